@@ -65,7 +65,7 @@ func (x *DataplaneInsight) GetSubscription(id string) (int, *DiscoverySubscripti
 	return -1, nil
 }
 
-func (x *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Time, issuedBackend string, supportedBackends []string) error {
+func (x *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Time, issuedBackend string, supportedBackends []string, serial string) error {
 	if x.MTLS == nil {
 		x.MTLS = &DataplaneInsight_MTLS{}
 	}
@@ -81,6 +81,7 @@ func (x *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Time
 	}
 	x.MTLS.IssuedBackend = issuedBackend
 	x.MTLS.SupportedBackends = supportedBackends
+	x.MTLS.CertificateSerial = serial
 	x.MTLS.LastCertificateRegeneration = ts
 	return nil
 }
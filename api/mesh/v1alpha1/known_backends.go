@@ -3,9 +3,12 @@ package v1alpha1
 const (
 	LoggingTcpType  = "tcp"
 	LoggingFileType = "file"
+	LoggingOtelType = "otel"
 
-	TracingZipkinType  = "zipkin"
-	TracingDatadogType = "datadog"
+	TracingZipkinType        = "zipkin"
+	TracingDatadogType       = "datadog"
+	TracingOpenTelemetryType = "opentelemetry"
 
-	MetricsPrometheusType = "prometheus"
+	MetricsPrometheusType    = "prometheus"
+	MetricsOpenTelemetryType = "opentelemetry"
 )
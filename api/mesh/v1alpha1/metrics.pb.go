@@ -9,6 +9,7 @@ package v1alpha1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -89,7 +90,7 @@ type MetricsBackend struct {
 
 	// Name of the backend, can be then used in Mesh.metrics.enabledBackend
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Type of the backend (Kuma ships with 'prometheus')
+	// Type of the backend (Kuma ships with 'prometheus' and 'opentelemetry')
 	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	// Configuration of the backend
 	Conf *structpb.Struct `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
@@ -167,6 +168,11 @@ type PrometheusMetricsBackendConfig struct {
 	// If true then endpoints for scraping metrics won't require mTLS even if mTLS
 	// is enabled in Mesh. If nil, then it is treated as false.
 	SkipMTLS *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=skipMTLS,proto3" json:"skipMTLS,omitempty"`
+	// Metrics endpoints of applications next to the dataplane. kuma-dp scrapes
+	// them and merges them with Envoy metrics, so they are exposed on the same
+	// (possibly mTLS protected) endpoint.
+	// Changes are picked up when kuma-dp is restarted.
+	Aggregate []*PrometheusAggregateMetricsConfig `protobuf:"bytes,5,rep,name=aggregate,proto3" json:"aggregate,omitempty"`
 }
 
 func (x *PrometheusMetricsBackendConfig) Reset() {
@@ -229,13 +235,272 @@ func (x *PrometheusMetricsBackendConfig) GetSkipMTLS() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *PrometheusMetricsBackendConfig) GetAggregate() []*PrometheusAggregateMetricsConfig {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+// PrometheusAggregateMetricsConfig defines an application metrics endpoint
+// that is exposed together with Envoy metrics.
+type PrometheusAggregateMetricsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the application. It identifies the endpoint when a dataplane
+	// overrides the Mesh configuration and is added as the `kuma_application`
+	// label to all metrics of the application.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Address on which the application exposes metrics, 127.0.0.1 by default.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Port on which the application exposes metrics.
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Path on which the application exposes metrics, /metrics by default.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// If false then the endpoint is not scraped. It can be used to disable
+	// an endpoint defined in Mesh. If nil, then it is treated as true.
+	Enabled *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *PrometheusAggregateMetricsConfig) Reset() {
+	*x = PrometheusAggregateMetricsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_metrics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrometheusAggregateMetricsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusAggregateMetricsConfig) ProtoMessage() {}
+
+func (x *PrometheusAggregateMetricsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_metrics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusAggregateMetricsConfig.ProtoReflect.Descriptor instead.
+func (*PrometheusAggregateMetricsConfig) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_metrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PrometheusAggregateMetricsConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrometheusAggregateMetricsConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PrometheusAggregateMetricsConfig) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PrometheusAggregateMetricsConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PrometheusAggregateMetricsConfig) GetEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+// OpenTelemetryMetricsBackendConfig defines configuration of OpenTelemetry
+// backend. Instead of exposing an endpoint to scrape, kuma-dp periodically
+// reads Envoy stats and pushes them to a collector over OTLP.
+// Changes are picked up when kuma-dp is restarted.
+type OpenTelemetryMetricsBackendConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of OpenTelemetry collector receiving OTLP over gRPC, e.g.
+	// otel-collector:4317.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Interval between pushes of metrics, 60s by default.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Regex that a name of Envoy stat has to match to be pushed. If empty,
+	// then all stats are pushed.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If true then only stats that were updated by Envoy are pushed.
+	// If nil, then it is treated as false.
+	UsedOnly *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=usedOnly,proto3" json:"usedOnly,omitempty"`
+	// TLS settings of the connection to the collector. If nil, then
+	// the connection is not encrypted.
+	Tls *OpenTelemetryMetricsTls `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) Reset() {
+	*x = OpenTelemetryMetricsBackendConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_metrics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenTelemetryMetricsBackendConfig) ProtoMessage() {}
+
+func (x *OpenTelemetryMetricsBackendConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_metrics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenTelemetryMetricsBackendConfig.ProtoReflect.Descriptor instead.
+func (*OpenTelemetryMetricsBackendConfig) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_metrics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) GetUsedOnly() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.UsedOnly
+	}
+	return nil
+}
+
+func (x *OpenTelemetryMetricsBackendConfig) GetTls() *OpenTelemetryMetricsTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// OpenTelemetryMetricsTls defines how kuma-dp verifies the collector.
+type OpenTelemetryMetricsTls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM encoded CA certificate that signed the certificate of the collector.
+	// If empty, then CA certificates of the system are used.
+	CaCert string `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// Server name that the certificate of the collector has to be valid for.
+	// If empty, then the host of the endpoint is used.
+	ServerName string `protobuf:"bytes,2,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// If true then the certificate of the collector is not verified.
+	SkipVerify bool `protobuf:"varint,3,opt,name=skipVerify,proto3" json:"skipVerify,omitempty"`
+}
+
+func (x *OpenTelemetryMetricsTls) Reset() {
+	*x = OpenTelemetryMetricsTls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_metrics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenTelemetryMetricsTls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenTelemetryMetricsTls) ProtoMessage() {}
+
+func (x *OpenTelemetryMetricsTls) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_metrics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenTelemetryMetricsTls.ProtoReflect.Descriptor instead.
+func (*OpenTelemetryMetricsTls) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_metrics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OpenTelemetryMetricsTls) GetCaCert() string {
+	if x != nil {
+		return x.CaCert
+	}
+	return ""
+}
+
+func (x *OpenTelemetryMetricsTls) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *OpenTelemetryMetricsTls) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
 var File_mesh_v1alpha1_metrics_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_metrics_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
 	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b,
 	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
@@ -252,7 +517,7 @@ var file_mesh_v1alpha1_metrics_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x04,
 	0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x22, 0x8b, 0x02, 0x0a, 0x1e, 0x50, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x22, 0xdf, 0x02, 0x0a, 0x1e, 0x50, 0x72,
 	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42,
 	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
 	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
@@ -265,11 +530,51 @@ var file_mesh_v1alpha1_metrics_proto_rawDesc = []byte{
 	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x4d, 0x54,
 	0x4c, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x4d, 0x54, 0x4c, 0x53, 0x1a, 0x37,
-	0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x4d, 0x54, 0x4c, 0x53, 0x12, 0x52,
+	0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75,
+	0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x20,
+	0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x85, 0x02, 0x0a,
+	0x21, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x6c, 0x73, 0x52,
+	0x03, 0x74, 0x6c, 0x73, 0x22, 0x71, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x6c, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d,
 	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -287,25 +592,34 @@ func file_mesh_v1alpha1_metrics_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_metrics_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_mesh_v1alpha1_metrics_proto_goTypes = []interface{}{
-	(*Metrics)(nil),                        // 0: kuma.mesh.v1alpha1.Metrics
-	(*MetricsBackend)(nil),                 // 1: kuma.mesh.v1alpha1.MetricsBackend
-	(*PrometheusMetricsBackendConfig)(nil), // 2: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig
-	nil,                                    // 3: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.TagsEntry
-	(*structpb.Struct)(nil),                // 4: google.protobuf.Struct
-	(*wrapperspb.BoolValue)(nil),           // 5: google.protobuf.BoolValue
+	(*Metrics)(nil),                           // 0: kuma.mesh.v1alpha1.Metrics
+	(*MetricsBackend)(nil),                    // 1: kuma.mesh.v1alpha1.MetricsBackend
+	(*PrometheusMetricsBackendConfig)(nil),    // 2: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig
+	(*PrometheusAggregateMetricsConfig)(nil),  // 3: kuma.mesh.v1alpha1.PrometheusAggregateMetricsConfig
+	(*OpenTelemetryMetricsBackendConfig)(nil), // 4: kuma.mesh.v1alpha1.OpenTelemetryMetricsBackendConfig
+	(*OpenTelemetryMetricsTls)(nil),           // 5: kuma.mesh.v1alpha1.OpenTelemetryMetricsTls
+	nil,                                       // 6: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.TagsEntry
+	(*structpb.Struct)(nil),                   // 7: google.protobuf.Struct
+	(*wrapperspb.BoolValue)(nil),              // 8: google.protobuf.BoolValue
+	(*durationpb.Duration)(nil),               // 9: google.protobuf.Duration
 }
 var file_mesh_v1alpha1_metrics_proto_depIdxs = []int32{
 	1, // 0: kuma.mesh.v1alpha1.Metrics.backends:type_name -> kuma.mesh.v1alpha1.MetricsBackend
-	4, // 1: kuma.mesh.v1alpha1.MetricsBackend.conf:type_name -> google.protobuf.Struct
-	3, // 2: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.tags:type_name -> kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.TagsEntry
-	5, // 3: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.skipMTLS:type_name -> google.protobuf.BoolValue
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	7, // 1: kuma.mesh.v1alpha1.MetricsBackend.conf:type_name -> google.protobuf.Struct
+	6, // 2: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.tags:type_name -> kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.TagsEntry
+	8, // 3: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.skipMTLS:type_name -> google.protobuf.BoolValue
+	3, // 4: kuma.mesh.v1alpha1.PrometheusMetricsBackendConfig.aggregate:type_name -> kuma.mesh.v1alpha1.PrometheusAggregateMetricsConfig
+	8, // 5: kuma.mesh.v1alpha1.PrometheusAggregateMetricsConfig.enabled:type_name -> google.protobuf.BoolValue
+	9, // 6: kuma.mesh.v1alpha1.OpenTelemetryMetricsBackendConfig.interval:type_name -> google.protobuf.Duration
+	8, // 7: kuma.mesh.v1alpha1.OpenTelemetryMetricsBackendConfig.usedOnly:type_name -> google.protobuf.BoolValue
+	5, // 8: kuma.mesh.v1alpha1.OpenTelemetryMetricsBackendConfig.tls:type_name -> kuma.mesh.v1alpha1.OpenTelemetryMetricsTls
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_metrics_proto_init() }
@@ -350,6 +664,42 @@ func file_mesh_v1alpha1_metrics_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_metrics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrometheusAggregateMetricsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_metrics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenTelemetryMetricsBackendConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_metrics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenTelemetryMetricsTls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -357,7 +707,7 @@ func file_mesh_v1alpha1_metrics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_metrics_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
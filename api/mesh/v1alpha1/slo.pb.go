@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: mesh/v1alpha1/slo.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SLO defines a service level objective of a service. It is used to
+// generate Prometheus recording and alerting rules and to compute the
+// remaining error budget of the service.
+type SLO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Service is the value of the kuma.io/service tag of the service the
+	// objective is defined for.
+	Service string    `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Conf    *SLO_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *SLO) Reset() {
+	*x = SLO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_slo_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLO) ProtoMessage() {}
+
+func (x *SLO) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_slo_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLO.ProtoReflect.Descriptor instead.
+func (*SLO) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_slo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SLO) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *SLO) GetConf() *SLO_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+type SLO_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target is the percentage of good requests in the window, e.g. 99.9
+	Target float64 `protobuf:"fixed64,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Window is the rolling window the objective is evaluated over, e.g. 720h
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Types that are assignable to Objective:
+	//	*SLO_Conf_Availability_
+	//	*SLO_Conf_Latency_
+	Objective isSLO_Conf_Objective `protobuf_oneof:"objective"`
+}
+
+func (x *SLO_Conf) Reset() {
+	*x = SLO_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_slo_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLO_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLO_Conf) ProtoMessage() {}
+
+func (x *SLO_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_slo_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLO_Conf.ProtoReflect.Descriptor instead.
+func (*SLO_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_slo_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *SLO_Conf) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *SLO_Conf) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (m *SLO_Conf) GetObjective() isSLO_Conf_Objective {
+	if m != nil {
+		return m.Objective
+	}
+	return nil
+}
+
+func (x *SLO_Conf) GetAvailability() *SLO_Conf_Availability {
+	if x, ok := x.GetObjective().(*SLO_Conf_Availability_); ok {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *SLO_Conf) GetLatency() *SLO_Conf_Latency {
+	if x, ok := x.GetObjective().(*SLO_Conf_Latency_); ok {
+		return x.Latency
+	}
+	return nil
+}
+
+type isSLO_Conf_Objective interface {
+	isSLO_Conf_Objective()
+}
+
+type SLO_Conf_Availability_ struct {
+	Availability *SLO_Conf_Availability `protobuf:"bytes,3,opt,name=availability,proto3,oneof"`
+}
+
+type SLO_Conf_Latency_ struct {
+	Latency *SLO_Conf_Latency `protobuf:"bytes,4,opt,name=latency,proto3,oneof"`
+}
+
+func (*SLO_Conf_Availability_) isSLO_Conf_Objective() {}
+
+func (*SLO_Conf_Latency_) isSLO_Conf_Objective() {}
+
+// Availability objective counts requests answered with 5xx status
+// codes as bad requests.
+type SLO_Conf_Availability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SLO_Conf_Availability) Reset() {
+	*x = SLO_Conf_Availability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_slo_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLO_Conf_Availability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLO_Conf_Availability) ProtoMessage() {}
+
+func (x *SLO_Conf_Availability) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_slo_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLO_Conf_Availability.ProtoReflect.Descriptor instead.
+func (*SLO_Conf_Availability) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_slo_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// Latency objective counts requests slower than the threshold as bad
+// requests.
+type SLO_Conf_Latency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Threshold has to be one of the buckets of Envoy histograms:
+	// 1ms, 5ms, 10ms, 25ms, 50ms, 100ms, 250ms, 500ms, 1s, 2.5s, 5s, 10s,
+	// 30s, 60s.
+	Threshold *durationpb.Duration `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *SLO_Conf_Latency) Reset() {
+	*x = SLO_Conf_Latency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_slo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLO_Conf_Latency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLO_Conf_Latency) ProtoMessage() {}
+
+func (x *SLO_Conf_Latency) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_slo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLO_Conf_Latency.ProtoReflect.Descriptor instead.
+func (*SLO_Conf_Latency) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_slo_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *SLO_Conf_Latency) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_slo_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_slo_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x6c, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xe2, 0x03, 0x0a, 0x03, 0x53, 0x4c, 0x4f, 0x12, 0x1e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x4c, 0x4f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a,
+	0xd7, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1c, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x4f, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x4c, 0x4f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x40, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x4c, 0x4f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x1a, 0x0e, 0x0a, 0x0c, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x1a, 0x48, 0x0a, 0x07, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3d, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18,
+	0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x0b, 0x0a, 0x09,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x29, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x23, 0x12, 0x03, 0x53, 0x4c, 0x4f, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x52, 0x02, 0x10, 0x01,
+	0x3a, 0x05, 0x0a, 0x03, 0x73, 0x6c, 0x6f, 0x0a, 0x0b, 0x53, 0x4c, 0x4f, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x3c, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x8a, 0xb5, 0x18, 0x0e, 0x50, 0x01, 0xa2, 0x01, 0x03, 0x53, 0x4c, 0x4f, 0xf2, 0x01, 0x03, 0x73,
+	0x6c, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_slo_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_slo_proto_rawDescData = file_mesh_v1alpha1_slo_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_slo_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_slo_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_slo_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_slo_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_slo_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_slo_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_slo_proto_goTypes = []interface{}{
+	(*SLO)(nil),                   // 0: kuma.mesh.v1alpha1.SLO
+	(*SLO_Conf)(nil),              // 1: kuma.mesh.v1alpha1.SLO.Conf
+	(*SLO_Conf_Availability)(nil), // 2: kuma.mesh.v1alpha1.SLO.Conf.Availability
+	(*SLO_Conf_Latency)(nil),      // 3: kuma.mesh.v1alpha1.SLO.Conf.Latency
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_slo_proto_depIdxs = []int32{
+	1, // 0: kuma.mesh.v1alpha1.SLO.conf:type_name -> kuma.mesh.v1alpha1.SLO.Conf
+	4, // 1: kuma.mesh.v1alpha1.SLO.Conf.window:type_name -> google.protobuf.Duration
+	2, // 2: kuma.mesh.v1alpha1.SLO.Conf.availability:type_name -> kuma.mesh.v1alpha1.SLO.Conf.Availability
+	3, // 3: kuma.mesh.v1alpha1.SLO.Conf.latency:type_name -> kuma.mesh.v1alpha1.SLO.Conf.Latency
+	4, // 4: kuma.mesh.v1alpha1.SLO.Conf.Latency.threshold:type_name -> google.protobuf.Duration
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_slo_proto_init() }
+func file_mesh_v1alpha1_slo_proto_init() {
+	if File_mesh_v1alpha1_slo_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_slo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_slo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLO_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_slo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLO_Conf_Availability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_slo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLO_Conf_Latency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_slo_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*SLO_Conf_Availability_)(nil),
+		(*SLO_Conf_Latency_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_slo_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_slo_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_slo_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_slo_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_slo_proto = out.File
+	file_mesh_v1alpha1_slo_proto_rawDesc = nil
+	file_mesh_v1alpha1_slo_proto_goTypes = nil
+	file_mesh_v1alpha1_slo_proto_depIdxs = nil
+}
@@ -11,6 +11,8 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -97,6 +99,9 @@ type TrafficLog_Conf struct {
 
 	// Backend defined in the Mesh entity.
 	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Filter narrows down which requests are logged.
+	// If not set, all requests are logged.
+	Filter *TrafficLog_Conf_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *TrafficLog_Conf) Reset() {
@@ -138,6 +143,160 @@ func (x *TrafficLog_Conf) GetBackend() string {
 	return ""
 }
 
+func (x *TrafficLog_Conf) GetFilter() *TrafficLog_Conf_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Filter defines which requests are logged.
+// A request is logged when it matches any of the defined conditions.
+// Requests that match none of them are logged at the sampling rate.
+// Status code and header conditions apply only to HTTP traffic.
+type TrafficLog_Conf_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Requests with a response code in any of the ranges are logged.
+	StatusCodes []*TrafficLog_Conf_Filter_StatusCodeRange `protobuf:"bytes,1,rep,name=statusCodes,proto3" json:"statusCodes,omitempty"`
+	// Requests that take at least this long are logged.
+	MinDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	// Requests with any of the Envoy response flags (e.g. UH, UF, UT) are
+	// logged.
+	ResponseFlags []string `protobuf:"bytes,3,rep,name=responseFlags,proto3" json:"responseFlags,omitempty"`
+	// Requests that carry any of the headers are logged.
+	Headers []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Percentage of remaining requests that are logged, between 0 and 100.
+	// If not set, only requests that match the conditions above are logged.
+	Sampling *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=sampling,proto3" json:"sampling,omitempty"`
+}
+
+func (x *TrafficLog_Conf_Filter) Reset() {
+	*x = TrafficLog_Conf_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficLog_Conf_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficLog_Conf_Filter) ProtoMessage() {}
+
+func (x *TrafficLog_Conf_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficLog_Conf_Filter.ProtoReflect.Descriptor instead.
+func (*TrafficLog_Conf_Filter) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_log_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *TrafficLog_Conf_Filter) GetStatusCodes() []*TrafficLog_Conf_Filter_StatusCodeRange {
+	if x != nil {
+		return x.StatusCodes
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetMinDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetResponseFlags() []string {
+	if x != nil {
+		return x.ResponseFlags
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetSampling() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+// StatusCodeRange matches response codes between min and max
+// (inclusive).
+type TrafficLog_Conf_Filter_StatusCodeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodeRange) Reset() {
+	*x = TrafficLog_Conf_Filter_StatusCodeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficLog_Conf_Filter_StatusCodeRange) ProtoMessage() {}
+
+func (x *TrafficLog_Conf_Filter_StatusCodeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficLog_Conf_Filter_StatusCodeRange.ProtoReflect.Descriptor instead.
+func (*TrafficLog_Conf_Filter_StatusCodeRange) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_log_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodeRange) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodeRange) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
 var File_mesh_v1alpha1_traffic_log_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_traffic_log_proto_rawDesc = []byte{
@@ -145,10 +304,14 @@ var file_mesh_v1alpha1_traffic_log_proto_rawDesc = []byte{
 	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x05, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
 	0x63, 0x4c, 0x6f, 0x67, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
 	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
@@ -161,20 +324,45 @@ var file_mesh_v1alpha1_traffic_log_proto_rawDesc = []byte{
 	0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
 	0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63,
-	0x6f, 0x6e, 0x66, 0x1a, 0x20, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x3a, 0x5f, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x0a, 0x12, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0c, 0x12, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x4c, 0x6f, 0x67, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa,
-	0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0f, 0x3a,
-	0x0d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x6c, 0x6f, 0x67, 0xaa, 0x8c,
-	0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x4b, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1d, 0x50, 0x01, 0xa2, 0x01, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x4c, 0x6f, 0x67, 0xf2, 0x01, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d,
-	0x6c, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x66, 0x1a, 0xc7, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0xe0, 0x02, 0x0a, 0x06, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x38, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x1a, 0x41, 0x0a, 0x0f, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x3a, 0x41, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x3b, 0x12, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f,
+	0x67, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x52, 0x02, 0x10, 0x01, 0x3a, 0x0d, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x6c, 0x6f, 0x67, 0x68, 0x01, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x4b, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1d,
+	0xa2, 0x01, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0xf2, 0x01, 0x0b,
+	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x6c, 0x6f, 0x67, 0x50, 0x01, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -189,21 +377,29 @@ func file_mesh_v1alpha1_traffic_log_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_log_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_traffic_log_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_mesh_v1alpha1_traffic_log_proto_goTypes = []interface{}{
-	(*TrafficLog)(nil),      // 0: kuma.mesh.v1alpha1.TrafficLog
-	(*TrafficLog_Conf)(nil), // 1: kuma.mesh.v1alpha1.TrafficLog.Conf
-	(*Selector)(nil),        // 2: kuma.mesh.v1alpha1.Selector
+	(*TrafficLog)(nil),                             // 0: kuma.mesh.v1alpha1.TrafficLog
+	(*TrafficLog_Conf)(nil),                        // 1: kuma.mesh.v1alpha1.TrafficLog.Conf
+	(*TrafficLog_Conf_Filter)(nil),                 // 2: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter
+	(*TrafficLog_Conf_Filter_StatusCodeRange)(nil), // 3: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.StatusCodeRange
+	(*Selector)(nil),                               // 4: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),                    // 5: google.protobuf.Duration
+	(*wrapperspb.DoubleValue)(nil),                 // 6: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_traffic_log_proto_depIdxs = []int32{
-	2, // 0: kuma.mesh.v1alpha1.TrafficLog.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	2, // 1: kuma.mesh.v1alpha1.TrafficLog.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	4, // 0: kuma.mesh.v1alpha1.TrafficLog.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	4, // 1: kuma.mesh.v1alpha1.TrafficLog.destinations:type_name -> kuma.mesh.v1alpha1.Selector
 	1, // 2: kuma.mesh.v1alpha1.TrafficLog.conf:type_name -> kuma.mesh.v1alpha1.TrafficLog.Conf
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 3: kuma.mesh.v1alpha1.TrafficLog.Conf.filter:type_name -> kuma.mesh.v1alpha1.TrafficLog.Conf.Filter
+	3, // 4: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.statusCodes:type_name -> kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.StatusCodeRange
+	5, // 5: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.minDuration:type_name -> google.protobuf.Duration
+	6, // 6: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.sampling:type_name -> google.protobuf.DoubleValue
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_log_proto_init() }
@@ -237,6 +433,30 @@ func file_mesh_v1alpha1_traffic_log_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_traffic_log_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficLog_Conf_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_log_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficLog_Conf_Filter_StatusCodeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -244,7 +464,7 @@ func file_mesh_v1alpha1_traffic_log_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_log_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
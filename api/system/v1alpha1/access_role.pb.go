@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: system/v1alpha1/access_role.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccessRole defines a set of rules that grant access to the API Server.
+// It is used when the Control Plane is configured with the "rbac" access type.
+type AccessRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of rules of the role.
+	Rules []*AccessRole_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *AccessRole) Reset() {
+	*x = AccessRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole) ProtoMessage() {}
+
+func (x *AccessRole) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole.ProtoReflect.Descriptor instead.
+func (*AccessRole) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRole) GetRules() []*AccessRole_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// AccessRoleBinding grants AccessRoles to users and groups.
+type AccessRoleBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of users to which the roles are granted.
+	Users []string `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Names of groups to which the roles are granted.
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Names of AccessRoles that are granted.
+	Roles []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *AccessRoleBinding) Reset() {
+	*x = AccessRoleBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRoleBinding) ProtoMessage() {}
+
+func (x *AccessRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRoleBinding.ProtoReflect.Descriptor instead.
+func (*AccessRoleBinding) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccessRoleBinding) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AccessRoleBinding) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AccessRoleBinding) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Rule grants verbs on types of resources in meshes.
+type AccessRole_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types of resources (ex. TrafficPermission) to which the rule applies.
+	// "*" matches every type.
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// Verbs that are granted. Available values: get, list, create, update,
+	// delete, inspect. "*" matches every verb.
+	Verbs []string `protobuf:"bytes,2,rep,name=verbs,proto3" json:"verbs,omitempty"`
+	// Meshes in which the rule applies. "*" matches every mesh, but not global resources.
+	// If empty, the rule applies to every mesh and to global resources.
+	Meshes []string `protobuf:"bytes,3,rep,name=meshes,proto3" json:"meshes,omitempty"`
+}
+
+func (x *AccessRole_Rule) Reset() {
+	*x = AccessRole_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole_Rule) ProtoMessage() {}
+
+func (x *AccessRole_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole_Rule.ProtoReflect.Descriptor instead.
+func (*AccessRole_Rule) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AccessRole_Rule) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *AccessRole_Rule) GetVerbs() []string {
+	if x != nil {
+		return x.Verbs
+	}
+	return nil
+}
+
+func (x *AccessRole_Rule) GetMeshes() []string {
+	if x != nil {
+		return x.Meshes
+	}
+	return nil
+}
+
+var File_system_v1alpha1_access_role_proto protoreflect.FileDescriptor
+
+var file_system_v1alpha1_access_role_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01,
+	0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x04, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x72, 0x62, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x65, 0x72, 0x62, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x73, 0x68, 0x65, 0x73, 0x3a, 0x41, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x3b, 0x12, 0x0a, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x3a, 0x0f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x72, 0x6f,
+	0x6c, 0x65, 0x20, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x3a, 0x57, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x51, 0x3a, 0x17, 0x0a, 0x13, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x20, 0x01, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x11,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71,
+	0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_system_v1alpha1_access_role_proto_rawDescOnce sync.Once
+	file_system_v1alpha1_access_role_proto_rawDescData = file_system_v1alpha1_access_role_proto_rawDesc
+)
+
+func file_system_v1alpha1_access_role_proto_rawDescGZIP() []byte {
+	file_system_v1alpha1_access_role_proto_rawDescOnce.Do(func() {
+		file_system_v1alpha1_access_role_proto_rawDescData = protoimpl.X.CompressGZIP(file_system_v1alpha1_access_role_proto_rawDescData)
+	})
+	return file_system_v1alpha1_access_role_proto_rawDescData
+}
+
+var file_system_v1alpha1_access_role_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_system_v1alpha1_access_role_proto_goTypes = []interface{}{
+	(*AccessRole)(nil),        // 0: kuma.system.v1alpha1.AccessRole
+	(*AccessRoleBinding)(nil), // 1: kuma.system.v1alpha1.AccessRoleBinding
+	(*AccessRole_Rule)(nil),   // 2: kuma.system.v1alpha1.AccessRole.Rule
+}
+var file_system_v1alpha1_access_role_proto_depIdxs = []int32{
+	2, // 0: kuma.system.v1alpha1.AccessRole.rules:type_name -> kuma.system.v1alpha1.AccessRole.Rule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_system_v1alpha1_access_role_proto_init() }
+func file_system_v1alpha1_access_role_proto_init() {
+	if File_system_v1alpha1_access_role_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_system_v1alpha1_access_role_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_system_v1alpha1_access_role_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRoleBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_system_v1alpha1_access_role_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_system_v1alpha1_access_role_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_system_v1alpha1_access_role_proto_goTypes,
+		DependencyIndexes: file_system_v1alpha1_access_role_proto_depIdxs,
+		MessageInfos:      file_system_v1alpha1_access_role_proto_msgTypes,
+	}.Build()
+	File_system_v1alpha1_access_role_proto = out.File
+	file_system_v1alpha1_access_role_proto_rawDesc = nil
+	file_system_v1alpha1_access_role_proto_goTypes = nil
+	file_system_v1alpha1_access_role_proto_depIdxs = nil
+}
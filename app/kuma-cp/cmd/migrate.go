@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
@@ -8,6 +10,11 @@ import (
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	postgres_store "github.com/kumahq/kuma/pkg/plugins/resources/postgres"
 	"github.com/kumahq/kuma/pkg/version"
 )
 
@@ -20,6 +27,7 @@ func newMigrateCmd() *cobra.Command {
 		Long:  `Migrate database to which Control Plane is connected. The database contains all policies, dataplanes and secrets. The schema has to be in sync with version of Kuma CP to properly work. Make sure to run "kuma-cp migrate up" before running new version of Kuma.`,
 	}
 	cmd.AddCommand(newMigrateUpCmd())
+	cmd.AddCommand(newMigrateSecretsCmd())
 	return cmd
 }
 
@@ -79,3 +87,56 @@ func migrate(cfg kuma_cp.Config) error {
 	_, err = plugin.Migrate(nil, pluginConfig)
 	return err
 }
+
+func newMigrateSecretsCmd() *cobra.Command {
+	args := struct {
+		configPath string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Encrypt all secrets with the active key encryption key.",
+		Long: `Encrypt all Secrets and GlobalSecrets with the active key encryption key (KUMA_STORE_SECRETS_ENCRYPTION_ACTIVE_KEY_ID).
+Run it after encryption of secrets is enabled to encrypt secrets that are stored in plaintext
+and after the active key is rotated to encrypt secrets with the new key. The previous key can be removed afterwards.
+Secrets are updated in a single transaction.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := kuma_cp.DefaultConfig()
+			err := config.Load(args.configPath, &cfg)
+			if err != nil {
+				migrateLog.Error(err, "could not load the configuration")
+				return err
+			}
+			if cfg.Store.Type != store.PostgresStore {
+				return errors.Errorf("secrets can only be migrated for the %s store", store.PostgresStore)
+			}
+			if !cfg.Store.Secrets.Encryption.Enabled() {
+				return errors.New("encryption of secrets is not enabled. Set the active key encryption key first")
+			}
+
+			cipher, err := secret_cipher.FromConfig(cfg.Store.Secrets.Encryption)
+			if err != nil {
+				return err
+			}
+			metrics, err := core_metrics.NewMetrics("")
+			if err != nil {
+				return err
+			}
+			rs, err := postgres_store.NewStore(metrics, *cfg.Store.Postgres)
+			if err != nil {
+				return err
+			}
+			var count int
+			err = rs.(core_store.Transactions).Transaction(context.Background(), func(ctx context.Context) error {
+				count, err = secret_store.ReEncrypt(ctx, secret_store.NewSecretStore(rs), cipher)
+				return err
+			})
+			if err != nil {
+				return errors.Wrap(err, "could not migrate secrets")
+			}
+			cmd.Printf("%d secrets have been encrypted with the key %q\n", count, cfg.Store.Secrets.Encryption.ActiveKeyId)
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&args.configPath, "config-file", "c", "", "configuration file")
+	return cmd
+}
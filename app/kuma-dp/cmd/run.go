@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 
@@ -10,9 +11,11 @@ import (
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	kumadp_config "github.com/kumahq/kuma/app/kuma-dp/pkg/config"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/attestation"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/dnsserver"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/metrics"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/workloadapi"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/config"
 	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
@@ -36,6 +39,7 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 	cfg := rootCtx.Config
 	var tmpDir string
 	var proxyResource model.Resource
+	var tokenRenewer *attestation.TokenRenewer
 	cmd := &cobra.Command{
 		Use:   "run",
 		Short: "Launch Dataplane (Envoy)",
@@ -143,6 +147,27 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 				}
 				cfg.ControlPlane.CaCert = string(cert)
 			}
+
+			if cfg.DataplaneRuntime.Attestation.Provider != "" {
+				if cfg.DataplaneRuntime.TokenPath != "" {
+					return errors.New("--attestation-provider cannot be used together with --dataplane-token or --dataplane-token-file")
+				}
+				client, err := attestation.NewTokenClient(*cfg)
+				if err != nil {
+					return errors.Wrap(err, "could not configure attestation")
+				}
+				// Envoy reads the token from the file, so the path has to be absolute
+				path, err := filepath.Abs(filepath.Join(cfg.DataplaneRuntime.ConfigDir, cfg.Dataplane.Name))
+				if err != nil {
+					return err
+				}
+				tokenRenewer = attestation.NewTokenRenewer(client, path, cfg.ControlPlane.Retry.Backoff)
+				runLog.Info("fetching a dataplane token using attestation", "provider", cfg.DataplaneRuntime.Attestation.Provider)
+				if err := tokenRenewer.Init(context.Background(), cfg.ControlPlane.Retry.MaxDuration); err != nil {
+					return errors.Wrap(err, "could not fetch a dataplane token using attestation")
+				}
+				cfg.DataplaneRuntime.TokenPath = path
+			}
 			return nil
 		},
 		PostRunE: func(cmd *cobra.Command, _ []string) error {
@@ -166,6 +191,23 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 			components := []component.Component{
 				accesslogs.NewAccessLogServer(cfg.Dataplane),
 			}
+			if tokenRenewer != nil {
+				components = append(components, tokenRenewer)
+			}
+			if cfg.DataplaneRuntime.WorkloadAPI.SocketPath != "" {
+				if cfg.Dataplane.ProxyType != string(mesh_proto.DataplaneProxyType) {
+					return errors.New("--workload-api-socket-path can be used only with dataplane proxy type")
+				}
+				identityClient, err := workloadapi.NewIdentityClient(*cfg)
+				if err != nil {
+					return errors.Wrap(err, "could not configure Workload API")
+				}
+				socketGID, err := workloadapi.LookupSocketGroup(cfg.DataplaneRuntime.WorkloadAPI.SocketGroup)
+				if err != nil {
+					return errors.Wrap(err, "could not configure Workload API")
+				}
+				components = append(components, workloadapi.NewServer(cfg.DataplaneRuntime.WorkloadAPI.SocketPath, socketGID, identityClient, cfg.ControlPlane.Retry.Backoff))
+			}
 
 			opts := envoy.Opts{
 				Config:    *cfg,
@@ -236,9 +278,25 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 
 			components = append(components, dataplane)
 
-			metricsServer := metrics.New(cfg.Dataplane, bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue())
+			metricsApplications, err := metrics.ApplicationsFromBootstrap(bootstrap)
+			if err != nil {
+				return err
+			}
+			metricsServer := metrics.New(cfg.Dataplane, bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue(), metricsApplications)
 			components = append(components, metricsServer)
 
+			metricsOpenTelemetry, err := metrics.OpenTelemetryFromBootstrap(bootstrap)
+			if err != nil {
+				return err
+			}
+			if metricsOpenTelemetry != nil {
+				exporter, err := metrics.NewOpenTelemetryExporter(bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue(), *metricsOpenTelemetry)
+				if err != nil {
+					return err
+				}
+				components = append(components, exporter)
+			}
+
 			if err := rootCtx.ComponentManager.Add(components...); err != nil {
 				return err
 			}
@@ -268,6 +326,10 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.ConfigDir, "config-dir", cfg.DataplaneRuntime.ConfigDir, "Directory in which Envoy config will be generated")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.TokenPath, "dataplane-token-file", cfg.DataplaneRuntime.TokenPath, "Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Token, "dataplane-token", cfg.DataplaneRuntime.Token, "Dataplane Token")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Attestation.Provider, "attestation-provider", cfg.DataplaneRuntime.Attestation.Provider, "Attestation provider enabled in the Control Plane (ex. 'staticKey'). If set, kuma-dp exchanges a proof of its identity for a short-lived dataplane token and renews it in the background")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Attestation.KeyFile, "attestation-key-file", cfg.DataplaneRuntime.Attestation.KeyFile, "Path to a private key that signs the proof of identity of the 'staticKey' attestation provider")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.WorkloadAPI.SocketPath, "workload-api-socket-path", cfg.DataplaneRuntime.WorkloadAPI.SocketPath, "Path to a Unix socket on which SPIFFE Workload API is served to the application. The API serves the certificate of the dataplane and the trust bundle of the Mesh. Empty value disables the API")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.WorkloadAPI.SocketGroup, "workload-api-socket-group", cfg.DataplaneRuntime.WorkloadAPI.SocketGroup, "Name or ID of the group whose users can access the Workload API socket in addition to the user of kuma-dp and root. It has to be the primary group of the connecting process")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Resource, "dataplane", "", "Dataplane template to apply (YAML or JSON)")
 	cmd.PersistentFlags().StringVarP(&cfg.DataplaneRuntime.ResourcePath, "dataplane-file", "d", "", "Path to Dataplane template to apply (YAML or JSON)")
 	cmd.PersistentFlags().StringToStringVarP(&cfg.DataplaneRuntime.ResourceVars, "dataplane-var", "v", map[string]string{}, "Variables to replace Dataplane template")
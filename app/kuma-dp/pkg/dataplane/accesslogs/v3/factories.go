@@ -1,6 +1,7 @@
 package v3
 
 import (
+	"encoding/json"
 	"strings"
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
@@ -11,9 +12,14 @@ import (
 )
 
 func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
-	parts := strings.SplitN(msg.GetIdentifier().GetLogName(), ";", 2)
+	logName := msg.GetIdentifier().GetLogName()
+	if strings.HasPrefix(logName, accesslog.JsonFormatLogNamePrefix+";") {
+		return jsonHandler(log, strings.TrimPrefix(logName, accesslog.JsonFormatLogNamePrefix+";"))
+	}
+
+	parts := strings.SplitN(logName, ";", 2)
 	if len(parts) != 2 {
-		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", msg.GetIdentifier().GetLogName(), 2, len(parts))
+		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", logName, 2, len(parts))
 	}
 	address, formatString := parts[0], parts[1]
 
@@ -22,6 +28,31 @@ func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessag
 		return nil, err
 	}
 
+	return newHandler(log, address, format)
+}
+
+// jsonHandler creates a handler for a structured access log format
+// that is passed in a log name as `json;<address>;<fields as JSON object>`.
+func jsonHandler(log logr.Logger, logName string) (logHandler, error) {
+	parts := strings.SplitN(logName, ";", 2)
+	if len(parts) != 2 {
+		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", accesslog.JsonFormatLogNamePrefix+";"+logName, 3, len(parts)+1)
+	}
+	address, formatString := parts[0], parts[1]
+
+	fields := map[string]string{}
+	if err := json.Unmarshal([]byte(formatString), &fields); err != nil {
+		return nil, errors.Wrap(err, "json format is not valid")
+	}
+	format, err := accesslog.ParseJsonFormat(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHandler(log, address, format)
+}
+
+func newHandler(log logr.Logger, address string, format logFormatter) (logHandler, error) {
 	sender := defaultSender(log, address)
 
 	if err := sender.Connect(); err != nil {
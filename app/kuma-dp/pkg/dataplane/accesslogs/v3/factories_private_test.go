@@ -34,6 +34,30 @@ var _ = Describe("defaultHandler", func() {
 				},
 				expectedErr: `format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`,
 			}),
+			Entry("missing json format", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: "json;127.0.0.1:5000",
+					},
+				},
+				expectedErr: `log name "json;127.0.0.1:5000" has invalid format: expected 3 components separated by ';', got 2`,
+			}),
+			Entry("json format that is not a JSON object", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: "json;127.0.0.1:5000;%BYTES_SENT%",
+					},
+				},
+				expectedErr: `json format is not valid: invalid character '%' looking for beginning of value`,
+			}),
+			Entry("invalid field of json format", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: `json;127.0.0.1:5000;{"bytes":"%bytes_sent%"}`,
+					},
+				},
+				expectedErr: `field "bytes": format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`,
+			}),
 		)
 	})
 })
@@ -3,12 +3,10 @@ package v3
 import (
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	"github.com/pkg/errors"
-
-	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 type handler struct {
-	format *accesslog.AccessLogFormat
+	format logFormatter
 	sender logSender
 }
 
@@ -160,6 +160,42 @@ var _ = Describe("handler", func() {
 					},
 				}),
 			)
+
+			It("should handle valid messages with a structured format", func() {
+				// given
+				fakeSender := fakeSender{}
+				format, err := accesslog.ParseJsonFormat(map[string]string{
+					"method":   "%REQ(:METHOD)%",
+					"request":  "%REQ(:METHOD)% %REQ(:PATH)%",
+					"sent":     "%BYTES_SENT%",
+					"upstream": "%UPSTREAM_HOST%",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				handler := &handler{format: format, sender: &fakeSender}
+
+				// and
+				msg := &envoy_accesslog.StreamAccessLogsMessage{}
+				err = util_proto.FromYAML([]byte(`
+                http_logs:
+                  log_entry:
+                  - request:
+                      request_method: GET
+                      path: /api?q="<x>"
+                    response:
+                      response_body_bytes: 567
+`), msg)
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				err = handler.Handle(msg)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect([]string(fakeSender)).To(Equal([]string{
+					`{"method":"GET","request":"GET /api?q=\"<x>\"","sent":"567","upstream":"-"}` + "\n",
+				}))
+			})
 		})
 
 		Describe("error path", func() {
@@ -5,6 +5,8 @@ import (
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	"github.com/go-logr/logr"
+
+	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 // logHandler represents a contract between a log stream receiver and a log handler.
@@ -20,5 +22,11 @@ type logSender interface {
 	io.Closer
 }
 
+// logFormatter represents either a text or a structured access log format.
+type logFormatter interface {
+	accesslog.HttpLogEntryFormatter
+	accesslog.TcpLogEntryFormatter
+}
+
 // logHandlerFactoryFunc represents a factory of log handler implementations.
 type logHandlerFactoryFunc = func(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error)
@@ -0,0 +1,125 @@
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	net_url "net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	cp_attestation "github.com/kumahq/kuma/pkg/tokens/builtin/attestation"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+// Token is a dataplane token issued by the control plane on attestation.
+type Token struct {
+	Value     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+type TokenClient interface {
+	Fetch(ctx context.Context) (Token, error)
+}
+
+func NewTokenClient(cfg kuma_dp.Config) (TokenClient, error) {
+	evidence, err := NewEvidenceProvider(cfg.DataplaneRuntime.Attestation)
+	if err != nil {
+		return nil, err
+	}
+	url, err := net_url.Parse(cfg.ControlPlane.URL)
+	if err != nil {
+		return nil, err
+	}
+	url.Path = cp_attestation.Path
+
+	// the same as for the bootstrap request, the control plane is verified only if the CA cert is provided
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if cfg.ControlPlane.CaCert != "" {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(cfg.ControlPlane.CaCert)); !ok {
+			return nil, errors.New("could not add certificate")
+		}
+		tlsConfig = &tls.Config{RootCAs: certPool}
+	}
+	return &httpTokenClient{
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		url:      url.String(),
+		evidence: evidence,
+		request: types.DataplaneTokenAttestationRequest{
+			Provider: cfg.DataplaneRuntime.Attestation.Provider,
+			Mesh:     cfg.Dataplane.Mesh,
+			Name:     cfg.Dataplane.Name,
+			Type:     cfg.Dataplane.ProxyType,
+		},
+	}, nil
+}
+
+type httpTokenClient struct {
+	client   *http.Client
+	url      string
+	evidence EvidenceProvider
+	request  types.DataplaneTokenAttestationRequest
+}
+
+var _ TokenClient = &httpTokenClient{}
+
+func (h *httpTokenClient) Fetch(ctx context.Context) (Token, error) {
+	request := h.request
+	evidence, err := h.evidence.Evidence(request.Name, request.Mesh, request.Type)
+	if err != nil {
+		return Token{}, errors.Wrap(err, "could not produce the evidence")
+	}
+	request.Evidence = evidence
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return Token{}, errors.Wrap(err, "could not marshal request to json")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("content-type", "application/json")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return Token{}, errors.Wrap(err, "request to attestation server failed")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Token{}, errors.Wrap(err, "could not read the body of the response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return Token{}, errors.New("attestation is not enabled in the control plane")
+		}
+		return Token{}, errors.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+	return parseToken(string(body))
+}
+
+func parseToken(value string) (Token, error) {
+	claims := &jwt.RegisteredClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(value, claims); err != nil {
+		return Token{}, errors.Wrap(err, "could not parse the token")
+	}
+	if claims.ExpiresAt == nil || claims.IssuedAt == nil {
+		return Token{}, errors.New("token issued on attestation has to have iat and exp claims")
+	}
+	return Token{
+		Value:     value,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
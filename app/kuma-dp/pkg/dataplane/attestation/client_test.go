@@ -0,0 +1,103 @@
+package attestation_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/attestation"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/tokens/builtin"
+	cp_attestation "github.com/kumahq/kuma/pkg/tokens/builtin/attestation"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("TokenClient", func() {
+
+	var server *httptest.Server
+	var cfg kuma_dp.Config
+
+	BeforeEach(func() {
+		// given attestation key of the machine
+		key, err := core_tokens.NewSigningKeyWithAlgorithm(util_tls.ECDSAP256KeyAlgorithm)
+		Expect(err).ToNot(HaveOccurred())
+		publicKey, err := core_tokens.PublicKeyFromSigningKey(key)
+		Expect(err).ToNot(HaveOccurred())
+		dir := GinkgoT().TempDir()
+		keyFile := filepath.Join(dir, "attestation.key")
+		Expect(os.WriteFile(keyFile, key, 0600)).To(Succeed())
+		publicKeyFile := filepath.Join(dir, "attestation.pub")
+		Expect(os.WriteFile(publicKeyFile, publicKey, 0600)).To(Succeed())
+
+		// and control plane with attestation enabled
+		provider, err := cp_attestation.LoadStaticKeyProvider(map[string]string{publicKeyFile: "default/*"})
+		Expect(err).ToNot(HaveOccurred())
+		resManager := manager.NewResourceManager(memory.NewStore())
+		Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey("default", core_model.NoMesh))).To(Succeed())
+		signingKeyManager := core_tokens.NewMeshedSigningKeyManager(resManager, issuer.DataplaneTokenSigningKeyPrefix("default"), "default")
+		Expect(signingKeyManager.CreateDefaultSigningKey(context.Background())).To(Succeed())
+		handler := &cp_attestation.Handler{
+			Providers: cp_attestation.Providers{
+				cp_attestation.StaticKeyProvider: provider,
+			},
+			Issuer:        builtin.NewDataplaneTokenIssuer(resManager),
+			TokenValidity: 10 * time.Minute,
+			AuditLogger:   audit.NopLogger(),
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc(cp_attestation.Path, handler.Handle)
+		server = httptest.NewServer(mux)
+
+		cfg = kuma_dp.DefaultConfig()
+		cfg.ControlPlane.URL = server.URL
+		cfg.Dataplane.Mesh = "default"
+		cfg.Dataplane.Name = "backend-01"
+		cfg.DataplaneRuntime.Attestation = kuma_dp.Attestation{
+			Provider: cp_attestation.StaticKeyProvider,
+			KeyFile:  keyFile,
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should exchange the evidence for a dataplane token", func() {
+		// given
+		client, err := attestation.NewTokenClient(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		token, err := client.Fetch(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Value).ToNot(BeEmpty())
+		Expect(token.ExpiresAt.Sub(token.IssuedAt)).To(Equal(10 * time.Minute))
+	})
+
+	It("should fail when the provider is not supported", func() {
+		// given
+		cfg.DataplaneRuntime.Attestation.Provider = "unknown"
+
+		// when
+		_, err := attestation.NewTokenClient(cfg)
+
+		// then
+		Expect(err).To(MatchError(`unsupported attestation provider "unknown"`))
+	})
+})
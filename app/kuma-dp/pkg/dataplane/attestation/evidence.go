@@ -0,0 +1,42 @@
+package attestation
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	cp_attestation "github.com/kumahq/kuma/pkg/tokens/builtin/attestation"
+)
+
+// EvidenceProvider produces a proof of the identity of the data plane proxy
+// that is verified by the attestation provider of the same name in the control plane.
+type EvidenceProvider interface {
+	Evidence(name string, mesh string, proxyType string) (string, error)
+}
+
+func NewEvidenceProvider(cfg kuma_dp.Attestation) (EvidenceProvider, error) {
+	switch cfg.Provider {
+	case cp_attestation.StaticKeyProvider:
+		if cfg.KeyFile == "" {
+			return nil, errors.Errorf("attestation key file is required by the %q provider", cfg.Provider)
+		}
+		return &staticKeyEvidence{keyFile: cfg.KeyFile}, nil
+	default:
+		return nil, errors.Errorf("unsupported attestation provider %q", cfg.Provider)
+	}
+}
+
+type staticKeyEvidence struct {
+	keyFile string
+}
+
+func (s *staticKeyEvidence) Evidence(name string, mesh string, proxyType string) (string, error) {
+	// the key is read on every attestation, so it can be rotated without restarting kuma-dp
+	keyBytes, err := os.ReadFile(s.keyFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read attestation key file %s", s.keyFile)
+	}
+	return cp_attestation.NewStaticKeyEvidence(keyBytes, name, mesh, proxyType, core.Now())
+}
@@ -0,0 +1,113 @@
+package attestation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sethvargo/go-retry"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var log = core.Log.WithName("attestation")
+
+// TokenRenewer keeps a valid dataplane token in the file.
+// The token is renewed after 2/3 of its validity, so there is time to retry when the control plane is not available.
+// Envoy reads the file whenever it connects to the control plane.
+type TokenRenewer struct {
+	client       TokenClient
+	path         string
+	retryBackoff time.Duration
+	token        Token
+}
+
+var _ component.Component = &TokenRenewer{}
+
+func NewTokenRenewer(client TokenClient, path string, retryBackoff time.Duration) *TokenRenewer {
+	return &TokenRenewer{
+		client:       client,
+		path:         path,
+		retryBackoff: retryBackoff,
+	}
+}
+
+// Init fetches the first token, which is required to get the bootstrap configuration.
+func (r *TokenRenewer) Init(ctx context.Context, maxDuration time.Duration) error {
+	backoff := retry.WithMaxDuration(maxDuration, retry.NewConstant(r.retryBackoff))
+	return retry.Do(ctx, backoff, func(ctx context.Context) error {
+		if err := r.renew(ctx); err != nil {
+			log.Info("could not fetch a dataplane token. Retrying.", "backoff", r.retryBackoff, "err", err.Error())
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+func (r *TokenRenewer) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	for {
+		validity := r.token.ExpiresAt.Sub(r.token.IssuedAt)
+		renewIn := r.token.IssuedAt.Add(validity * 2 / 3).Sub(core.Now())
+		select {
+		case <-time.After(renewIn):
+		case <-stop:
+			return nil
+		}
+		for {
+			err := r.renew(ctx)
+			if err == nil {
+				break
+			}
+			log.Error(err, "could not renew the dataplane token. Retrying.", "backoff", r.retryBackoff, "expiresAt", r.token.ExpiresAt)
+			select {
+			case <-time.After(r.retryBackoff):
+			case <-stop:
+				return nil
+			}
+		}
+	}
+}
+
+func (r *TokenRenewer) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *TokenRenewer) renew(ctx context.Context) error {
+	token, err := r.client.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if err := writeAtomically(r.path, []byte(token.Value)); err != nil {
+		return errors.Wrapf(err, "could not write the dataplane token to %s", r.path)
+	}
+	r.token = token
+	log.V(1).Info("dataplane token renewed", "expiresAt", token.ExpiresAt)
+	return nil
+}
+
+// writeAtomically replaces the file, so Envoy never reads a partially written token.
+func writeAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
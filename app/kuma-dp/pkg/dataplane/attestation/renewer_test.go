@@ -0,0 +1,106 @@
+package attestation_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/attestation"
+)
+
+type sequenceTokenClient struct {
+	sync.Mutex
+	validity time.Duration
+	fetched  int
+	failing  bool
+}
+
+func (s *sequenceTokenClient) Fetch(context.Context) (attestation.Token, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.failing {
+		return attestation.Token{}, errors.New("control plane is not available")
+	}
+	s.fetched++
+	now := time.Now()
+	return attestation.Token{
+		Value:     fmt.Sprintf("token-%d", s.fetched),
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.validity),
+	}, nil
+}
+
+func (s *sequenceTokenClient) setFailing(failing bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.failing = failing
+}
+
+var _ = Describe("TokenRenewer", func() {
+
+	var path string
+	var client *sequenceTokenClient
+	var renewer *attestation.TokenRenewer
+	var stop chan struct{}
+
+	tokenInFile := func() string {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return ""
+		}
+		return string(content)
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "token")
+		client = &sequenceTokenClient{validity: 300 * time.Millisecond}
+		renewer = attestation.NewTokenRenewer(client, path, 10*time.Millisecond)
+		stop = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should write the token and renew it before it expires", func() {
+		// when
+		Expect(renewer.Init(context.Background(), time.Second)).To(Succeed())
+
+		// then
+		Expect(tokenInFile()).To(Equal("token-1"))
+
+		// when
+		go func() {
+			defer GinkgoRecover()
+			Expect(renewer.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(tokenInFile, "1s", "10ms").Should(Equal("token-2"))
+		Eventually(tokenInFile, "1s", "10ms").Should(Equal("token-3"))
+	})
+
+	It("should retry renewal when the control plane is not available", func() {
+		// given
+		Expect(renewer.Init(context.Background(), time.Second)).To(Succeed())
+		client.setFailing(true)
+		go func() {
+			defer GinkgoRecover()
+			Expect(renewer.Start(stop)).To(Succeed())
+		}()
+
+		// when
+		Consistently(tokenInFile, "400ms", "50ms").Should(Equal("token-1"))
+		client.setFailing(false)
+
+		// then
+		Eventually(tokenInFile, "1s", "10ms").Should(Equal("token-2"))
+	})
+})
@@ -125,10 +125,12 @@ func (b *remoteBootstrap) requestForBootstrap(url *net_url.URL, cfg kuma_dp.Conf
 		ProxyType: cfg.Dataplane.ProxyType,
 		// if not set in config, the 0 will be sent which will result in providing default admin port
 		// that is set in the control plane bootstrap params
-		AdminPort:         cfg.Dataplane.AdminPort.Lowest(),
-		DataplaneToken:    token,
-		DataplaneResource: dataplaneResource,
-		CaCert:            cfg.ControlPlane.CaCert,
+		AdminPort:      cfg.Dataplane.AdminPort.Lowest(),
+		DataplaneToken: token,
+		// the token issued on attestation is renewed in the file
+		DataplaneTokenPath: dataplaneTokenPath(cfg),
+		DataplaneResource:  dataplaneResource,
+		CaCert:             cfg.ControlPlane.CaCert,
 		Version: types.Version{
 			KumaDp: types.KumaDpVersion{
 				Version:   kuma_version.Build.Version,
@@ -177,3 +179,10 @@ func (b *remoteBootstrap) requestForBootstrap(url *net_url.URL, cfg kuma_dp.Conf
 	}
 	return respBytes, nil
 }
+
+func dataplaneTokenPath(cfg kuma_dp.Config) string {
+	if cfg.DataplaneRuntime.Attestation.Provider == "" {
+		return ""
+	}
+	return cfg.DataplaneRuntime.TokenPath
+}
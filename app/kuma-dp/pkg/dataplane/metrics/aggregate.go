@@ -0,0 +1,179 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/pkg/errors"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+const ApplicationLabelName = "kuma_application"
+
+// applicationsScrapeTimeout bounds the time spent on scraping applications
+// so that a misbehaving one doesn't stall the whole scrape.
+const applicationsScrapeTimeout = 5 * time.Second
+
+// ApplicationsFromBootstrap returns application metrics endpoints passed by
+// Control Plane in the node metadata of the bootstrap config.
+func ApplicationsFromBootstrap(bootstrap *envoy_bootstrap_v3.Bootstrap) ([]types.MetricsApplication, error) {
+	var applications []types.MetricsApplication
+	if _, err := nodeMetadata(bootstrap, types.MetricsApplicationsMetadataField, &applications); err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// nodeMetadata unmarshals the given field of the bootstrap node metadata.
+// It returns false if the field is not present.
+func nodeMetadata(bootstrap *envoy_bootstrap_v3.Bootstrap, field string, out interface{}) (bool, error) {
+	value, ok := bootstrap.GetNode().GetMetadata().GetFields()[field]
+	if !ok {
+		return false, nil
+	}
+	data, err := protojson.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return false, errors.Wrapf(err, "could not parse %q node metadata", field)
+	}
+	return true, nil
+}
+
+type applicationScrapeResult struct {
+	families map[string]*io_prometheus_client.MetricFamily
+	err      error
+}
+
+// scrapeApplications collects metrics from every application and returns
+// them as metric families labeled with the application name. Applications
+// are scraped concurrently within a shared deadline. Families whose
+// names are already exposed by Envoy are dropped, since Prometheus rejects
+// duplicated families in a single scrape.
+func scrapeApplications(ctx context.Context, applications []types.MetricsApplication, envoyFamilies map[string]bool) []*io_prometheus_client.MetricFamily {
+	ctx, cancel := context.WithTimeout(ctx, applicationsScrapeTimeout)
+	defer cancel()
+
+	results := make([]applicationScrapeResult, len(applications))
+	var wg sync.WaitGroup
+	for i, app := range applications {
+		wg.Add(1)
+		go func(i int, app types.MetricsApplication) {
+			defer wg.Done()
+			families, err := scrapeApplication(ctx, app)
+			results[i] = applicationScrapeResult{families: families, err: err}
+		}(i, app)
+	}
+	wg.Wait()
+
+	// results are merged in the order of applications, so the output does not depend on the order of responses
+	merged := map[string]*io_prometheus_client.MetricFamily{}
+	for i, app := range applications {
+		if err := results[i].err; err != nil {
+			logger.Error(err, "failed to scrape application metrics", "application", app.Name)
+			continue
+		}
+		for name, family := range results[i].families {
+			if envoyFamilies[name] {
+				logger.V(1).Info("skipping application metric family that conflicts with Envoy", "application", app.Name, "family", name)
+				continue
+			}
+			addApplicationLabel(family, app.Name)
+			existing, ok := merged[name]
+			if !ok {
+				merged[name] = family
+				continue
+			}
+			if existing.GetType() != family.GetType() {
+				logger.Info("skipping application metric family with conflicting type", "application", app.Name, "family", name)
+				continue
+			}
+			existing.Metric = append(existing.Metric, family.Metric...)
+		}
+	}
+
+	var names []string
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []*io_prometheus_client.MetricFamily
+	for _, name := range names {
+		result = append(result, merged[name])
+	}
+	return result
+}
+
+func scrapeApplication(ctx context.Context, app types.MetricsApplication) (map[string]*io_prometheus_client.MetricFamily, error) {
+	u := fmt.Sprintf("http://%s%s", net.JoinHostPort(app.Address, strconv.Itoa(int(app.Port))), app.Path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code %d from %s", resp.StatusCode, u)
+	}
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+func addApplicationLabel(family *io_prometheus_client.MetricFamily, application string) {
+	for _, metric := range family.Metric {
+		metric.Label = append(metric.Label, &io_prometheus_client.LabelPair{
+			Name:  proto.String(ApplicationLabelName),
+			Value: proto.String(application),
+		})
+	}
+}
+
+// familyNames returns the names of metric families present in the
+// Prometheus text exposition.
+func familyNames(in []byte) map[string]bool {
+	names := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(in))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 3 && fields[0] == "#" && fields[1] == "TYPE" {
+			names[fields[2]] = true
+		}
+	}
+	return names
+}
+
+func writeMetricFamilies(out io.Writer, families []*io_prometheus_client.MetricFamily) error {
+	for _, family := range families {
+		if _, err := expfmt.MetricFamilyToText(out, family); err != nil {
+			return err
+		}
+		if _, err := out.Write([]byte("\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var _ = Describe("Application metrics", func() {
+
+	It("should read applications from the bootstrap node metadata", func() {
+		// given
+		value, err := structpb.NewValue([]interface{}{
+			map[string]interface{}{
+				"name":    "app",
+				"address": "127.0.0.1",
+				"port":    8080,
+				"path":    "/metrics",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		bootstrap := &envoy_bootstrap_v3.Bootstrap{
+			Node: &envoy_core_v3.Node{
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						types.MetricsApplicationsMetadataField: value,
+					},
+				},
+			},
+		}
+
+		// when
+		applications, err := ApplicationsFromBootstrap(bootstrap)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applications).To(Equal([]types.MetricsApplication{{
+			Name:    "app",
+			Address: "127.0.0.1",
+			Port:    8080,
+			Path:    "/metrics",
+		}}))
+	})
+
+	It("should return no applications when metadata is missing", func() {
+		// when
+		applications, err := ApplicationsFromBootstrap(&envoy_bootstrap_v3.Bootstrap{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applications).To(BeEmpty())
+	})
+
+	It("should merge application metrics with Envoy metrics", func() {
+		// given
+		envoyAdmin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`# TYPE shared_total counter
+shared_total{} 1
+`))
+		}))
+		defer envoyAdmin.Close()
+		first := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`# TYPE requests_total counter
+requests_total{path="/"} 10
+# TYPE shared_total counter
+shared_total 2
+`))
+		}))
+		defer first.Close()
+		second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`# TYPE requests_total counter
+requests_total{path="/api"} 20
+# TYPE jvm_threads gauge
+jvm_threads 7
+`))
+		}))
+		defer second.Close()
+		broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer broken.Close()
+
+		hijacker := New(kumadp.Dataplane{Name: "dp", Mesh: "default"}, serverPort(envoyAdmin), []types.MetricsApplication{
+			application("first", first),
+			application("second", second),
+			application("broken", broken),
+		})
+
+		// when
+		recorder := httptest.NewRecorder()
+		hijacker.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		// then
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal(`# TYPE shared_total counter
+shared_total 1
+
+# TYPE jvm_threads gauge
+jvm_threads{kuma_application="second"} 7
+
+# TYPE requests_total counter
+requests_total{path="/",kuma_application="first"} 10
+requests_total{path="/api",kuma_application="second"} 20
+
+`))
+	})
+
+	It("should scrape applications concurrently", func() {
+		// given applications that respond only when both of them are scraped
+		var started sync.WaitGroup
+		started.Add(2)
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			started.Done()
+			started.Wait()
+			_, _ = w.Write([]byte(`# TYPE up gauge
+up 1
+`))
+		}
+		first := httptest.NewServer(http.HandlerFunc(handler))
+		defer first.Close()
+		second := httptest.NewServer(http.HandlerFunc(handler))
+		defer second.Close()
+
+		// when
+		families := scrapeApplications(context.Background(), []types.MetricsApplication{
+			application("first", first),
+			application("second", second),
+		}, map[string]bool{})
+
+		// then
+		Expect(families).To(HaveLen(1))
+		Expect(families[0].Metric).To(HaveLen(2))
+	})
+})
+
+func serverPort(server *httptest.Server) uint32 {
+	u, err := url.Parse(server.URL)
+	Expect(err).ToNot(HaveOccurred())
+	_, port, err := net.SplitHostPort(u.Host)
+	Expect(err).ToNot(HaveOccurred())
+	p, err := strconv.Atoi(port)
+	Expect(err).ToNot(HaveOccurred())
+	return uint32(p)
+}
+
+func application(name string, server *httptest.Server) types.MetricsApplication {
+	return types.MetricsApplication{
+		Name:    name,
+		Address: "127.0.0.1",
+		Port:    serverPort(server),
+		Path:    "/metrics",
+	}
+}
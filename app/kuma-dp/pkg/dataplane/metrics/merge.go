@@ -254,6 +254,9 @@ func getClusterName(metric *io_prometheus_client.Metric) (clusterName string, ok
 }
 
 // splitClusterMatch marches cluster names generated by envoy_names.GetSplitClusterName.
+// Control planes that set the stat name of split clusters with envoy_names.GetSplitClusterStatName
+// don't expose such names, their stats are reported under the service with the "kuma_split" tag.
+// The match is kept for proxies connected to an older control plane.
 var splitClusterMatch = regexp.MustCompile(`(?P<prefix>.*)-_[0-9]+_$`)
 
 // gwClusterMatch marches cluster names generated by DestinationClusterName.
@@ -0,0 +1,369 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/pkg/errors"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	otlp_collector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	otlp_common "go.opentelemetry.io/proto/otlp/common/v1"
+	otlp_metrics "go.opentelemetry.io/proto/otlp/metrics/v1"
+	otlp_resource "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var otelLogger = logger.WithName("opentelemetry")
+
+var _ component.Component = &OpenTelemetryExporter{}
+
+const instrumentationScopeName = "kuma-dp"
+
+// OpenTelemetryExporter periodically reads Envoy stats and pushes them
+// to an OpenTelemetry collector over OTLP.
+type OpenTelemetryExporter struct {
+	envoyAdminPort uint32
+	config         types.MetricsOpenTelemetry
+	interval       time.Duration
+	startTime      time.Time
+}
+
+func NewOpenTelemetryExporter(envoyAdminPort uint32, config types.MetricsOpenTelemetry) (*OpenTelemetryExporter, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse interval %q", config.Interval)
+	}
+	if interval <= 0 {
+		return nil, errors.Errorf("interval has to be positive, got %q", config.Interval)
+	}
+	return &OpenTelemetryExporter{
+		envoyAdminPort: envoyAdminPort,
+		config:         config,
+		interval:       interval,
+		startTime:      time.Now(),
+	}, nil
+}
+
+// OpenTelemetryFromBootstrap returns the configuration of pushing metrics
+// passed by Control Plane in the node metadata of the bootstrap config.
+func OpenTelemetryFromBootstrap(bootstrap *envoy_bootstrap_v3.Bootstrap) (*types.MetricsOpenTelemetry, error) {
+	var config types.MetricsOpenTelemetry
+	ok, err := nodeMetadata(bootstrap, types.MetricsOpenTelemetryMetadataField, &config)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (e *OpenTelemetryExporter) Start(stop <-chan struct{}) error {
+	creds, err := transportCredentials(e.config.TLS)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(e.config.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return errors.Wrapf(err, "could not dial OpenTelemetry collector %q", e.config.Endpoint)
+	}
+	defer conn.Close()
+	client := otlp_collector.NewMetricsServiceClient(conn)
+
+	otelLogger.Info("starting OpenTelemetry metrics exporter",
+		"endpoint", e.config.Endpoint,
+		"interval", e.interval,
+		"adminPort", e.envoyAdminPort,
+		"tls", e.config.TLS != nil,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := e.export(ctx, client); err != nil {
+				otelLogger.Error(err, "failed to export metrics", "endpoint", e.config.Endpoint)
+			}
+		case <-stop:
+			otelLogger.Info("stopping OpenTelemetry metrics exporter")
+			return nil
+		}
+	}
+}
+
+func (e *OpenTelemetryExporter) NeedLeaderElection() bool {
+	return false
+}
+
+// transportCredentials returns credentials of the connection to the collector,
+// which is not encrypted if TLS is not configured.
+func transportCredentials(cfg *types.MetricsOpenTelemetryTLS) (credentials.TransportCredentials, error) {
+	if cfg == nil {
+		return insecure.NewCredentials(), nil
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.SkipVerify, // it is explicitly requested by the user
+		MinVersion:         tls.VersionTLS12,
+	}
+	if cfg.CaCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.CaCert)) {
+			return nil, errors.New("could not parse CA certificate of OpenTelemetry collector")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func (e *OpenTelemetryExporter) export(ctx context.Context, client otlp_collector.MetricsServiceClient) error {
+	ctx, cancel := context.WithTimeout(ctx, e.interval)
+	defer cancel()
+
+	families, err := e.envoyMetricFamilies(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read Envoy stats")
+	}
+	request := &otlp_collector.ExportMetricsServiceRequest{
+		ResourceMetrics: []*otlp_metrics.ResourceMetrics{
+			toResourceMetrics(families, e.config.ResourceAttributes, e.startTime, time.Now()),
+		},
+	}
+	if _, err := client.Export(ctx, request); err != nil {
+		return errors.Wrap(err, "could not push metrics")
+	}
+	return nil
+}
+
+// The Envoy stats endpoint recognizes the "usedonly" and "filter" query
+// parameters, so only requested stats are read from Envoy.
+func (e *OpenTelemetryExporter) statsURL() string {
+	query := url.Values{}
+	if e.config.Filter != "" {
+		query.Set("filter", e.config.Filter)
+	}
+	if e.config.UsedOnly {
+		query.Set("usedonly", "")
+	}
+	return rewriteMetricsURL(e.envoyAdminPort, &url.URL{RawQuery: query.Encode()})
+}
+
+func (e *OpenTelemetryExporter) envoyMetricFamilies(ctx context.Context) ([]*io_prometheus_client.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.statsURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	buf := new(bytes.Buffer)
+	if err := MergeClusters(resp.Body, buf); err != nil {
+		return nil, err
+	}
+	var parser expfmt.TextParser
+	byName, err := parser.TextToMetricFamilies(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	families := make([]*io_prometheus_client.MetricFamily, 0, len(names))
+	for _, name := range names {
+		families = append(families, byName[name])
+	}
+	return families, nil
+}
+
+// toResourceMetrics converts Prometheus metric families into OTLP metrics.
+// Envoy counters and histograms are cumulative since the start of kuma-dp.
+func toResourceMetrics(
+	families []*io_prometheus_client.MetricFamily,
+	attributes map[string]string,
+	start time.Time,
+	now time.Time,
+) *otlp_metrics.ResourceMetrics {
+	startNano := uint64(start.UnixNano())
+	nowNano := uint64(now.UnixNano())
+
+	var metrics []*otlp_metrics.Metric
+	for _, family := range families {
+		metric := &otlp_metrics.Metric{
+			Name:        family.GetName(),
+			Description: family.GetHelp(),
+		}
+		switch family.GetType() {
+		case io_prometheus_client.MetricType_COUNTER:
+			var points []*otlp_metrics.NumberDataPoint
+			for _, m := range family.Metric {
+				points = append(points, &otlp_metrics.NumberDataPoint{
+					Attributes:        toLabelAttributes(m.Label),
+					StartTimeUnixNano: startNano,
+					TimeUnixNano:      nowNano,
+					Value:             &otlp_metrics.NumberDataPoint_AsDouble{AsDouble: m.GetCounter().GetValue()},
+				})
+			}
+			metric.Data = &otlp_metrics.Metric_Sum{
+				Sum: &otlp_metrics.Sum{
+					DataPoints:             points,
+					AggregationTemporality: otlp_metrics.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+					IsMonotonic:            true,
+				},
+			}
+		case io_prometheus_client.MetricType_GAUGE, io_prometheus_client.MetricType_UNTYPED:
+			var points []*otlp_metrics.NumberDataPoint
+			for _, m := range family.Metric {
+				value := m.GetGauge().GetValue()
+				if family.GetType() == io_prometheus_client.MetricType_UNTYPED {
+					value = m.GetUntyped().GetValue()
+				}
+				points = append(points, &otlp_metrics.NumberDataPoint{
+					Attributes:   toLabelAttributes(m.Label),
+					TimeUnixNano: nowNano,
+					Value:        &otlp_metrics.NumberDataPoint_AsDouble{AsDouble: value},
+				})
+			}
+			metric.Data = &otlp_metrics.Metric_Gauge{
+				Gauge: &otlp_metrics.Gauge{
+					DataPoints: points,
+				},
+			}
+		case io_prometheus_client.MetricType_HISTOGRAM:
+			var points []*otlp_metrics.HistogramDataPoint
+			for _, m := range family.Metric {
+				points = append(points, toHistogramDataPoint(m, startNano, nowNano))
+			}
+			metric.Data = &otlp_metrics.Metric_Histogram{
+				Histogram: &otlp_metrics.Histogram{
+					DataPoints:             points,
+					AggregationTemporality: otlp_metrics.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				},
+			}
+		case io_prometheus_client.MetricType_SUMMARY:
+			var points []*otlp_metrics.SummaryDataPoint
+			for _, m := range family.Metric {
+				var quantiles []*otlp_metrics.SummaryDataPoint_ValueAtQuantile
+				for _, q := range m.GetSummary().GetQuantile() {
+					quantiles = append(quantiles, &otlp_metrics.SummaryDataPoint_ValueAtQuantile{
+						Quantile: q.GetQuantile(),
+						Value:    q.GetValue(),
+					})
+				}
+				points = append(points, &otlp_metrics.SummaryDataPoint{
+					Attributes:        toLabelAttributes(m.Label),
+					StartTimeUnixNano: startNano,
+					TimeUnixNano:      nowNano,
+					Count:             m.GetSummary().GetSampleCount(),
+					Sum:               m.GetSummary().GetSampleSum(),
+					QuantileValues:    quantiles,
+				})
+			}
+			metric.Data = &otlp_metrics.Metric_Summary{
+				Summary: &otlp_metrics.Summary{
+					DataPoints: points,
+				},
+			}
+		default:
+			otelLogger.V(1).Info("skipping metric family of unsupported type", "family", family.GetName(), "type", family.GetType())
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return &otlp_metrics.ResourceMetrics{
+		Resource: &otlp_resource.Resource{
+			Attributes: toAttributes(attributes),
+		},
+		ScopeMetrics: []*otlp_metrics.ScopeMetrics{{
+			Scope: &otlp_common.InstrumentationScope{
+				Name:    instrumentationScopeName,
+				Version: kuma_version.Build.Version,
+			},
+			Metrics: metrics,
+		}},
+	}
+}
+
+// toHistogramDataPoint converts cumulative Prometheus buckets into OTLP
+// buckets, which hold counts of the bucket only. The last OTLP bucket
+// covers values above the highest bound.
+func toHistogramDataPoint(m *io_prometheus_client.Metric, startNano uint64, nowNano uint64) *otlp_metrics.HistogramDataPoint {
+	histogram := m.GetHistogram()
+	var bounds []float64
+	var counts []uint64
+	var previous uint64
+	for _, bucket := range histogram.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), +1) {
+			continue
+		}
+		bounds = append(bounds, bucket.GetUpperBound())
+		counts = append(counts, bucket.GetCumulativeCount()-previous)
+		previous = bucket.GetCumulativeCount()
+	}
+	counts = append(counts, histogram.GetSampleCount()-previous)
+
+	sum := histogram.GetSampleSum()
+	return &otlp_metrics.HistogramDataPoint{
+		Attributes:        toLabelAttributes(m.Label),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Count:             histogram.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+	}
+}
+
+func toLabelAttributes(pairs []*io_prometheus_client.LabelPair) []*otlp_common.KeyValue {
+	var attributes []*otlp_common.KeyValue
+	for _, pair := range pairs {
+		attributes = append(attributes, stringAttribute(pair.GetName(), pair.GetValue()))
+	}
+	return attributes
+}
+
+func toAttributes(attributes map[string]string) []*otlp_common.KeyValue {
+	var keys []string
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var result []*otlp_common.KeyValue
+	for _, key := range keys {
+		result = append(result, stringAttribute(key, attributes[key]))
+	}
+	return result
+}
+
+func stringAttribute(key string, value string) *otlp_common.KeyValue {
+	return &otlp_common.KeyValue{
+		Key: key,
+		Value: &otlp_common.AnyValue{
+			Value: &otlp_common.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
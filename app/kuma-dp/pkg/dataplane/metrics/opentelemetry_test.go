@@ -0,0 +1,242 @@
+package metrics
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	otlp_collector "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+type fakeMetricsService struct {
+	otlp_collector.UnimplementedMetricsServiceServer
+	requests chan *otlp_collector.ExportMetricsServiceRequest
+}
+
+func (f *fakeMetricsService) Export(_ context.Context, req *otlp_collector.ExportMetricsServiceRequest) (*otlp_collector.ExportMetricsServiceResponse, error) {
+	f.requests <- req
+	return &otlp_collector.ExportMetricsServiceResponse{}, nil
+}
+
+var _ = Describe("OpenTelemetry metrics", func() {
+
+	It("should convert Prometheus metric families into OTLP metrics", func() {
+		// given
+		var parser expfmt.TextParser
+		byName, err := parser.TextToMetricFamilies(strings.NewReader(`# TYPE envoy_cluster_upstream_rq_total counter
+envoy_cluster_upstream_rq_total{envoy_cluster_name="backend"} 7
+# TYPE envoy_server_live gauge
+envoy_server_live 1
+# TYPE envoy_cluster_upstream_rq_time histogram
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="backend",le="0.5"} 1
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="backend",le="1"} 3
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="backend",le="+Inf"} 4
+envoy_cluster_upstream_rq_time_sum{envoy_cluster_name="backend"} 5.5
+envoy_cluster_upstream_rq_time_count{envoy_cluster_name="backend"} 4
+`))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resourceMetrics := toResourceMetrics(
+			[]*io_prometheus_client.MetricFamily{
+				byName["envoy_cluster_upstream_rq_total"],
+				byName["envoy_server_live"],
+				byName["envoy_cluster_upstream_rq_time"],
+			},
+			map[string]string{
+				"kuma.io/service": "backend",
+				"kuma.io/mesh":    "default",
+			},
+			time.Unix(1, 0),
+			time.Unix(2, 0),
+		)
+		resourceMetrics.ScopeMetrics[0].Scope.Version = ""
+
+		// then
+		actual, err := util_proto.ToYAML(resourceMetrics)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+            resource:
+              attributes:
+              - key: kuma.io/mesh
+                value:
+                  stringValue: default
+              - key: kuma.io/service
+                value:
+                  stringValue: backend
+            scopeMetrics:
+            - scope:
+                name: kuma-dp
+              metrics:
+              - name: envoy_cluster_upstream_rq_total
+                sum:
+                  aggregationTemporality: AGGREGATION_TEMPORALITY_CUMULATIVE
+                  isMonotonic: true
+                  dataPoints:
+                  - attributes:
+                    - key: envoy_cluster_name
+                      value:
+                        stringValue: backend
+                    startTimeUnixNano: "1000000000"
+                    timeUnixNano: "2000000000"
+                    asDouble: 7
+              - name: envoy_server_live
+                gauge:
+                  dataPoints:
+                  - timeUnixNano: "2000000000"
+                    asDouble: 1
+              - name: envoy_cluster_upstream_rq_time
+                histogram:
+                  aggregationTemporality: AGGREGATION_TEMPORALITY_CUMULATIVE
+                  dataPoints:
+                  - attributes:
+                    - key: envoy_cluster_name
+                      value:
+                        stringValue: backend
+                    startTimeUnixNano: "1000000000"
+                    timeUnixNano: "2000000000"
+                    count: "4"
+                    sum: 5.5
+                    bucketCounts: ["1", "2", "1"]
+                    explicitBounds: [0.5, 1]
+`))
+	})
+
+	It("should push filtered Envoy stats to the collector", func() {
+		// given
+		queries := make(chan string, 10)
+		envoyAdmin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			queries <- req.URL.RawQuery
+			_, _ = w.Write([]byte(`# TYPE envoy_cluster_upstream_rq_total counter
+envoy_cluster_upstream_rq_total{envoy_cluster_name="backend-_0_"} 7
+`))
+		}))
+		defer envoyAdmin.Close()
+
+		// and
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		collector := &fakeMetricsService{
+			requests: make(chan *otlp_collector.ExportMetricsServiceRequest, 10),
+		}
+		server := grpc.NewServer()
+		otlp_collector.RegisterMetricsServiceServer(server, collector)
+		go func() {
+			_ = server.Serve(lis)
+		}()
+		defer server.Stop()
+
+		// and
+		exporter, err := NewOpenTelemetryExporter(serverPort(envoyAdmin), types.MetricsOpenTelemetry{
+			Endpoint: lis.Addr().String(),
+			Interval: "50ms",
+			Filter:   "^cluster",
+			UsedOnly: true,
+			ResourceAttributes: map[string]string{
+				"kuma.io/service": "backend",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		stop := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- exporter.Start(stop)
+		}()
+
+		// then
+		var req *otlp_collector.ExportMetricsServiceRequest
+		Eventually(collector.requests, "5s").Should(Receive(&req))
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+
+		// and
+		Expect(<-queries).To(Equal("filter=%5Ecluster&usedonly="))
+		metrics := req.ResourceMetrics[0].ScopeMetrics[0].Metrics
+		Expect(metrics).To(HaveLen(1))
+		Expect(metrics[0].GetSum().GetDataPoints()[0].GetAttributes()[0].GetValue().GetStringValue()).To(Equal("backend"))
+		Expect(req.ResourceMetrics[0].Resource.Attributes[0].GetKey()).To(Equal("kuma.io/service"))
+	})
+
+	It("should push Envoy stats to the collector over TLS", func() {
+		// given
+		envoyAdmin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`# TYPE envoy_server_live gauge
+envoy_server_live 1
+`))
+		}))
+		defer envoyAdmin.Close()
+
+		// and
+		keyPair, err := util_tls.NewSelfSignedCert("otel-collector", util_tls.ServerCertType, util_tls.DefaultKeyType, "otel-collector")
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := tls.X509KeyPair(keyPair.CertPEM, keyPair.KeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		collector := &fakeMetricsService{
+			requests: make(chan *otlp_collector.ExportMetricsServiceRequest, 10),
+		}
+		server := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+		otlp_collector.RegisterMetricsServiceServer(server, collector)
+		go func() {
+			_ = server.Serve(lis)
+		}()
+		defer server.Stop()
+
+		// and
+		exporter, err := NewOpenTelemetryExporter(serverPort(envoyAdmin), types.MetricsOpenTelemetry{
+			Endpoint: lis.Addr().String(),
+			Interval: "50ms",
+			TLS: &types.MetricsOpenTelemetryTLS{
+				CaCert:     string(keyPair.CertPEM),
+				ServerName: "otel-collector",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		stop := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- exporter.Start(stop)
+		}()
+
+		// then
+		var req *otlp_collector.ExportMetricsServiceRequest
+		Eventually(collector.requests, "5s").Should(Receive(&req))
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+
+		// and
+		Expect(req.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].GetName()).To(Equal("envoy_server_live"))
+	})
+
+	It("should reject invalid interval", func() {
+		// when
+		_, err := NewOpenTelemetryExporter(9901, types.MetricsOpenTelemetry{
+			Endpoint: "otel-collector:4317",
+			Interval: "0s",
+		})
+
+		// then
+		Expect(err).To(MatchError(`interval has to be positive, got "0s"`))
+	})
+})
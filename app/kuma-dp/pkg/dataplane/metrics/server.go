@@ -14,6 +14,7 @@ import (
 	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
 )
 
@@ -24,12 +25,14 @@ var _ component.Component = &Hijacker{}
 type Hijacker struct {
 	envoyAdminPort uint32
 	socketPath     string
+	applications   []types.MetricsApplication
 }
 
-func New(dataplane kumadp.Dataplane, envoyAdminPort uint32) *Hijacker {
+func New(dataplane kumadp.Dataplane, envoyAdminPort uint32, applications []types.MetricsApplication) *Hijacker {
 	return &Hijacker{
 		envoyAdminPort: envoyAdminPort,
 		socketPath:     envoy.MetricsHijackerSocketName(dataplane.Name, dataplane.Mesh),
+		applications:   applications,
 	}
 }
 
@@ -60,6 +63,7 @@ func (s *Hijacker) Start(stop <-chan struct{}) error {
 	logger.Info("starting Metrics Hijacker Server",
 		"socketPath", fmt.Sprintf("unix://%s", s.socketPath),
 		"adminPort", s.envoyAdminPort,
+		"applications", len(s.applications),
 	)
 
 	server := &http.Server{
@@ -110,6 +114,14 @@ func (s *Hijacker) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if len(s.applications) > 0 {
+		families := scrapeApplications(req.Context(), s.applications, familyNames(buf.Bytes()))
+		if err := writeMetricFamilies(buf, families); err != nil {
+			http.Error(writer, err.Error(), 500)
+			return
+		}
+	}
+
 	if _, err := writer.Write(buf.Bytes()); err != nil {
 		logger.Error(err, "error while writing the response")
 	}
@@ -0,0 +1,107 @@
+package workloadapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	net_url "net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/xds/identity"
+)
+
+// ErrNoIdentity is returned when the control plane does not issue certificates for the dataplane, because mTLS is disabled in the Mesh.
+var ErrNoIdentity = errors.New("mTLS is not enabled in the mesh")
+
+type IdentityClient interface {
+	Fetch(ctx context.Context) (*Identity, error)
+}
+
+func NewIdentityClient(cfg kuma_dp.Config) (IdentityClient, error) {
+	url, err := net_url.Parse(cfg.ControlPlane.URL)
+	if err != nil {
+		return nil, err
+	}
+	url.Path = identity.Path
+
+	// the same as for the bootstrap request, the control plane is verified only if the CA cert is provided
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if cfg.ControlPlane.CaCert != "" {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(cfg.ControlPlane.CaCert)); !ok {
+			return nil, errors.New("could not add certificate")
+		}
+		tlsConfig = &tls.Config{RootCAs: certPool}
+	}
+	return &httpIdentityClient{
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		url:       url.String(),
+		tokenPath: cfg.DataplaneRuntime.TokenPath,
+		request: identity.WorkloadIdentityRequest{
+			Mesh: cfg.Dataplane.Mesh,
+			Name: cfg.Dataplane.Name,
+		},
+	}, nil
+}
+
+type httpIdentityClient struct {
+	client *http.Client
+	url    string
+	// tokenPath is read on every request, because the token may be renewed in the background
+	tokenPath string
+	request   identity.WorkloadIdentityRequest
+}
+
+var _ IdentityClient = &httpIdentityClient{}
+
+func (h *httpIdentityClient) Fetch(ctx context.Context) (*Identity, error) {
+	jsonBytes, err := json.Marshal(h.request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request to json")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	if h.tokenPath != "" {
+		token, err := os.ReadFile(h.tokenPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the dataplane token")
+		}
+		req.Header.Set("authorization", strings.TrimSpace(string(token)))
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "request to the control plane failed")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the body of the response")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusConflict:
+		return nil, ErrNoIdentity
+	default:
+		return nil, errors.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+	response := identity.WorkloadIdentityResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	return NewIdentity(response)
+}
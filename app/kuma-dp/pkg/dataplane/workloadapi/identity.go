@@ -0,0 +1,151 @@
+package workloadapi
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/pkg/errors"
+	workload_proto "github.com/spiffe/go-spiffe/proto/spiffe/workload"
+
+	"github.com/kumahq/kuma/pkg/xds/identity"
+)
+
+// Identity is the X.509 SVID of the dataplane with the trust bundle of the Mesh in the format of the Workload API.
+type Identity struct {
+	SpiffeIDs []string
+	// CertChain is ASN.1 DER encoded certificate chain, the SVID comes first
+	CertChain []byte
+	// PrivateKey is ASN.1 DER encoded PKCS#8 private key
+	PrivateKey []byte
+	// Bundle is ASN.1 DER encoded certificates of CAs trusted in the Mesh
+	Bundle []byte
+	// Crls are ASN.1 DER encoded revocation lists
+	Crls [][]byte
+
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+func NewIdentity(response identity.WorkloadIdentityResponse) (*Identity, error) {
+	certChain, err := decodePEM(response.CertChain, "CERTIFICATE")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode the certificate chain")
+	}
+	if len(certChain) == 0 {
+		return nil, errors.New("certificate chain is empty")
+	}
+	cert, err := x509.ParseCertificate(certChain[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse the certificate")
+	}
+	var spiffeIDs []string
+	for _, uri := range cert.URIs {
+		if uri.Scheme == "spiffe" {
+			spiffeIDs = append(spiffeIDs, uri.String())
+		}
+	}
+	if len(spiffeIDs) == 0 {
+		return nil, errors.New("certificate does not contain SPIFFE ID")
+	}
+	key, err := toPKCS8(response.PrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert the private key")
+	}
+	bundle, err := decodePEM(response.TrustBundle, "CERTIFICATE")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode the trust bundle")
+	}
+	crls, err := decodePEM(response.Crls, "X509 CRL")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode the revocation lists")
+	}
+	return &Identity{
+		SpiffeIDs:  spiffeIDs,
+		CertChain:  bytes.Join(certChain, nil),
+		PrivateKey: key,
+		Bundle:     bytes.Join(bundle, nil),
+		Crls:       crls,
+		NotBefore:  cert.NotBefore,
+		NotAfter:   cert.NotAfter,
+	}, nil
+}
+
+// Equal returns true if both identities contain the same certificates, so the clients don't have to be notified.
+func (i *Identity) Equal(other *Identity) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+	if !bytes.Equal(i.CertChain, other.CertChain) || !bytes.Equal(i.Bundle, other.Bundle) || len(i.Crls) != len(other.Crls) {
+		return false
+	}
+	for idx := range i.Crls {
+		if !bytes.Equal(i.Crls[idx], other.Crls[idx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// X509SVIDResponse returns an entry for every SPIFFE ID of the dataplane.
+// Kuma issues a single certificate with SPIFFE IDs of all services of the dataplane, so the entries share the certificate.
+func (i *Identity) X509SVIDResponse() *workload_proto.X509SVIDResponse {
+	response := &workload_proto.X509SVIDResponse{
+		Crl: i.Crls,
+	}
+	for _, id := range i.SpiffeIDs {
+		response.Svids = append(response.Svids, &workload_proto.X509SVID{
+			SpiffeId:    id,
+			X509Svid:    i.CertChain,
+			X509SvidKey: i.PrivateKey,
+			Bundle:      i.Bundle,
+		})
+	}
+	return response
+}
+
+func decodePEM(data []byte, blockType string) ([][]byte, error) {
+	var result [][]byte
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != blockType {
+			return nil, errors.Errorf("unexpected PEM block %q", block.Type)
+		}
+		result = append(result, block.Bytes)
+	}
+	if len(bytes.TrimSpace(data)) != 0 {
+		return nil, errors.New("could not decode PEM")
+	}
+	return result, nil
+}
+
+// toPKCS8 converts the PEM encoded private key to DER encoded PKCS#8 required by the Workload API.
+func toPKCS8(keyPEM []byte) ([]byte, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("could not decode PEM")
+	}
+	switch block.Type {
+	case "PRIVATE KEY":
+		return block.Bytes, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return x509.MarshalPKCS8PrivateKey(key)
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return x509.MarshalPKCS8PrivateKey(key)
+	default:
+		return nil, errors.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
@@ -0,0 +1,46 @@
+package workloadapi
+
+import (
+	"net"
+	"os/user"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// LookupSocketGroup returns the ID of the group given by the name or the ID, or NoSocketGroup when the group is empty.
+func LookupSocketGroup(group string) (int, error) {
+	if group == "" {
+		return NoSocketGroup, nil
+	}
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not find group %q of the socket", group)
+	}
+	return strconv.Atoi(g.Gid)
+}
+
+// peerCheckingListener closes connections of peers other than the user of kuma-dp, root and users of the group.
+type peerCheckingListener struct {
+	net.Listener
+	uid int
+	gid int
+}
+
+func (l *peerCheckingListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if err := l.checkPeer(conn); err != nil {
+			logger.Info("rejected connection to the Workload API", "reason", err.Error())
+			_ = conn.Close()
+			continue
+		}
+		return conn, nil
+	}
+}
@@ -0,0 +1,39 @@
+//go:build linux
+// +build linux
+
+package workloadapi
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// checkPeer compares the group with the primary group of the peer from SO_PEERCRED.
+// Supplementary groups of the peer are not checked.
+func (l *peerCheckingListener) checkPeer(conn net.Conn) error {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return errors.New("connection is not a Unix socket connection")
+	}
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var cred *unix.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return err
+	}
+	if credErr != nil {
+		return errors.Wrap(credErr, "could not get credentials of the peer")
+	}
+	uid, gid := int(cred.Uid), int(cred.Gid)
+	if uid == 0 || uid == l.uid || (l.gid != NoSocketGroup && gid == l.gid) {
+		return nil
+	}
+	return errors.Errorf("peer with uid %d and gid %d is not allowed", uid, gid)
+}
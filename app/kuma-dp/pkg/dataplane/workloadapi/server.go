@@ -0,0 +1,244 @@
+package workloadapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	workload_proto "github.com/spiffe/go-spiffe/proto/spiffe/workload"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var logger = core.Log.WithName("workload-api-server")
+
+const (
+	// securityHeader has to be sent by every client, see https://github.com/spiffe/spiffe/blob/main/standards/SPIFFE_Workload_Endpoint.md
+	securityHeader = "workload.spiffe.io"
+	// maxRefreshInterval bounds how late a certificate reissued by the control plane before its renewal time (e.g. after revocation) is noticed.
+	maxRefreshInterval = 5 * time.Minute
+	// minRefreshInterval applies when the renewal time has passed. The control plane reissues the certificate when it's requested
+	// after the renewal time, so the new certificate is expected on the next fetch.
+	minRefreshInterval = 1 * time.Second
+)
+
+// NoSocketGroup restricts the socket to the user of kuma-dp.
+const NoSocketGroup = -1
+
+// Server serves the identity of the dataplane over SPIFFE Workload API, so the application can use it for traffic that does not go through Envoy.
+// The identity is fetched from the control plane, which issues it from its cache of dataplane certificates. The cache is kept
+// by every instance of the control plane, so the certificate is not necessarily the one delivered to Envoy over SDS
+// and it's renewed independently, but it has the same identity and is signed by the same CA.
+type Server struct {
+	socketPath   string
+	socketGID    int
+	client       IdentityClient
+	retryBackoff time.Duration
+
+	sync.RWMutex
+	identity   *Identity
+	noIdentity bool
+	// changed is closed and replaced whenever the identity changes
+	changed chan struct{}
+}
+
+var _ component.Component = &Server{}
+var _ workload_proto.SpiffeWorkloadAPIServer = &Server{}
+
+// NewServer creates the server that is accessible only to the user of kuma-dp, root and,
+// unless socketGID is NoSocketGroup, to processes whose primary group is socketGID.
+// Supplementary groups of the peer are not checked.
+func NewServer(socketPath string, socketGID int, client IdentityClient, retryBackoff time.Duration) *Server {
+	return &Server{
+		socketPath:   socketPath,
+		socketGID:    socketGID,
+		client:       client,
+		retryBackoff: retryBackoff,
+		changed:      make(chan struct{}),
+	}
+}
+
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Server) Start(stop <-chan struct{}) error {
+	if err := os.Remove(s.socketPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "could not remove stale socket %s", s.socketPath)
+	}
+	lis, err := s.listen()
+	if err != nil {
+		return err
+	}
+	defer lis.Close()
+
+	server := grpc.NewServer()
+	workload_proto.RegisterSpiffeWorkloadAPIServer(server, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.refreshLoop(ctx)
+
+	logger.Info("starting Workload API Server", "address", fmt.Sprintf("unix://%s", s.socketPath))
+	errCh := make(chan error, 1)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			errCh <- err
+		}
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-stop:
+		logger.Info("stopping Workload API Server")
+		server.Stop() // streams of FetchX509SVID never end, so there is no point in graceful stop
+		return nil
+	}
+}
+
+// listen creates the socket that can be accessed only by the allowed users.
+// Permissions of the socket are set after it's created, so peers are also verified when the connection is accepted.
+func (s *Server) listen() (net.Listener, error) {
+	lis, err := net.Listen("unix", s.socketPath)
+	if err != nil {
+		return nil, err
+	}
+	mode := os.FileMode(0600)
+	if s.socketGID != NoSocketGroup {
+		mode = 0660
+		if err := os.Chown(s.socketPath, -1, s.socketGID); err != nil {
+			lis.Close()
+			return nil, errors.Wrapf(err, "could not change the group of socket %s", s.socketPath)
+		}
+	}
+	if err := os.Chmod(s.socketPath, mode); err != nil {
+		lis.Close()
+		return nil, errors.Wrapf(err, "could not change permissions of socket %s", s.socketPath)
+	}
+	return &peerCheckingListener{
+		Listener: lis,
+		uid:      os.Getuid(),
+		gid:      s.socketGID,
+	}, nil
+}
+
+func (s *Server) refreshLoop(ctx context.Context) {
+	for {
+		next := s.refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next):
+		}
+	}
+}
+
+// refresh fetches the identity and returns the duration after which it should be fetched again.
+func (s *Server) refresh(ctx context.Context) time.Duration {
+	identity, err := s.client.Fetch(ctx)
+	switch {
+	case errors.Is(err, ErrNoIdentity):
+		s.update(nil, true)
+		return maxRefreshInterval
+	case err != nil:
+		logger.Error(err, "could not fetch the identity of the dataplane, retrying", "backoff", s.retryBackoff)
+		return s.retryBackoff
+	}
+	s.update(identity, false)
+	return nextRefresh(identity, core.Now())
+}
+
+// nextRefresh returns the time when the control plane is expected to reissue the certificate,
+// which happens after 4/5 of its lifetime.
+func nextRefresh(identity *Identity, now time.Time) time.Duration {
+	lifetime := identity.NotAfter.Sub(identity.NotBefore)
+	next := identity.NotBefore.Add(lifetime / 5 * 4).Sub(now)
+	if next > maxRefreshInterval {
+		return maxRefreshInterval
+	}
+	if next < minRefreshInterval {
+		return minRefreshInterval
+	}
+	return next
+}
+
+func (s *Server) update(identity *Identity, noIdentity bool) {
+	s.Lock()
+	defer s.Unlock()
+	if s.noIdentity == noIdentity && s.identity.Equal(identity) {
+		return
+	}
+	if identity != nil {
+		logger.Info("identity of the dataplane changed", "spiffeIDs", identity.SpiffeIDs, "expiration", identity.NotAfter)
+	}
+	s.identity = identity
+	s.noIdentity = noIdentity
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *Server) current() (*Identity, bool, <-chan struct{}) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.identity, s.noIdentity, s.changed
+}
+
+func (s *Server) FetchX509SVID(_ *workload_proto.X509SVIDRequest, stream workload_proto.SpiffeWorkloadAPI_FetchX509SVIDServer) error {
+	if err := checkSecurityHeader(stream.Context()); err != nil {
+		return err
+	}
+	for {
+		identity, noIdentity, changed := s.current()
+		switch {
+		case identity != nil:
+			if err := stream.Send(identity.X509SVIDResponse()); err != nil {
+				return err
+			}
+		case noIdentity:
+			return status.Error(codes.PermissionDenied, ErrNoIdentity.Error())
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *Server) FetchJWTSVID(ctx context.Context, _ *workload_proto.JWTSVIDRequest) (*workload_proto.JWTSVIDResponse, error) {
+	if err := checkSecurityHeader(ctx); err != nil {
+		return nil, err
+	}
+	return nil, status.Error(codes.Unimplemented, "JWT-SVIDs are not supported")
+}
+
+func (s *Server) FetchJWTBundles(_ *workload_proto.JWTBundlesRequest, stream workload_proto.SpiffeWorkloadAPI_FetchJWTBundlesServer) error {
+	if err := checkSecurityHeader(stream.Context()); err != nil {
+		return err
+	}
+	return status.Error(codes.Unimplemented, "JWT-SVIDs are not supported")
+}
+
+func (s *Server) ValidateJWTSVID(ctx context.Context, _ *workload_proto.ValidateJWTSVIDRequest) (*workload_proto.ValidateJWTSVIDResponse, error) {
+	if err := checkSecurityHeader(ctx); err != nil {
+		return nil, err
+	}
+	return nil, status.Error(codes.Unimplemented, "JWT-SVIDs are not supported")
+}
+
+func checkSecurityHeader(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(securityHeader)) != 1 || md.Get(securityHeader)[0] != "true" {
+		return status.Errorf(codes.InvalidArgument, "security header %q is missing", securityHeader)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package workloadapi_test
+
+import (
+	"context"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	workload_proto "github.com/spiffe/go-spiffe/proto/spiffe/workload"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/workloadapi"
+	"github.com/kumahq/kuma/pkg/core/ca/issuer"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/xds/identity"
+)
+
+type staticIdentityClient struct {
+	sync.Mutex
+	identity *workloadapi.Identity
+	err      error
+}
+
+func (s *staticIdentityClient) Fetch(context.Context) (*workloadapi.Identity, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.identity, s.err
+}
+
+func (s *staticIdentityClient) set(identity *workloadapi.Identity, err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.identity = identity
+	s.err = err
+}
+
+func newIdentity(ca util_tls.KeyPair, expiration time.Duration, services ...string) *workloadapi.Identity {
+	pair, err := issuer.NewWorkloadCert(ca, "default", mesh_proto.MultiValueTagSet{
+		mesh_proto.ServiceTag: func() map[string]bool {
+			values := map[string]bool{}
+			for _, service := range services {
+				values[service] = true
+			}
+			return values
+		}(),
+	}, util_tls.ECDSAKeyType, issuer.WithExpirationTime(expiration))
+	Expect(err).ToNot(HaveOccurred())
+	id, err := workloadapi.NewIdentity(identity.WorkloadIdentityResponse{
+		CertChain:   pair.CertPEM,
+		PrivateKey:  pair.KeyPEM,
+		TrustBundle: ca.CertPEM,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	return id
+}
+
+var _ = Describe("Workload API Server", func() {
+
+	var ca util_tls.KeyPair
+	var client *staticIdentityClient
+	var stop chan struct{}
+	var conn *grpc.ClientConn
+	var socketPath string
+
+	BeforeEach(func() {
+		var err error
+		ca, err = util_tls.NewSelfSignedCert("ca", util_tls.ServerCertType, util_tls.ECDSAKeyType)
+		Expect(err).ToNot(HaveOccurred())
+		client = &staticIdentityClient{}
+
+		socketPath = filepath.Join(GinkgoT().TempDir(), "workload.sock")
+		server := workloadapi.NewServer(socketPath, workloadapi.NoSocketGroup, client, 10*time.Millisecond)
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Start(stop)).To(Succeed())
+		}()
+
+		conn, err = grpc.Dial("unix://"+socketPath, grpc.WithInsecure())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		close(stop)
+	})
+
+	fetch := func(ctx context.Context) workload_proto.SpiffeWorkloadAPI_FetchX509SVIDClient {
+		ctx = metadata.AppendToOutgoingContext(ctx, "workload.spiffe.io", "true")
+		stream, err := workload_proto.NewSpiffeWorkloadAPIClient(conn).FetchX509SVID(ctx, &workload_proto.X509SVIDRequest{}, grpc.WaitForReady(true))
+		Expect(err).ToNot(HaveOccurred())
+		return stream
+	}
+
+	It("should restrict the socket to the user of kuma-dp", func() {
+		// given the server serves requests, so the socket is set up
+		client.set(newIdentity(ca, time.Hour, "backend"), nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := fetch(ctx).Recv()
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		info, err := os.Stat(socketPath)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("should serve SVID of every service and send updates", func() {
+		// given
+		// the certificate is past its renewal time, so it is fetched again right away
+		client.set(newIdentity(ca, time.Second, "backend", "backend-admin"), nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// when
+		stream := fetch(ctx)
+		resp, err := stream.Recv()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Svids).To(HaveLen(2))
+		Expect(resp.Svids[0].SpiffeId).To(Equal("spiffe://default/backend"))
+		Expect(resp.Svids[1].SpiffeId).To(Equal("spiffe://default/backend-admin"))
+		cert, err := x509.ParseCertificate(resp.Svids[0].X509Svid)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = x509.ParsePKCS8PrivateKey(resp.Svids[0].X509SvidKey)
+		Expect(err).ToNot(HaveOccurred())
+		bundle, err := x509.ParseCertificates(resp.Svids[0].Bundle)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundle).To(HaveLen(1))
+		Expect(cert.CheckSignatureFrom(bundle[0])).To(Succeed())
+
+		// when the certificate is reissued
+		client.set(newIdentity(ca, time.Hour, "backend"), nil)
+		resp, err = stream.Recv()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Svids).To(HaveLen(1))
+		newCert, err := x509.ParseCertificate(resp.Svids[0].X509Svid)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newCert.SerialNumber).ToNot(Equal(cert.SerialNumber))
+	})
+
+	It("should deny clients when mTLS is disabled", func() {
+		// given
+		client.set(nil, workloadapi.ErrNoIdentity)
+
+		// when
+		_, err := fetch(context.Background()).Recv()
+
+		// then
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should require security header", func() {
+		// given
+		client.set(newIdentity(ca, time.Hour, "backend"), nil)
+
+		// when
+		stream, err := workload_proto.NewSpiffeWorkloadAPIClient(conn).FetchX509SVID(context.Background(), &workload_proto.X509SVIDRequest{}, grpc.WaitForReady(true))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = stream.Recv()
+
+		// then
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
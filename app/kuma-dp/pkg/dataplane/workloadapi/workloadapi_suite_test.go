@@ -0,0 +1,11 @@
+package workloadapi_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestWorkloadAPI(t *testing.T) {
+	test.RunSpecs(t, "Workload API Suite")
+}
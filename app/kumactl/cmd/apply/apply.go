@@ -16,6 +16,7 @@ import (
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -59,6 +60,9 @@ name: demo
 
 Apply a resource from external URL
 $ kumactl apply -f https://example.com/resource.yaml
+
+Apply several resources separated by "---". Either all of them are applied or none
+$ kumactl apply -f resources.yaml
 `,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -125,8 +129,8 @@ $ kumactl apply -f https://example.com/resource.yaml
 				}
 				resources = append(resources, res)
 			}
-			for _, resource := range resources {
-				if ctx.args.dryRun {
+			if ctx.args.dryRun {
+				for _, resource := range resources {
 					p, err := printers.NewGenericPrinter(output.YAMLFormat)
 					if err != nil {
 						return err
@@ -134,15 +138,28 @@ $ kumactl apply -f https://example.com/resource.yaml
 					if err := p.Print(rest_types.From.Resource(resource), cmd.OutOrStdout()); err != nil {
 						return err
 					}
-				} else {
-					rs, err := pctx.CurrentResourceStore()
-					if err != nil {
-						return err
-					}
-
-					if err := upsert(pctx.Runtime.Registry, rs, resource); err != nil {
-						return err
-					}
+				}
+				return nil
+			}
+			if len(resources) > 1 {
+				// apply all resources atomically so a failing one does not leave the mesh half-configured
+				batchClient, err := pctx.CurrentBatchClient()
+				if err != nil {
+					return err
+				}
+				err = batchClient.Apply(context.Background(), resources)
+				if err != kumactl_resources.ErrBatchNotSupported {
+					return err
+				}
+				cmd.PrintErrln("Control Plane does not support applying resources in a batch. Resources are applied one by one.")
+			}
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			for _, resource := range resources {
+				if err := upsert(pctx.Runtime.Registry, rs, resource); err != nil {
+					return err
 				}
 			}
 			return nil
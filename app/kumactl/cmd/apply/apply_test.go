@@ -19,6 +19,7 @@ import (
 	"github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
@@ -33,11 +34,31 @@ import (
 	"github.com/kumahq/kuma/pkg/util/test"
 )
 
+type fakeBatchClient struct {
+	store   core_store.ResourceStore
+	batches [][]core_model.Resource
+	err     error
+}
+
+func (f *fakeBatchClient) Apply(ctx context.Context, resources []core_model.Resource) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.batches = append(f.batches, resources)
+	for _, res := range resources {
+		if err := f.store.Create(ctx, res, core_store.CreateBy(core_model.MetaToResourceKey(res.GetMeta()))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var _ = Describe("kumactl apply", func() {
 
 	var rootCtx *kumactl_cmd.RootContext
 	var rootCmd *cobra.Command
 	var store core_store.ResourceStore
+	var batchClient *fakeBatchClient
 	BeforeEach(func() {
 		rootCtx = &kumactl_cmd.RootContext{
 			Runtime: kumactl_cmd.RootRuntime{
@@ -49,9 +70,13 @@ var _ = Describe("kumactl apply", func() {
 					return store
 				},
 				NewAPIServerClient: test.GetMockNewAPIServerClient(),
+				NewBatchClient: func(util_http.Client) kumactl_resources.BatchClient {
+					return batchClient
+				},
 			},
 		}
 		store = core_store.NewPaginationStore(memory_resources.NewStore())
+		batchClient = &fakeBatchClient{store: store}
 		rootCmd = cmd.NewRootCmd(rootCtx)
 	})
 
@@ -300,6 +325,68 @@ var _ = Describe("kumactl apply", func() {
 		Expect(resource.Items[1].Meta.GetName()).To(Equal("sample2"))
 	})
 
+	It("should apply multiple resources in a single batch", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple-resource-same-type.yaml")},
+		)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(batchClient.batches).To(HaveLen(1))
+		Expect(batchClient.batches[0]).To(HaveLen(2))
+		Expect(batchClient.batches[0][0].GetMeta().GetName()).To(Equal("sample1"))
+		Expect(batchClient.batches[0][1].GetMeta().GetName()).To(Equal("sample2"))
+	})
+
+	It("should apply resources one by one when Control Plane does not support batches", func() {
+		// given
+		batchClient.err = kumactl_resources.ErrBatchNotSupported
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple-resource-same-type.yaml")},
+		)
+		buf := &bytes.Buffer{}
+		rootCmd.SetErr(buf)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("Resources are applied one by one"))
+		resources := mesh.DataplaneResourceList{}
+		Expect(store.List(context.Background(), &resources, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(resources.Items).To(HaveLen(2))
+	})
+
+	It("should not apply any resource when the batch fails", func() {
+		// given
+		batchClient.err = &types.Error{
+			Title:   "Could not apply Dataplane \"sample2\"",
+			Details: "Mesh is not found",
+		}
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple-resource-same-type.yaml")},
+		)
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`Could not apply Dataplane "sample2" (Mesh is not found)`))
+		resources := mesh.DataplaneResourceList{}
+		Expect(store.List(context.Background(), &resources, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(resources.Items).To(BeEmpty())
+	})
+
 	It("should apply multiple resources of different type", func() {
 		// given
 		rootCmd.SetArgs([]string{
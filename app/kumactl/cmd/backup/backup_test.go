@@ -0,0 +1,121 @@
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl export and import", func() {
+
+	var store core_store.ResourceStore
+	var archiveFile string
+
+	newRootCmd := func() (*cobra.Command, *bytes.Buffer) {
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+		rootCmd.SetErr(buf)
+		return rootCmd, buf
+	}
+
+	execute := func(args ...string) (string, error) {
+		rootCmd, buf := newRootCmd()
+		rootCmd.SetArgs(append([]string{"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml")}, args...))
+		err := rootCmd.Execute()
+		return buf.String(), err
+	}
+
+	BeforeEach(func() {
+		store = core_store.NewPaginationStore(memory_resources.NewStore())
+		Expect(store.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+		Expect(store.Create(context.Background(), &core_mesh.TrafficPermissionResource{
+			Spec: &mesh_proto.TrafficPermission{
+				Sources:      []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "web"}}},
+				Destinations: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}},
+			},
+		}, core_store.CreateByKey("tp-1", core_model.DefaultMesh))).To(Succeed())
+
+		dir, err := os.MkdirTemp("", "kumactl-backup")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+		archiveFile = filepath.Join(dir, "backup.json")
+	})
+
+	It("should restore exported resources", func() {
+		// given
+		out, err := execute("export", "-o", archiveFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(HaveSuffix("exported 2 resources to " + archiveFile + "\n"))
+		info, err := os.Stat(archiveFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		// when
+		store = core_store.NewPaginationStore(memory_resources.NewStore())
+		out, err = execute("import", "-f", archiveFile)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(HaveSuffix("imported resources: 2 created, 0 updated, 0 skipped\n"))
+		tp := core_mesh.NewTrafficPermissionResource()
+		Expect(store.Get(context.Background(), tp, core_store.GetByKey("tp-1", core_model.DefaultMesh))).To(Succeed())
+		Expect(tp.Spec.Sources[0].Match[mesh_proto.ServiceTag]).To(Equal("web"))
+	})
+
+	It("should restrict permissions of an existing archive file", func() {
+		// given
+		Expect(os.WriteFile(archiveFile, []byte("{}"), 0644)).To(Succeed())
+
+		// when
+		_, err := execute("export", "-o", archiveFile)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		info, err := os.Stat(archiveFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("should skip existing resources unless overwrite is set", func() {
+		// given
+		_, err := execute("export", "-o", archiveFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		out, err := execute("import", "-f", archiveFile)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(HaveSuffix("imported resources: 0 created, 0 updated, 2 skipped\n"))
+
+		// when
+		out, err = execute("import", "-f", archiveFile, "--overwrite")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(HaveSuffix("imported resources: 0 created, 2 updated, 0 skipped\n"))
+	})
+})
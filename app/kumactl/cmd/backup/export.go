@@ -0,0 +1,90 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/backup"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/register"
+)
+
+func NewExportCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	register.RegisterGatewayTypes() // allow exporting experimental Gateway types
+
+	args := struct {
+		file           string
+		includeSecrets bool
+	}{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all resources of the Control Plane to an archive",
+		Long: `Export all meshes and global resources of the Control Plane to an archive.
+
+The archive can be restored on another Control Plane with "kumactl import".
+Resources that are computed by the Control Plane (like insights) are not exported.`,
+		Example: `
+Export all resources without secrets
+$ kumactl export -o backup.json
+
+Export all resources including secrets
+$ kumactl export --include-secrets -o backup.json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := pctx.CheckServerVersionCompatibility(); err != nil {
+				cmd.PrintErrln(err)
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			archive, err := backup.Export(context.Background(), rs, pctx.Runtime.Registry, backup.ExportOptions{
+				IncludeSecrets: args.includeSecrets,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to export resources")
+			}
+			if client, err := pctx.CurrentApiClient(); err == nil {
+				if index, err := client.GetVersion(context.Background()); err == nil {
+					archive.KumaVersion = index.Version
+				}
+			}
+
+			bytes, err := json.MarshalIndent(archive, "", "  ")
+			if err != nil {
+				return err
+			}
+			var out io.Writer = cmd.OutOrStdout()
+			if args.file != "" {
+				// the archive may contain secrets, so it is readable only by the owner
+				f, err := os.OpenFile(args.file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+				if err != nil {
+					return errors.Wrap(err, "could not create the archive file")
+				}
+				defer f.Close()
+				// the mode of OpenFile applies only to new files, an existing file keeps its permissions
+				if err := f.Chmod(0600); err != nil {
+					return errors.Wrap(err, "could not restrict permissions of the archive file")
+				}
+				out = f
+			}
+			if _, err := out.Write(append(bytes, '\n')); err != nil {
+				return errors.Wrap(err, "could not write the archive")
+			}
+			if args.file != "" {
+				cmd.Printf("exported %d resources to %s\n", len(archive.Items), args.file)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&args.file, "output-file", "o", "", "path to the archive file. If not set, the archive is printed to stdout")
+	cmd.Flags().BoolVar(&args.includeSecrets, "include-secrets", false, "include Secrets and GlobalSecrets in the archive")
+	return cmd
+}
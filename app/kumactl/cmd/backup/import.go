@@ -0,0 +1,82 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/backup"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/register"
+)
+
+func NewImportCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	register.RegisterGatewayTypes() // allow importing experimental Gateway types
+
+	args := struct {
+		file      string
+		overwrite bool
+	}{}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import resources from an archive created by \"kumactl export\"",
+		Long: `Import resources from an archive created by "kumactl export".
+
+Resources are restored in the order of their dependencies. Resources that already exist are skipped unless --overwrite is set.
+Resources of types that are not supported by the Control Plane are skipped.
+Keep in mind that creating a Mesh also creates its default policies, so they may appear even if they were not in the archive.`,
+		Example: `
+Restore resources, skipping the ones that already exist
+$ kumactl import -f backup.json
+
+Restore resources, overwriting the ones that already exist
+$ kumactl import -f backup.json --overwrite
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := pctx.CheckServerVersionCompatibility(); err != nil {
+				cmd.PrintErrln(err)
+			}
+
+			var b []byte
+			var err error
+			if args.file == "-" {
+				b, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				b, err = os.ReadFile(args.file)
+			}
+			if err != nil {
+				return errors.Wrap(err, "error while reading provided file")
+			}
+			archive := &backup.Archive{}
+			if err := json.Unmarshal(b, archive); err != nil {
+				return errors.Wrap(err, "provided file is not a valid archive")
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			result, err := backup.Import(context.Background(), rs, pctx.Runtime.Registry, archive, backup.ImportOptions{
+				Overwrite: args.overwrite,
+			})
+			if len(result.UnsupportedTypes) > 0 {
+				cmd.PrintErrf("skipped resources of types not supported by the Control Plane: %s\n", strings.Join(result.UnsupportedTypes, ", "))
+			}
+			if err != nil {
+				return err
+			}
+			cmd.Printf("imported resources: %d created, %d updated, %d skipped\n", result.Created, result.Updated, result.Skipped)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&args.file, "file", "f", "", "path to the archive file. Pass `-` to read from stdin")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVar(&args.overwrite, "overwrite", false, "overwrite resources that already exist")
+	return cmd
+}
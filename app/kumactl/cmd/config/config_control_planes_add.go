@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	net_url "net/url"
 
 	"github.com/pkg/errors"
@@ -63,6 +64,13 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 			if err := config.ValidateCpCoordinates(cp, pctx.Args.ApiTimeout); err != nil {
 				return err
 			}
+			if plugin, ok := pctx.Runtime.AuthnPlugins[args.authType].(plugins.AuthnLoginPlugin); ok {
+				authConf, err := plugin.Login(context.Background(), args.authConf, cmd.OutOrStdout())
+				if err != nil {
+					return err
+				}
+				cp.Coordinates.ApiServer.AuthConf = authConf
+			}
 			if !cfg.AddControlPlane(cp, args.overwrite) {
 				return errors.Errorf("Control Plane with name %q already exists. Use --overwrite to replace an existing one.", cp.Name)
 			}
@@ -93,7 +101,7 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 	cmd.Flags().StringVar(&args.caCertFile, "ca-cert-file", "", "path to the certificate authority which will be used to verify the Control Plane certificate (kumactl stores only a reference to this file)")
 	cmd.Flags().BoolVar(&args.skipVerify, "skip-verify", false, "skip CA verification")
 	cmd.Flags().StringToStringVar(&args.headers, "headers", args.headers, "add these headers while communicating to control plane, format key=value")
-	cmd.Flags().StringVar(&args.authType, "auth-type", args.authType, `authentication type (for example: "tokens")`)
+	cmd.Flags().StringVar(&args.authType, "auth-type", args.authType, `authentication type (for example: "tokens" or "oidc")`)
 	cmd.Flags().StringToStringVar(&args.authConf, "auth-conf", args.authConf, "authentication configuration for defined authentication type format key=value")
 	return cmd
 }
@@ -0,0 +1,27 @@
+package debug
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewDebugCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	debugCmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Debug data plane proxies",
+		Long:  `Debug data plane proxies.`,
+	}
+	debugCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(debugCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	debugCmd.AddCommand(newDebugDataplaneCmd(pctx))
+	return debugCmd
+}
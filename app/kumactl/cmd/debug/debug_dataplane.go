@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+func newDebugDataplaneCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dataplane",
+		Short: "Debug Dataplane",
+		Long:  `Debug Dataplane.`,
+	}
+	cmd.AddCommand(newSetLogLevelCmd(pctx))
+	return cmd
+}
+
+func newSetLogLevelCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		logger string
+		level  string
+		ttl    time.Duration
+	}{}
+	cmd := &cobra.Command{
+		Use:   "set-log-level NAME",
+		Short: "Temporarily change log level of Envoy of the Dataplane",
+		Long: `Temporarily change log level of Envoy of the Dataplane.
+
+The Control Plane reverts the change after the TTL. When the level is changed again
+before the revert, the revert is postponed and it restores levels from before the first change.
+Every change is recorded in the audit log of the Control Plane.`,
+		Example: `kumactl debug dataplane set-log-level backend-1 --logger http --level debug --ttl 10m`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			client, err := pctx.CurrentInspectEnvoyProxyClient(core_mesh.DataplaneResourceTypeDescriptor)
+			if err != nil {
+				return errors.Wrap(err, "failed to create a dataplane inspect client")
+			}
+			request := api_server_types.LogLevelRequest{
+				Logger: args.logger,
+				Level:  args.level,
+			}
+			if args.ttl != 0 {
+				request.TTL = args.ttl.String()
+			}
+			resp, err := client.SetLogLevel(context.Background(), core_model.ResourceKey{Name: cmdArgs[0], Mesh: pctx.CurrentMesh()}, request)
+			if err != nil {
+				return err
+			}
+			if resp.RevertAt != nil {
+				if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Log levels will be reverted at %s\n", resp.RevertAt.Format(time.RFC3339)); err != nil {
+					return err
+				}
+			}
+			return printLogLevels(resp.Levels, cmd)
+		},
+	}
+	cmd.Flags().StringVar(&args.logger, "logger", "", "name of the Envoy logger, e.g. http. If not set, the level of all loggers is changed")
+	cmd.Flags().StringVar(&args.level, "level", "", "log level: trace, debug, info, warning, error, critical or off")
+	cmd.Flags().DurationVar(&args.ttl, "ttl", 0, "time after which log levels are reverted (default is set by the Control Plane)")
+	_ = cmd.MarkFlagRequired("level")
+	return cmd
+}
+
+func printLogLevels(levels map[string]string, cmd *cobra.Command) error {
+	var loggers []string
+	for logger := range levels {
+		loggers = append(loggers, logger)
+	}
+	sort.Strings(loggers)
+	data := printers.Table{
+		Headers: []string{"LOGGER", "LEVEL"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(loggers) <= i {
+					return nil
+				}
+				return []string{
+					loggers[i],         // LOGGER
+					levels[loggers[i]], // LEVEL
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, cmd.OutOrStdout())
+}
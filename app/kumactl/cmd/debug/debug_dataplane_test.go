@@ -0,0 +1,111 @@
+package debug_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testLogLevelClient struct {
+	kumactl_resources.InspectEnvoyProxyClient
+	key     core_model.ResourceKey
+	request api_server_types.LogLevelRequest
+}
+
+func (c *testLogLevelClient) SetLogLevel(_ context.Context, rk core_model.ResourceKey, request api_server_types.LogLevelRequest) (*api_server_types.LogLevelsResponse, error) {
+	c.key = rk
+	c.request = request
+	revertAt, _ := time.Parse(time.RFC3339, "2022-06-01T10:15:00Z")
+	return &api_server_types.LogLevelsResponse{
+		Levels: map[string]string{
+			"admin":    "info",
+			"http":     request.Level,
+			"upstream": "info",
+		},
+		RevertAt: &revertAt,
+	}, nil
+}
+
+var _ = Describe("kumactl debug dataplane set-log-level", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *testLogLevelClient
+
+	BeforeEach(func() {
+		client = &testLogLevelClient{}
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(core_model.ResourceTypeDescriptor, util_http.Client) kumactl_resources.InspectEnvoyProxyClient {
+			return client
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should change log level of a logger", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"debug", "dataplane", "set-log-level", "backend-1",
+			"--mesh", "demo", "--logger", "http", "--level", "debug", "--ttl", "15m"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.key).To(Equal(core_model.ResourceKey{Mesh: "demo", Name: "backend-1"}))
+		Expect(client.request).To(Equal(api_server_types.LogLevelRequest{
+			Logger: "http",
+			Level:  "debug",
+			TTL:    "15m0s",
+		}))
+		// and
+		Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", "set-log-level.golden.txt"))
+	})
+
+	It("should leave TTL to the Control Plane when not set", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"debug", "dataplane", "set-log-level", "backend-1", "--level", "trace"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.request).To(Equal(api_server_types.LogLevelRequest{
+			Level: "trace",
+		}))
+	})
+
+	It("should require level", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"debug", "dataplane", "set-log-level", "backend-1"})
+		rootCmd.SetErr(&bytes.Buffer{})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`required flag(s) "level" not set`))
+	})
+})
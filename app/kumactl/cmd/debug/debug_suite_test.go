@@ -0,0 +1,11 @@
+package debug_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestDebugCmd(t *testing.T) {
+	test.RunSpecs(t, "Debug Cmd Suite")
+}
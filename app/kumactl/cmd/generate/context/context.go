@@ -5,11 +5,11 @@ import (
 )
 
 type GenerateContext struct {
-	NewSigningKey func() ([]byte, error)
+	NewSigningKey func(algorithm string) ([]byte, error)
 }
 
 func DefaultGenerateContext() GenerateContext {
 	return GenerateContext{
-		NewSigningKey: tokens.NewSigningKey,
+		NewSigningKey: tokens.NewSigningKeyWithAlgorithm,
 	}
 }
@@ -7,9 +7,12 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 )
 
 func NewGenerateSigningKeyCmd(ctx *cmd.RootContext) *cobra.Command {
+	var keyAlgorithm string
 	cmd := &cobra.Command{
 		Use:   "signing-key",
 		Short: "Generate signing keys",
@@ -33,10 +36,13 @@ metadata:
   namespace: kong-mesh-system
 type: system.kuma.io/global-secret
 " | kubectl apply -f - 
+
+Generate an ECDSA signing key. Tokens signed with it use ES256.
+$ kumactl generate signing-key --key-algorithm ECDSA-P256
 `,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			key, err := ctx.GenerateContext.NewSigningKey()
+			key, err := ctx.GenerateContext.NewSigningKey(keyAlgorithm)
 			if err != nil {
 				return errors.Wrap(err, "could not generate signing key")
 			}
@@ -44,5 +50,6 @@ type: system.kuma.io/global-secret
 			return err
 		},
 	}
+	cmd.Flags().StringVar(&keyAlgorithm, "key-algorithm", util_tls.RSAKeyAlgorithm, kuma_cmd.UsageOptions("algorithm of the signing key", util_tls.RSAKeyAlgorithm, util_tls.ECDSAP256KeyAlgorithm, util_tls.ECDSAP384KeyAlgorithm, util_tls.Ed25519KeyAlgorithm))
 	return cmd
 }
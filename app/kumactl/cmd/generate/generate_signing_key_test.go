@@ -15,7 +15,8 @@ var _ = Describe("Generate Signing Key", func() {
 	It("should generate signing key", func() {
 		// setup
 		ctx := cmd.DefaultRootContext()
-		ctx.GenerateContext.NewSigningKey = func() ([]byte, error) {
+		ctx.GenerateContext.NewSigningKey = func(algorithm string) ([]byte, error) {
+			Expect(algorithm).To(Equal("RSA"))
 			return []byte("TEST"), nil
 		}
 
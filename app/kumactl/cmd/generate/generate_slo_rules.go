@@ -0,0 +1,41 @@
+package generate
+
+import (
+	"context"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewGenerateSLORulesCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slo-rules",
+		Short: "Generate Prometheus rules of SLOs",
+		Long:  `Generate Prometheus recording and alerting rules of SLOs in the mesh.`,
+		Example: `
+Generate rules of SLOs in the "demo" mesh
+$ kumactl generate slo-rules --mesh demo > kuma-slo-rules.yaml
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentSLORulesClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create SLO rules client")
+			}
+			rules, err := client.Get(context.Background(), pctx.CurrentMesh())
+			if err != nil {
+				return errors.Wrap(err, "failed to generate SLO rules")
+			}
+			bytes, err := yaml.Marshal(rules)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(bytes)
+			return err
+		},
+	}
+	return cmd
+}
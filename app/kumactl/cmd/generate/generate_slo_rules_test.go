@@ -0,0 +1,122 @@
+package generate_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/slo"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+type staticSLORulesClient struct {
+	mesh string
+	err  error
+}
+
+var _ kumactl_resources.SLORulesClient = &staticSLORulesClient{}
+
+func (s *staticSLORulesClient) Get(_ context.Context, mesh string) (*slo.RuleFile, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.mesh = mesh
+	return &slo.RuleFile{
+		Groups: []slo.RuleGroup{{
+			Name: "kuma-slo-" + mesh + "-backend-availability",
+			Rules: []slo.Rule{
+				{
+					Record: slo.ErrorRatioRecord + "5m",
+					Expr:   `sum(rate(envoy_cluster_upstream_rq_xx{envoy_response_code_class="5"}[5m])) / sum(rate(envoy_cluster_upstream_rq_total[5m]))`,
+					Labels: map[string]string{
+						"mesh": mesh,
+						"slo":  "backend-availability",
+					},
+				},
+				{
+					Alert: slo.FastBurnAlert,
+					Expr:  slo.ErrorRatioRecord + `5m{mesh="` + mesh + `",slo="backend-availability"} > 0.0144`,
+					For:   "2m",
+					Labels: map[string]string{
+						"severity": "critical",
+					},
+				},
+			},
+		}},
+	}, nil
+}
+
+var _ = Describe("kumactl generate slo-rules", func() {
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *staticSLORulesClient
+
+	BeforeEach(func() {
+		client = &staticSLORulesClient{}
+		ctx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Registry: registry.NewTypeRegistry(),
+				NewBaseAPIServerClient: func(server *config_proto.ControlPlaneCoordinates_ApiServer, _ time.Duration) (util_http.Client, error) {
+					return nil, nil
+				},
+				NewSLORulesClient: func(util_http.Client) kumactl_resources.SLORulesClient {
+					return client
+				},
+				NewAPIServerClient: test.GetMockNewAPIServerClient(),
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(ctx)
+
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+		rootCmd.SetErr(buf)
+	})
+
+	It("should print rules of the mesh", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "slo-rules", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.mesh).To(Equal("demo"))
+		Expect(buf.String()).To(matchers.MatchGoldenYAML(filepath.Join("testdata", "generate-slo-rules.golden.yaml")))
+	})
+
+	It("should use default mesh when it is not specified", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "slo-rules"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.mesh).To(Equal("default"))
+	})
+
+	It("should write error when generating rules fails", func() {
+		// setup
+		client.err = errors.New("could not connect to API")
+
+		// when
+		rootCmd.SetArgs([]string{"generate", "slo-rules"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(buf.String()).To(Equal("Error: failed to generate SLO rules: could not connect to API\n"))
+	})
+})
@@ -34,6 +34,7 @@ func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 		getCmd.AddCommand(WithPaginationArgs(NewGetResourcesCmd(pctx, cmdInst), &pctx.ListContext))
 		getCmd.AddCommand(NewGetResourceCmd(pctx, cmdInst))
 	}
+	getCmd.AddCommand(NewGetRevokedTokensCmd(pctx))
 	return getCmd
 }
 
@@ -0,0 +1,93 @@
+package get
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+)
+
+type getRevokedTokensContext struct {
+	tokenType string
+}
+
+func NewGetRevokedTokensCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &getRevokedTokensContext{}
+	cmd := &cobra.Command{
+		Use:   "revoked-tokens",
+		Short: "Show revoked tokens",
+		Long:  `Show revoked tokens of the given type. Revoked dataplane tokens are shown for the current Mesh.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			key, err := tokens.RevocationsKey(ctx.tokenType, pctx.CurrentMesh())
+			if err != nil {
+				return err
+			}
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			list, _, err := tokens.GetRevocationList(context.Background(), rs, key)
+			if err != nil {
+				return err
+			}
+			if list == nil {
+				list = core_tokens.RevocationList{}
+			}
+
+			switch format := output.Format(pctx.GetContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printRevokedTokens(pctx.Now(), list, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(list, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.Flags().StringVar(&ctx.tokenType, "type", "", kuma_cmd.UsageOptions("type of the token", tokens.DataplaneTokenType, tokens.ZoneTokenType, tokens.ZoneIngressTokenType, tokens.UserTokenType))
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
+
+func printRevokedTokens(now time.Time, list core_tokens.RevocationList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"ID", "REVOKED", "EXPIRES IN"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(list) <= i {
+					return nil
+				}
+				token := list[i]
+
+				revoked := "-"
+				if token.RevokedAt != nil {
+					revoked = table.Ago(token.RevokedAt, now)
+				}
+				expiresIn := "-"
+				if token.ExpiresAt != nil {
+					expiresIn = table.Duration(token.ExpiresAt.Sub(now))
+				}
+				return []string{
+					token.ID,  // ID
+					revoked,   // REVOKED
+					expiresIn, // EXPIRES IN
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,102 @@
+package get_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("kumactl get revoked-tokens", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+
+	BeforeEach(func() {
+		// setup
+		store := memory_resources.NewStore()
+		rootCtx, err := test_kumactl.MakeRootContext(rootTime, store)
+		Expect(err).ToNot(HaveOccurred())
+
+		revokedAt := rootTime.Add(-time.Hour)
+		expiresAt := rootTime.Add(2 * time.Hour)
+		data, err := core_tokens.RevocationList{
+			{ID: "legacy-token"},
+			{ID: "token-1", RevokedAt: &revokedAt, ExpiresAt: &expiresAt},
+		}.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		secret := system.NewSecretResource()
+		secret.Spec = &system_proto.Secret{
+			Data: util_proto.Bytes(data),
+		}
+		key := core_model.ResourceKey{Mesh: "default", Name: "dataplane-token-revocations-default"}
+		Expect(store.Create(context.Background(), secret, core_store.CreateBy(key))).To(Succeed())
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+	}
+
+	DescribeTable("kumactl get revoked-tokens -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"get", "revoked-tokens", "--type", "dataplane", given.outputFormat,
+			})
+
+			// when
+			Expect(rootCmd.Execute()).To(Succeed())
+
+			// then
+			Expect(buf.String()).To(MatchGoldenEqual("testdata", given.goldenFile))
+		},
+		Entry("should support Table output explicitly", testCase{
+			outputFormat: "-otable",
+			goldenFile:   "get-revoked-tokens.golden.txt",
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "get-revoked-tokens.golden.json",
+		}),
+		Entry("should support YAML output", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "get-revoked-tokens.golden.yaml",
+		}),
+	)
+
+	It("should show empty list when no token was revoked", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"get", "revoked-tokens", "--type", "user", "-ojson",
+		})
+
+		// when
+		Expect(rootCmd.Execute()).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(MatchJSON(`[]`))
+	})
+})
@@ -0,0 +1,127 @@
+package inspect
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// printClusters prints clusters returned by envoy admin API. Table has a row for every host of every cluster.
+func printClusters(raw []byte, format output.Format, out io.Writer) error {
+	switch format {
+	case output.TableFormat:
+	case output.JSONFormat:
+		_, err := fmt.Fprint(out, string(raw))
+		return err
+	case output.YAMLFormat:
+		bytes, err := yaml.JSONToYAML(raw)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(bytes)
+		return err
+	default:
+		return errors.Errorf("unknown output format %q", format)
+	}
+
+	clusters := &envoy_admin_v3.Clusters{}
+	if err := util_proto.FromJSON(raw, clusters); err != nil {
+		return errors.Wrap(err, "could not parse clusters")
+	}
+	type row struct {
+		cluster string
+		host    *envoy_admin_v3.HostStatus
+	}
+	var rows []row
+	for _, cluster := range clusters.GetClusterStatuses() {
+		for _, host := range cluster.GetHostStatuses() {
+			rows = append(rows, row{cluster: cluster.GetName(), host: host})
+		}
+	}
+
+	data := printers.Table{
+		Headers: []string{
+			"CLUSTER",
+			"HOST",
+			"HEALTH",
+			"OUTLIER",
+			"SUCCESS RATE",
+			"REQUESTS",
+			"ERRORS",
+		},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				host := rows[i].host
+				outlier := "-"
+				if host.GetHealthStatus().GetFailedOutlierCheck() {
+					outlier = "ejected"
+				}
+				successRate := "-"
+				if host.GetSuccessRate() != nil {
+					successRate = fmt.Sprintf("%.2f%%", host.GetSuccessRate().GetValue())
+				}
+				return []string{
+					rows[i].cluster,            // CLUSTER
+					hostAddress(host),          // HOST
+					hostHealth(host),           // HEALTH
+					outlier,                    // OUTLIER
+					successRate,                // SUCCESS RATE
+					hostStat(host, "rq_total"), // REQUESTS
+					hostStat(host, "rq_error"), // ERRORS
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func hostAddress(host *envoy_admin_v3.HostStatus) string {
+	if pipe := host.GetAddress().GetPipe(); pipe != nil {
+		return pipe.GetPath()
+	}
+	socket := host.GetAddress().GetSocketAddress()
+	return net.JoinHostPort(socket.GetAddress(), strconv.FormatUint(uint64(socket.GetPortValue()), 10))
+}
+
+// hostHealth returns the health status from EDS followed by reasons why Envoy considers the host unhealthy.
+func hostHealth(host *envoy_admin_v3.HostStatus) string {
+	status := host.GetHealthStatus()
+	health := []string{strings.ToLower(status.GetEdsHealthStatus().String())}
+	if status.GetFailedActiveHealthCheck() {
+		health = append(health, "failed active health check")
+	}
+	if status.GetFailedActiveDegradedCheck() {
+		health = append(health, "degraded")
+	}
+	if status.GetActiveHcTimeout() {
+		health = append(health, "active health check timeout")
+	}
+	if status.GetPendingDynamicRemoval() {
+		health = append(health, "pending removal")
+	}
+	return strings.Join(health, ", ")
+}
+
+func hostStat(host *envoy_admin_v3.HostStatus, name string) string {
+	for _, stat := range host.GetStats() {
+		if stat.GetName() == name {
+			return strconv.FormatUint(stat.GetValue(), 10)
+		}
+	}
+	return "-"
+}
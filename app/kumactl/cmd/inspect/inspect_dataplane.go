@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -51,7 +52,8 @@ func newInspectDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
 	if err != nil {
 		panic("unable to parse template")
 	}
-	var configDump bool
+	var configDump, stats, clusters bool
+	var statsFilter string
 	cmd := &cobra.Command{
 		Use:   "dataplane NAME",
 		Short: "Inspect Dataplane",
@@ -59,12 +61,33 @@ func newInspectDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
-			if configDump {
+			envoyAdminFlags := 0
+			for _, set := range []bool{configDump, stats, clusters} {
+				if set {
+					envoyAdminFlags++
+				}
+			}
+			if envoyAdminFlags > 1 {
+				return errors.New("only one of --config-dump, --stats and --clusters can be used")
+			}
+			if envoyAdminFlags > 0 {
 				client, err := pctx.CurrentInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
 				if err != nil {
 					return errors.Wrap(err, "failed to create a dataplane inspect client")
 				}
-				bytes, err := client.ConfigDump(context.Background(), core_model.ResourceKey{Name: name, Mesh: pctx.CurrentMesh()})
+				key := core_model.ResourceKey{Name: name, Mesh: pctx.CurrentMesh()}
+				var bytes []byte
+				switch {
+				case stats:
+					bytes, err = client.Stats(context.Background(), key, statsFilter)
+				case clusters:
+					bytes, err = client.Clusters(context.Background(), key)
+					if err == nil {
+						return printClusters(bytes, output.Format(pctx.InspectContext.Args.OutputFormat), cmd.OutOrStdout())
+					}
+				default:
+					bytes, err = client.ConfigDump(context.Background(), key)
+				}
 				if err != nil {
 					return err
 				}
@@ -84,5 +107,8 @@ func newInspectDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
 		},
 	}
 	cmd.PersistentFlags().BoolVar(&configDump, "config-dump", false, "if set then the command returns envoy config dump for provided dataplane")
+	cmd.PersistentFlags().BoolVar(&stats, "stats", false, "if set then the command returns envoy stats for provided dataplane")
+	cmd.PersistentFlags().StringVar(&statsFilter, "stats-filter", "", "regex that names of envoy stats have to match, used with --stats")
+	cmd.PersistentFlags().BoolVar(&clusters, "clusters", false, "if set then the command returns envoy clusters with health and outlier detection status of hosts for provided dataplane")
 	return cmd
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
@@ -97,3 +98,96 @@ var _ = Describe("kumactl inspect dataplane", func() {
 		}),
 	)
 })
+
+type testEnvoyProxyClient struct {
+	resources.InspectEnvoyProxyClient
+	statsFilter string
+}
+
+func (t *testEnvoyProxyClient) Stats(_ context.Context, _ core_model.ResourceKey, filter string) ([]byte, error) {
+	t.statsFilter = filter
+	return []byte("cluster.backend.upstream_rq_total: 12\ncluster.backend.upstream_rq_5xx: 1\n"), nil
+}
+
+func (t *testEnvoyProxyClient) Clusters(context.Context, core_model.ResourceKey) ([]byte, error) {
+	return os.ReadFile(path.Join("testdata", "inspect-dataplane-clusters.server-response.json"))
+}
+
+var _ = Describe("kumactl inspect dataplane with envoy admin", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *testEnvoyProxyClient
+
+	BeforeEach(func() {
+		client = &testEnvoyProxyClient{}
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(core_model.ResourceTypeDescriptor, util_http.Client) resources.InspectEnvoyProxyClient {
+			return client
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should return filtered stats", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "backend-1", "--stats", "--stats-filter", "^cluster\\.backend\\."})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.statsFilter).To(Equal("^cluster\\.backend\\."))
+		Expect(buf.String()).To(Equal("cluster.backend.upstream_rq_total: 12\ncluster.backend.upstream_rq_5xx: 1\n"))
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(path ...string) gomega_types.GomegaMatcher
+	}
+	DescribeTable("should return clusters",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-1", "--clusters"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(given.matcher("testdata", given.goldenFile))
+		},
+		Entry("as a table", testCase{
+			outputFormat: "-otable",
+			goldenFile:   "inspect-dataplane-clusters.golden.txt",
+			matcher:      matchers.MatchGoldenEqual,
+		}),
+		Entry("as YAML", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "inspect-dataplane-clusters.golden.yaml",
+			matcher:      matchers.MatchGoldenYAML,
+		}),
+	)
+
+	It("should reject more than one envoy admin flag", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "backend-1", "--stats", "--clusters"})
+		rootCmd.SetErr(&bytes.Buffer{})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("only one of --config-dump, --stats and --clusters can be used"))
+	})
+})
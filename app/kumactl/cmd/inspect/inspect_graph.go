@@ -0,0 +1,154 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/graph"
+)
+
+func newInspectGraphCmd(pctx *cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Inspect service graph",
+		Long: `Inspect graph of services in the mesh.
+
+Connections are built from declared outbounds, TrafficRoutes and TrafficPermissions.
+When Control Plane has access to metrics of the mesh, connections include request and error rates.
+
+Use "-o dot" to render the graph with Graphviz, e.g.
+
+  kumactl inspect graph -o dot | dot -Tsvg > graph.svg`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentGraphClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a graph client")
+			}
+			g, err := client.Get(context.Background(), pctx.CurrentMesh())
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printGraph(g, cmd.OutOrStdout())
+			case output.DOTFormat:
+				return printGraphDOT(pctx.CurrentMesh(), g, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(g, cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printGraph(g *graph.Graph, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{
+			"SOURCE",
+			"DESTINATION",
+			"PROTOCOL",
+			"PERMITTED",
+			"ROUTES",
+			"REQUEST RATE",
+			"ERROR RATE",
+		},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(g.Edges) <= i {
+					return nil
+				}
+				edge := g.Edges[i]
+				requestRate, errorRate := "-", "-"
+				if edge.Stats != nil {
+					requestRate = fmt.Sprintf("%.2f/s", edge.Stats.RequestRate)
+					errorRate = fmt.Sprintf("%.2f%%", edge.Stats.ErrorRate*100)
+				}
+				routes := "-"
+				if len(edge.Routes) > 0 {
+					routes = strings.Join(edge.Routes, ",")
+				}
+				return []string{
+					edge.Source,                        // SOURCE
+					edge.Destination,                   // DESTINATION
+					edge.Protocol,                      // PROTOCOL
+					strconv.FormatBool(edge.Permitted), // PERMITTED
+					routes,                             // ROUTES
+					requestRate,                        // REQUEST RATE
+					errorRate,                          // ERROR RATE
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+// printGraphDOT prints the graph in the DOT language of Graphviz.
+// Connections that are not permitted by TrafficPermissions are drawn dashed.
+func printGraphDOT(mesh string, g *graph.Graph, out io.Writer) error {
+	w := &errWriter{out: out}
+	w.printf("digraph %s {\n", strconv.Quote(mesh))
+	for _, node := range g.Nodes {
+		label := node.Service
+		if node.Protocol != "" {
+			label += "\n" + node.Protocol
+		}
+		w.printf("  %s [label=%s, shape=%s];\n", strconv.Quote(node.Service), strconv.Quote(label), nodeShape(node.Type))
+	}
+	for _, edge := range g.Edges {
+		attrs := ""
+		if edge.Stats != nil {
+			attrs = fmt.Sprintf("label=%s", strconv.Quote(fmt.Sprintf("%.2f rps, %.2f%% errors", edge.Stats.RequestRate, edge.Stats.ErrorRate*100)))
+		}
+		if !edge.Permitted {
+			if attrs != "" {
+				attrs += ", "
+			}
+			attrs += "style=dashed, color=red"
+		}
+		if attrs != "" {
+			attrs = " [" + attrs + "]"
+		}
+		w.printf("  %s -> %s%s;\n", strconv.Quote(edge.Source), strconv.Quote(edge.Destination), attrs)
+	}
+	w.printf("}\n")
+	return w.err
+}
+
+func nodeShape(nodeType graph.NodeType) string {
+	switch nodeType {
+	case graph.GatewayNode:
+		return "diamond"
+	case graph.ExternalNode:
+		return "box"
+	default:
+		return "ellipse"
+	}
+}
+
+type errWriter struct {
+	out io.Writer
+	err error
+}
+
+func (w *errWriter) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = fmt.Fprintf(w.out, format, args...)
+}
@@ -0,0 +1,130 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/graph"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testGraphClient struct {
+	mesh  string
+	graph *graph.Graph
+}
+
+func (c *testGraphClient) Get(_ context.Context, mesh string) (*graph.Graph, error) {
+	c.mesh = mesh
+	return c.graph, nil
+}
+
+var _ resources.GraphClient = &testGraphClient{}
+
+var _ = Describe("kumactl inspect graph", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *testGraphClient
+	rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+
+	BeforeEach(func() {
+		client = &testGraphClient{
+			graph: &graph.Graph{
+				Nodes: []graph.Node{
+					{Service: "backend", Type: graph.InternalNode, Protocol: "http"},
+					{Service: "edge-gateway", Type: graph.GatewayNode},
+					{Service: "httpbin", Type: graph.ExternalNode, Protocol: "http"},
+					{Service: "redis", Type: graph.InternalNode, Protocol: "tcp"},
+					{Service: "web", Type: graph.InternalNode, Protocol: "http"},
+				},
+				Edges: []graph.Edge{
+					{Source: "backend", Destination: "httpbin", Protocol: "http", Permitted: true, Permission: "allow-all-default"},
+					{Source: "backend", Destination: "redis", Protocol: "tcp", Permitted: false},
+					{
+						Source:      "edge-gateway",
+						Destination: "web",
+						Protocol:    "http",
+						Permitted:   true,
+						Permission:  "allow-all-default",
+					},
+					{
+						Source:      "web",
+						Destination: "backend",
+						Protocol:    "http",
+						Routes:      []string{"backend-canary"},
+						Permitted:   true,
+						Permission:  "web-to-backend",
+						Stats: &graph.EdgeStats{
+							RequestRate: 12.5,
+							ErrorRate:   0.014,
+						},
+					},
+				},
+			},
+		}
+		rootCtx, err := test_kumactl.MakeRootContext(rootTime, nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewGraphClient = func(util_http.Client) resources.GraphClient {
+			return client
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(path ...string) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect graph -o table|dot|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "graph", "--mesh", "demo"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.mesh).To(Equal("demo"))
+			// and
+			Expect(buf.String()).To(given.matcher("testdata", given.goldenFile))
+		},
+		Entry("should support Table output by default", testCase{
+			outputFormat: "",
+			goldenFile:   "inspect-graph.golden.txt",
+			matcher:      matchers.MatchGoldenEqual,
+		}),
+		Entry("should support DOT output", testCase{
+			outputFormat: "-odot",
+			goldenFile:   "inspect-graph.golden.dot",
+			matcher:      matchers.MatchGoldenEqual,
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-graph.golden.json",
+			matcher:      matchers.MatchGoldenJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "inspect-graph.golden.yaml",
+			matcher:      matchers.MatchGoldenYAML,
+		}),
+	)
+})
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
 )
@@ -32,7 +35,11 @@ func newInspectMeshesCmd(ctx *cmd.RootContext) *cobra.Command {
 
 			switch format := output.Format(ctx.InspectContext.Args.OutputFormat); format {
 			case output.TableFormat:
-				return printMeshInsights(insights, cmd.OutOrStdout())
+				meshes := &mesh.MeshResourceList{}
+				if err := client.List(context.Background(), meshes); err != nil {
+					return err
+				}
+				return printMeshInsights(insights, meshes, cmd.OutOrStdout())
 			default:
 				printer, err := printers.NewGenericPrinter(format)
 				if err != nil {
@@ -45,7 +52,12 @@ func newInspectMeshesCmd(ctx *cmd.RootContext) *cobra.Command {
 	return cmd
 }
 
-func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer) error {
+func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, meshes *mesh.MeshResourceList, out io.Writer) error {
+	mtlsByMesh := map[string]*mesh_proto.Mesh_Mtls{}
+	for _, m := range meshes.Items {
+		mtlsByMesh[m.GetMeta().GetName()] = m.Spec.GetMtls()
+	}
+
 	data := printers.Table{
 		Headers: []string{
 			"MESH",
@@ -60,6 +72,7 @@ func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer
 			"TRAFFIC LOGS",
 			"PROXY TEMPLATES",
 			"RATE LIMITS",
+			"CA ROTATION",
 		},
 		NextRow: func() func() []string {
 			i := 0
@@ -134,9 +147,22 @@ func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer
 					table.Number(tl), // TRAFFIC LOGS
 					table.Number(pt), // PROXY TEMPLATES
 					table.Number(rl), // RATE LIMITS
+					caRotation(mtlsByMesh[meta.GetName()], meshInsight), // CA ROTATION
 				}
 			}
 		}(),
 	}
 	return printers.NewTablePrinter().Print(data, out)
 }
+
+func caRotation(mtls *mesh_proto.Mesh_Mtls, meshInsight *mesh_proto.MeshInsight) string {
+	rotation := core_ca.NewRotation(mtls, meshInsight)
+	switch rotation.Phase {
+	case core_ca.RotationTrusting:
+		return fmt.Sprintf("%s -> %s (trusting %d/%d)", strings.Join(rotation.From, ","), rotation.To, rotation.Trusting, rotation.Connected)
+	case core_ca.RotationReissuing:
+		return fmt.Sprintf("%s -> %s (reissuing %d/%d)", strings.Join(rotation.From, ","), rotation.To, rotation.Reissued, rotation.Connected)
+	default:
+		return "-"
+	}
+}
@@ -45,6 +45,16 @@ var _ = Describe("kumactl inspect meshes", func() {
 					string(mesh.ExternalServiceType):   {Total: 9},
 					string(mesh.RateLimitType):         {Total: 10},
 				},
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 90, Online: 80, Offline: 10},
+						"ca-2": {Total: 10, Online: 10},
+					},
+					SupportedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 100, Online: 90, Offline: 10},
+						"ca-2": {Total: 90, Online: 90},
+					},
+				},
 			},
 		},
 		{
@@ -71,6 +81,25 @@ var _ = Describe("kumactl inspect meshes", func() {
 		},
 	}
 
+	meshResources := []*mesh.MeshResource{
+		{
+			Meta: &model.ResourceMeta{Name: "default"},
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-2",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: "ca-1", Type: "builtin"},
+						{Name: "ca-2", Type: "builtin"},
+					},
+				},
+			},
+		},
+		{
+			Meta: &model.ResourceMeta{Name: "mesh-1"},
+			Spec: &mesh_proto.Mesh{},
+		},
+	}
+
 	Describe("InspectMeshesCmd", func() {
 
 		var rootCmd *cobra.Command
@@ -80,6 +109,10 @@ var _ = Describe("kumactl inspect meshes", func() {
 
 		BeforeEach(func() {
 			store = memory_resources.NewStore()
+			for _, m := range meshResources {
+				err := store.Create(context.Background(), m, core_store.CreateBy(core_model.MetaToResourceKey(m.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
 			for _, cb := range meshInsightResources {
 				err := store.Create(context.Background(), cb, core_store.CreateBy(core_model.MetaToResourceKey(cb.GetMeta())))
 				Expect(err).ToNot(HaveOccurred())
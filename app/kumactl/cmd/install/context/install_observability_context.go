@@ -0,0 +1,26 @@
+package context
+
+const OpenTelemetryComponent = "opentelemetry"
+
+var ObservabilityComponents = []string{OpenTelemetryComponent}
+
+type ObservabilityTemplateArgs struct {
+	Namespace     string
+	Mesh          string
+	Components    []string
+	ZipkinAddress string
+}
+
+type InstallObservabilityContext struct {
+	TemplateArgs ObservabilityTemplateArgs
+}
+
+func DefaultInstallObservabilityContext() InstallObservabilityContext {
+	return InstallObservabilityContext{
+		TemplateArgs: ObservabilityTemplateArgs{
+			Namespace:     "mesh-observability",
+			Components:    ObservabilityComponents,
+			ZipkinAddress: "http://jaeger-collector.kuma-tracing:9411/api/v2/spans",
+		},
+	}
+}
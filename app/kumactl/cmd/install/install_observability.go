@@ -0,0 +1,80 @@
+package install
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd/install/context"
+	kumactl_data "github.com/kumahq/kuma/app/kumactl/data"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/install/data"
+	"github.com/kumahq/kuma/app/kumactl/pkg/install/k8s"
+)
+
+func newInstallObservability(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := pctx.InstallObservabilityContext.TemplateArgs
+	cmd := &cobra.Command{
+		Use:   "observability",
+		Short: "Install Observability backend in Kubernetes cluster (OpenTelemetry collector)",
+		Long: `Install Observability backend in Kubernetes cluster (OpenTelemetry collector) in its own namespace.
+
+The namespace is a part of the mesh, so the collector can be used in Mesh.tracing
+as the "opentelemetry" backend with service "opentelemetry-collector_<namespace>_svc_4317".`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			args.Mesh = pctx.Args.Mesh
+
+			filter, err := getExcludeComponentsFilter(args.Components)
+			if err != nil {
+				return err
+			}
+
+			templateFiles, err := data.ReadFiles(kumactl_data.InstallObservabilityFS())
+			if err != nil {
+				return errors.Wrap(err, "Failed to read template files")
+			}
+
+			renderedFiles, err := renderFilesWithFilter(templateFiles, args, simpleTemplateRenderer, filter)
+			if err != nil {
+				return errors.Wrap(err, "Failed to render template files")
+			}
+
+			sortedResources, err := k8s.SortResourcesByKind(renderedFiles)
+			if err != nil {
+				return errors.Wrap(err, "Failed to sort resources by kind")
+			}
+
+			singleFile := data.JoinYAML(sortedResources)
+
+			if _, err := cmd.OutOrStdout().Write(singleFile.Data); err != nil {
+				return errors.Wrap(err, "Failed to output rendered resources")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&args.Namespace, "namespace", args.Namespace, "namespace to install observability to")
+	cmd.Flags().StringSliceVar(&args.Components, "components", args.Components, "list of components to install, available: "+strings.Join(context.ObservabilityComponents, ", "))
+	cmd.Flags().StringVar(&args.ZipkinAddress, "zipkin-address", args.ZipkinAddress, "the address of the Zipkin compatible endpoint the collector exports traces to")
+	return cmd
+}
+
+func getExcludeComponentsFilter(components []string) (ExcludePrefixesFilter, error) {
+	selected := map[string]bool{}
+	for _, component := range components {
+		selected[component] = true
+	}
+	prefixes := []string{}
+	for _, component := range context.ObservabilityComponents {
+		if !selected[component] {
+			prefixes = append(prefixes, component)
+		}
+		delete(selected, component)
+	}
+	for component := range selected {
+		return ExcludePrefixesFilter{}, errors.Errorf("unknown component %q, available components: %s", component, strings.Join(context.ObservabilityComponents, ", "))
+	}
+	return ExcludePrefixesFilter{
+		Prefixes: prefixes,
+	}, nil
+}
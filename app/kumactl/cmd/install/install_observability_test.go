@@ -0,0 +1,80 @@
+package install_test
+
+import (
+	"bytes"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl install observability", func() {
+
+	var stdout *bytes.Buffer
+	var stderr *bytes.Buffer
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+	})
+
+	type testCase struct {
+		extraArgs  []string
+		goldenFile string
+	}
+
+	DescribeTable("should generate Kubernetes resources",
+		func(given testCase) {
+			// given
+			rootCmd := test.DefaultTestingRootCmd()
+			rootCmd.SetArgs(append([]string{"install", "observability"}, given.extraArgs...))
+			rootCmd.SetOut(stdout)
+			rootCmd.SetErr(stderr)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stderr.String()).To(BeEmpty())
+
+			// and output matches golden files
+			actual := stdout.Bytes()
+			ExpectMatchesGoldenFiles(actual, filepath.Join("testdata", given.goldenFile))
+		},
+		Entry("should generate Kubernetes resources with default settings", testCase{
+			extraArgs:  nil,
+			goldenFile: "install-observability.defaults.golden.yaml",
+		}),
+		Entry("should generate Kubernetes resources with custom settings", testCase{
+			extraArgs: []string{
+				"--namespace", "kuma",
+				"--mesh", "demo",
+				"--zipkin-address", "http://zipkin.kuma:9411/api/v2/spans",
+			},
+			goldenFile: "install-observability.overrides.golden.yaml",
+		}),
+		Entry("should generate only namespace when no components are selected", testCase{
+			extraArgs: []string{
+				"--components", "",
+			},
+			goldenFile: "install-observability.no-components.golden.yaml",
+		}),
+	)
+
+	It("should fail on unknown component", func() {
+		// given
+		rootCmd := test.DefaultTestingRootCmd()
+		rootCmd.SetArgs([]string{"install", "observability", "--components", "opentelemetry,unknown"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`unknown component "unknown", available components: opentelemetry`))
+	})
+})
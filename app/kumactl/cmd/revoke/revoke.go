@@ -0,0 +1,81 @@
+package revoke
+
+import (
+	"io"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const (
+	// kubernetesOutput prints revocation lists as Kubernetes Secrets instead of saving them through the API server,
+	// which is read-only on Kubernetes.
+	kubernetesOutput = "kubernetes"
+	defaultNamespace = "kuma-system"
+	meshLabel        = "kuma.io/mesh"
+)
+
+func NewRevokeCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	revokeCmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke certificates, tokens, etc",
+		Long:  `Revoke certificates, tokens, etc.`,
+	}
+	revokeCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(revokeCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	revokeCmd.AddCommand(NewRevokeDataplaneCertCmd(pctx))
+	revokeCmd.AddCommand(NewRevokeTokenCmd(pctx))
+	return revokeCmd
+}
+
+type kubernetesSecretMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+type kubernetesSecret struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Metadata   kubernetesSecretMeta `json:"metadata"`
+	Type       string               `json:"type"`
+	Data       map[string][]byte    `json:"data"`
+}
+
+// printKubernetesSecret prints the manifest of the Secret, so it can be applied with kubectl.
+func printKubernetesSecret(out io.Writer, key model.ResourceKey, secretType string, namespace string, data []byte) error {
+	secret := kubernetesSecret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata: kubernetesSecretMeta{
+			Name:      key.Name,
+			Namespace: namespace,
+		},
+		Type: secretType,
+		Data: map[string][]byte{
+			"value": data,
+		},
+	}
+	if key.Mesh != "" {
+		secret.Metadata.Labels = map[string]string{
+			meshLabel: key.Mesh,
+		}
+	}
+	bytes, err := yaml.Marshal(secret)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(bytes)
+	return err
+}
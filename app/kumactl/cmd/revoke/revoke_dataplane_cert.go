@@ -0,0 +1,148 @@
+package revoke
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type revokeDataplaneCertContext struct {
+	output    string
+	namespace string
+}
+
+func NewRevokeDataplaneCertCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &revokeDataplaneCertContext{}
+	cmd := &cobra.Command{
+		Use:   "dataplane-cert NAME",
+		Short: "Revoke the current certificate of a Dataplane",
+		Long: `Revoke the current certificate of a Dataplane.
+
+Serial number of the certificate is added to the revocation list of the Mesh, which is stored in the Secret "dataplane-cert-revocations-<mesh>".
+Every Dataplane in the Mesh rejects the revoked certificate and the Dataplane receives a new certificate on its next update.
+Revocation lists are supported by the "builtin" and the "provided" CA, certificates issued by other CA backends can't be revoked.
+
+On Kubernetes the API server is read-only, use "--output kubernetes" to print the updated Secret and apply it with kubectl.`,
+		Example: `kumactl revoke dataplane-cert backend-01 --mesh default
+kumactl revoke dataplane-cert backend-01 --mesh default --output kubernetes | kubectl apply -f -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			mesh := pctx.CurrentMesh()
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+
+			insight := core_mesh.NewDataplaneInsightResource()
+			if err := rs.Get(context.Background(), insight, core_store.GetByKey(name, mesh)); err != nil {
+				if core_store.IsResourceNotFound(err) {
+					return errors.Errorf("there is no insight of Dataplane %q in Mesh %q. The Dataplane has to be connected to the control plane", name, mesh)
+				}
+				return errors.Wrapf(err, "failed to get insight of Dataplane %q", name)
+			}
+			mtls := insight.Spec.GetMTLS()
+			if mtls.GetCertificateSerial() == "" {
+				return errors.Errorf("Dataplane %q in Mesh %q has not received a certificate yet", name, mesh)
+			}
+
+			if err := validateRevocationSupported(rs, mesh, mtls.GetIssuedBackend()); err != nil {
+				return err
+			}
+
+			revocation := core_ca.RevokedCert{
+				Serial:    mtls.GetCertificateSerial(),
+				Dataplane: name,
+				Backend:   mtls.GetIssuedBackend(),
+				RevokedAt: pctx.Now(),
+				ExpiresAt: mtls.GetCertificateExpirationTime().AsTime(),
+			}
+			secret, exists, err := revokeCert(rs, mesh, revocation)
+			if err != nil {
+				return err
+			}
+
+			if ctx.output == kubernetesOutput {
+				return printKubernetesSecret(cmd.OutOrStdout(), core_ca.RevocationsSecretKey(mesh), common_k8s.MeshSecretType, ctx.namespace, secret.Spec.GetData().GetValue())
+			}
+			if exists {
+				err = rs.Update(context.Background(), secret)
+			} else {
+				err = rs.Create(context.Background(), secret, core_store.CreateBy(core_ca.RevocationsSecretKey(mesh)))
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to save the revocation list")
+			}
+
+			cmd.Printf("revoked certificate %q of Dataplane %q. The Dataplane will receive a new certificate on its next update\n", revocation.Serial, name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&ctx.output, "output", "o", "", kuma_cmd.UsageOptions("print the updated revocation list instead of saving it through the API server", kubernetesOutput))
+	cmd.Flags().StringVar(&ctx.namespace, "namespace", defaultNamespace, "namespace of the Kuma Control Plane, used with --output kubernetes")
+	return cmd
+}
+
+// validateRevocationSupported rejects revocation of certificates issued by CA backends that can't sign revocation lists.
+// Otherwise, the revocation would have no effect.
+func validateRevocationSupported(rs core_store.ResourceStore, mesh string, backendName string) error {
+	meshRes := core_mesh.NewMeshResource()
+	if err := rs.Get(context.Background(), meshRes, core_store.GetByKey(mesh, core_model.NoMesh)); err != nil {
+		return errors.Wrapf(err, "failed to get Mesh %q", mesh)
+	}
+	backend := meshRes.GetCertificateAuthorityBackend(backendName)
+	if backend == nil {
+		return errors.Errorf("CA backend %q that issued the certificate is not defined in Mesh %q", backendName, mesh)
+	}
+	switch core_plugins.PluginName(backend.Type) {
+	case core_plugins.CaBuiltin, core_plugins.CaProvided:
+		return nil
+	default:
+		return errors.Errorf("certificates issued by CA backend %q of type %q can't be revoked. Revocation lists are supported by %q and %q CA", backendName, backend.Type, core_plugins.CaBuiltin, core_plugins.CaProvided)
+	}
+}
+
+// revokeCert returns the Secret with the revocation list of the Mesh that includes the revocation
+// and whether the Secret already exists.
+func revokeCert(rs core_store.ResourceStore, mesh string, revocation core_ca.RevokedCert) (*system.SecretResource, bool, error) {
+	key := core_ca.RevocationsSecretKey(mesh)
+	secret := system.NewSecretResource()
+	exists := true
+	if err := rs.Get(context.Background(), secret, core_store.GetBy(key)); err != nil {
+		if !core_store.IsResourceNotFound(err) {
+			return nil, false, errors.Wrap(err, "failed to get the revocation list")
+		}
+		exists = false
+	}
+
+	revoked, err := core_ca.ParseRevokedCerts(secret.Spec.GetData().GetValue())
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to parse the revocation list in the Secret %q", key.Name)
+	}
+	if !revoked.IsRevoked(revocation.Serial) {
+		// expired certificates no longer have to be on the list
+		revoked = append(revoked.NotExpired(revocation.RevokedAt), revocation)
+	}
+	data, err := revoked.Marshal()
+	if err != nil {
+		return nil, false, err
+	}
+	secret.Spec = &system_proto.Secret{
+		Data: util_proto.Bytes(data),
+	}
+	return secret, exists, nil
+}
@@ -0,0 +1,179 @@
+package revoke_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl revoke dataplane-cert", func() {
+
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+	var store core_store.ResourceStore
+	var now time.Time
+
+	createMesh := func(backendType string) {
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{
+					Name: "ca-1",
+					Type: backendType,
+				},
+			},
+		}
+		err := store.Create(context.Background(), mesh, core_store.CreateByKey("default", core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createInsight := func(name string, serial string, expiration time.Time) {
+		insight := core_mesh.NewDataplaneInsightResource()
+		insight.Spec.MTLS = &mesh_proto.DataplaneInsight_MTLS{
+			CertificateSerial:         serial,
+			IssuedBackend:             "ca-1",
+			CertificateExpirationTime: util_proto.MustTimestampProto(expiration),
+		}
+		err := store.Create(context.Background(), insight, core_store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	revokedCerts := func() core_ca.RevokedCerts {
+		secret := system.NewSecretResource()
+		err := store.Get(context.Background(), secret, core_store.GetBy(core_ca.RevocationsSecretKey("default")))
+		Expect(err).ToNot(HaveOccurred())
+		revoked, err := core_ca.ParseRevokedCerts(secret.Spec.GetData().GetValue())
+		Expect(err).ToNot(HaveOccurred())
+		return revoked
+	}
+
+	revoke := func(name string, flags ...string) error {
+		rootCmd.SetArgs(append([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"revoke", "dataplane-cert", name}, flags...))
+		return rootCmd.Execute()
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.Now = func() time.Time { return now }
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+		store = memory_resources.NewStore()
+		createMesh("builtin")
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+	})
+
+	It("should add the current certificate of the dataplane to the revocation list", func() {
+		// given
+		createInsight("backend-1", "1a2b", now.Add(time.Hour))
+
+		// when
+		err := revoke("backend-1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("revoked certificate \"1a2b\" of Dataplane \"backend-1\". The Dataplane will receive a new certificate on its next update\n"))
+		Expect(revokedCerts()).To(Equal(core_ca.RevokedCerts{
+			{
+				Serial:    "1a2b",
+				Dataplane: "backend-1",
+				Backend:   "ca-1",
+				RevokedAt: now,
+				ExpiresAt: now.Add(time.Hour),
+			},
+		}))
+	})
+
+	It("should append to the revocation list and prune expired certificates", func() {
+		// given
+		createInsight("backend-1", "1a2b", now.Add(time.Hour))
+		createInsight("backend-2", "3c4d", now.Add(2*time.Hour))
+		Expect(revoke("backend-1")).To(Succeed())
+		now = now.Add(90 * time.Minute)
+
+		// when
+		err := revoke("backend-2")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revokedCerts().Serials()).To(Equal([]string{"3c4d"}))
+	})
+
+	It("should print the revocation list as a Kubernetes Secret", func() {
+		// given
+		createInsight("backend-1", "1a2b", now.Add(time.Hour))
+
+		// when
+		err := revoke("backend-1", "--output", "kubernetes", "--namespace", "kuma")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(matchers.MatchGoldenYAML(filepath.Join("testdata", "revoke-dataplane-cert.kubernetes.golden.yaml")))
+
+		// and the revocation list is not saved through the API server
+		err = store.Get(context.Background(), system.NewSecretResource(), core_store.GetBy(core_ca.RevocationsSecretKey("default")))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail when dataplane is not connected", func() {
+		// when
+		err := revoke("backend-1")
+
+		// then
+		Expect(err).To(MatchError(`there is no insight of Dataplane "backend-1" in Mesh "default". The Dataplane has to be connected to the control plane`))
+	})
+
+	It("should fail when dataplane has no certificate", func() {
+		// given
+		createInsight("backend-1", "", now.Add(time.Hour))
+
+		// when
+		err := revoke("backend-1")
+
+		// then
+		Expect(err).To(MatchError(`Dataplane "backend-1" in Mesh "default" has not received a certificate yet`))
+	})
+
+	It("should fail when CA backend does not support revocation lists", func() {
+		// given
+		store = memory_resources.NewStore()
+		createMesh("vault")
+		createInsight("backend-1", "1a2b", now.Add(time.Hour))
+
+		// when
+		err := revoke("backend-1")
+
+		// then
+		Expect(err).To(MatchError(`certificates issued by CA backend "ca-1" of type "vault" can't be revoked. Revocation lists are supported by "builtin" and "provided" CA`))
+		err = store.Get(context.Background(), system.NewSecretResource(), core_store.GetBy(core_ca.RevocationsSecretKey("default")))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,103 @@
+package revoke
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
+)
+
+type revokeTokenContext struct {
+	tokenType string
+	output    string
+	namespace string
+}
+
+func NewRevokeTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &revokeTokenContext{}
+	cmd := &cobra.Command{
+		Use:   "token TOKEN",
+		Short: "Revoke a token",
+		Long: `Revoke a token.
+
+ID of the token is added to the revocation list, which is stored in a Secret (dataplane tokens) or GlobalSecret (other tokens).
+The expiration time of the token is recorded, so the entry is pruned from the list once the token expires anyway.
+
+On Kubernetes the API server is read-only, use "--output kubernetes" to print the updated Secret and apply it with kubectl.`,
+		Example: `kumactl revoke token --type dataplane $(cat /tmp/kuma-dp-backend-1-token)
+kumactl revoke token --type user $(cat /tmp/admin-token)
+kumactl revoke token --type user --output kubernetes $(cat /tmp/admin-token) | kubectl apply -f -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			claims, err := tokens.ParseRevokedToken(strings.TrimSpace(args[0]))
+			if err != nil {
+				return err
+			}
+			mesh := pctx.CurrentMesh()
+			if claims.Mesh != "" {
+				mesh = claims.Mesh
+			}
+			key, err := tokens.RevocationsKey(ctx.tokenType, mesh)
+			if err != nil {
+				return err
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			list, existing, err := tokens.GetRevocationList(context.Background(), rs, key)
+			if err != nil {
+				return err
+			}
+			if list.Contains(claims.ID) && ctx.output != kubernetesOutput {
+				cmd.Printf("token %q is already revoked\n", claims.ID)
+				return nil
+			}
+
+			now := pctx.Now()
+			revoked := core_tokens.RevokedToken{
+				ID:        claims.ID,
+				RevokedAt: &now,
+			}
+			if claims.ExpiresAt != nil {
+				expiresAt := claims.ExpiresAt.Time
+				revoked.ExpiresAt = &expiresAt
+			}
+			// tokens that already expired no longer have to be on the list
+			list = list.Prune(now)
+			if !list.Contains(claims.ID) {
+				list = append(list, revoked)
+			}
+
+			if ctx.output == kubernetesOutput {
+				data, err := list.Marshal()
+				if err != nil {
+					return err
+				}
+				secretType := common_k8s.GlobalSecretType
+				if key.Mesh != "" {
+					secretType = common_k8s.MeshSecretType
+				}
+				return printKubernetesSecret(cmd.OutOrStdout(), key, secretType, ctx.namespace, data)
+			}
+			if err := tokens.SaveRevocationList(context.Background(), rs, key, existing, list); err != nil {
+				return err
+			}
+
+			cmd.Printf("revoked token %q\n", claims.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&ctx.tokenType, "type", "", kuma_cmd.UsageOptions("type of the token", tokens.DataplaneTokenType, tokens.ZoneTokenType, tokens.ZoneIngressTokenType, tokens.UserTokenType))
+	cmd.Flags().StringVarP(&ctx.output, "output", "o", "", kuma_cmd.UsageOptions("print the updated revocation list instead of saving it through the API server", kubernetesOutput))
+	cmd.Flags().StringVar(&ctx.namespace, "namespace", defaultNamespace, "namespace of the Kuma Control Plane, used with --output kubernetes")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
@@ -0,0 +1,147 @@
+package revoke_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+type testClaims struct {
+	Mesh string
+	jwt.RegisteredClaims
+}
+
+var _ = Describe("kumactl revoke token", func() {
+
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+	var store core_store.ResourceStore
+	var now time.Time
+
+	newToken := func(id string, mesh string, expiresAt time.Time) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims{
+			Mesh: mesh,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        id,
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}).SignedString([]byte("key"))
+		Expect(err).ToNot(HaveOccurred())
+		return token
+	}
+
+	revocationList := func(key core_model.ResourceKey) core_tokens.RevocationList {
+		resource := core_tokens.NewRevocationsResource(key)
+		err := store.Get(context.Background(), resource, core_store.GetBy(key))
+		Expect(err).ToNot(HaveOccurred())
+		list, err := core_tokens.ParseRevocationList(resource.GetSpec().(*system_proto.Secret).GetData().GetValue())
+		Expect(err).ToNot(HaveOccurred())
+		return list
+	}
+
+	revoke := func(tokenType string, token string, flags ...string) error {
+		rootCmd.SetArgs(append([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"revoke", "token", "--type", tokenType, token}, flags...))
+		return rootCmd.Execute()
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.Now = func() time.Time { return now }
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+		store = memory_resources.NewStore()
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+	})
+
+	It("should add the dataplane token to the revocation list of its mesh", func() {
+		// when
+		err := revoke("dataplane", newToken("token-1", "demo", now.Add(time.Hour)))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(ContainSubstring(`revoked token "token-1"`))
+
+		// and
+		list := revocationList(core_model.ResourceKey{Mesh: "demo", Name: "dataplane-token-revocations-demo"})
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].ID).To(Equal("token-1"))
+		Expect(list[0].RevokedAt.Equal(now)).To(BeTrue())
+		Expect(list[0].ExpiresAt.Equal(now.Add(time.Hour))).To(BeTrue())
+	})
+
+	It("should prune expired tokens when revoking a token", func() {
+		// given
+		Expect(revoke("user", newToken("token-1", "", now.Add(time.Minute)))).To(Succeed())
+		now = now.Add(time.Hour)
+
+		// when
+		err := revoke("user", newToken("token-2", "", now.Add(time.Hour)))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		list := revocationList(core_model.ResourceKey{Name: "user-token-revocations"})
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].ID).To(Equal("token-2"))
+	})
+
+	It("should not duplicate already revoked token", func() {
+		// given
+		token := newToken("token-1", "", now.Add(time.Hour))
+		Expect(revoke("zone", token)).To(Succeed())
+
+		// when
+		err := revoke("zone", token)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(ContainSubstring(`token "token-1" is already revoked`))
+		Expect(revocationList(core_model.ResourceKey{Name: "zone-token-revocations"})).To(HaveLen(1))
+	})
+
+	It("should print the revocation list as a Kubernetes Secret", func() {
+		// when
+		err := revoke("user", newToken("token-1", "", now.Add(time.Hour)), "--output", "kubernetes")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(matchers.MatchGoldenYAML(filepath.Join("testdata", "revoke-token.kubernetes.golden.yaml")))
+
+		// and the revocation list is not saved through the API server
+		err = store.Get(context.Background(), core_tokens.NewRevocationsResource(core_model.ResourceKey{Name: "user-token-revocations"}), core_store.GetByKey("user-token-revocations", core_model.NoMesh))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail for unknown token type", func() {
+		// when
+		err := revoke("unknown", newToken("token-1", "", now.Add(time.Hour)))
+
+		// then
+		Expect(err).To(MatchError(`unsupported token type "unknown"`))
+	})
+})
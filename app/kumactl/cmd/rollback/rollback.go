@@ -0,0 +1,121 @@
+package rollback
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+func NewRollbackCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		toRevision int
+	}{}
+
+	byName := map[string]model.ResourceTypeDescriptor{}
+	allNames := []string{}
+	for _, desc := range pctx.Runtime.Registry.ObjectDescriptors(model.HasKumactlEnabled(), history.TrackedTypes()) {
+		byName[desc.KumactlArg] = desc
+		allNames = append(allNames, desc.KumactlArg)
+	}
+	sort.Strings(allNames)
+	cmd := &cobra.Command{
+		Use:   "rollback TYPE NAME",
+		Short: "Rollback Kuma resource to one of its previous revisions",
+		Long: `Rollback Kuma resource to one of its previous revisions.
+
+Revisions are recorded only when history is enabled on the Control Plane (KUMA_STORE_HISTORY_ENABLED).
+Only changes made through the Control Plane (HTTP API, kumactl apply, GUI) are recorded.
+On Kubernetes, changes applied with kubectl bypass the Control Plane and are not recorded.
+Rollback is a regular update of the resource, therefore it is recorded as a new revision.`,
+		Example: `kumactl rollback traffic-route route-1 --to-revision 3`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			if err := pctx.CheckServerVersionCompatibility(); err != nil {
+				cmd.PrintErrln(err)
+			}
+
+			resourceTypeArg := cmdArgs[0]
+			name := cmdArgs[1]
+
+			desc, ok := byName[resourceTypeArg]
+			if !ok {
+				return errors.Errorf("unknown TYPE: %s. Allowed values: %s", resourceTypeArg, strings.Join(allNames, ", "))
+			}
+			if args.toRevision < 1 {
+				return errors.New("--to-revision has to be greater than 0")
+			}
+
+			mesh := model.NoMesh
+			if desc.Scope == model.ScopeMesh {
+				mesh = pctx.CurrentMesh()
+			}
+
+			client, err := pctx.CurrentRevisionsClient()
+			if err != nil {
+				return err
+			}
+			revisions, err := client.List(context.Background(), desc, mesh, name)
+			if err != nil {
+				return errors.Wrapf(err, "failed to retrieve revisions of %s %q", desc.Name, name)
+			}
+			revision, err := findRevision(revisions, args.toRevision)
+			if err != nil {
+				return err
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			if err := rollbackResource(rs, desc, mesh, name, revision); err != nil {
+				return err
+			}
+
+			cmd.Printf("rolled back %s %q to revision %d\n", desc.Name, name, args.toRevision)
+			return nil
+		},
+	}
+	cmd.Flags().IntVar(&args.toRevision, "to-revision", 0, "revision to which the resource is rolled back")
+	_ = cmd.MarkFlagRequired("to-revision")
+	return cmd
+}
+
+func findRevision(revisions *history.RevisionList, number int) (history.Revision, error) {
+	for _, revision := range revisions.Items {
+		if revision.Revision == number {
+			return revision, nil
+		}
+	}
+	return history.Revision{}, errors.Errorf("there is no revision %d of %s %q", number, revisions.Type, revisions.Name)
+}
+
+func rollbackResource(rs store.ResourceStore, desc model.ResourceTypeDescriptor, mesh string, name string, revision history.Revision) error {
+	spec := desc.NewObject().GetSpec()
+	if err := util_proto.FromJSON(revision.Spec, spec); err != nil {
+		return errors.Wrapf(err, "could not parse revision %d", revision.Revision)
+	}
+
+	resource := desc.NewObject()
+	if err := rs.Get(context.Background(), resource, store.GetByKey(name, mesh)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return errors.Wrapf(err, "failed to get %s with the name %q", desc.Name, name)
+		}
+		if err := resource.SetSpec(spec); err != nil {
+			return err
+		}
+		return rs.Create(context.Background(), resource, store.CreateByKey(name, mesh))
+	}
+	if err := resource.SetSpec(spec); err != nil {
+		return err
+	}
+	return rs.Update(context.Background(), resource)
+}
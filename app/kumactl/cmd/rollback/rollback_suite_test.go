@@ -0,0 +1,11 @@
+package rollback_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestRollbackCmd(t *testing.T) {
+	test.RunSpecs(t, "Rollback Cmd Suite")
+}
@@ -0,0 +1,121 @@
+package rollback_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+type staticRevisionsClient struct {
+	list *history.RevisionList
+}
+
+func (s *staticRevisionsClient) List(_ context.Context, desc core_model.ResourceTypeDescriptor, mesh string, name string) (*history.RevisionList, error) {
+	return s.list, nil
+}
+
+var _ kumactl_resources.RevisionsClient = &staticRevisionsClient{}
+
+var _ = Describe("kumactl rollback", func() {
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		store = core_store.NewPaginationStore(memory_resources.NewStore())
+		Expect(store.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+
+		revisions := &staticRevisionsClient{
+			list: &history.RevisionList{
+				Type: core_mesh.TrafficPermissionType,
+				Mesh: core_model.DefaultMesh,
+				Name: "tp-1",
+				Items: []history.Revision{
+					{
+						Revision:  1,
+						Operation: history.Create,
+						Spec:      json.RawMessage(`{"sources":[{"match":{"kuma.io/service":"web"}}],"destinations":[{"match":{"kuma.io/service":"*"}}]}`),
+					},
+					{
+						Revision:  2,
+						Operation: history.Update,
+						Spec:      json.RawMessage(`{"sources":[{"match":{"kuma.io/service":"backend"}}],"destinations":[{"match":{"kuma.io/service":"*"}}]}`),
+					},
+				},
+			},
+		}
+
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+		rootCtx.Runtime.NewRevisionsClient = func(util_http.Client) kumactl_resources.RevisionsClient {
+			return revisions
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+	})
+
+	rollback := func(revision string) error {
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"rollback", "traffic-permission", "tp-1", "--to-revision", revision})
+		return rootCmd.Execute()
+	}
+
+	It("should recreate deleted resource", func() {
+		// when
+		err := rollback("2")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("rolled back TrafficPermission \"tp-1\" to revision 2\n"))
+
+		// and
+		tp := core_mesh.NewTrafficPermissionResource()
+		Expect(store.Get(context.Background(), tp, core_store.GetByKey("tp-1", core_model.DefaultMesh))).To(Succeed())
+		Expect(tp.Spec.Sources[0].Match[mesh_proto.ServiceTag]).To(Equal("backend"))
+	})
+
+	It("should update existing resource", func() {
+		// given
+		Expect(rollback("2")).To(Succeed())
+
+		// when
+		err := rollback("1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		tp := core_mesh.NewTrafficPermissionResource()
+		Expect(store.Get(context.Background(), tp, core_store.GetByKey("tp-1", core_model.DefaultMesh))).To(Succeed())
+		Expect(tp.Spec.Sources[0].Match[mesh_proto.ServiceTag]).To(Equal("web"))
+	})
+
+	It("should fail when revision does not exist", func() {
+		// when
+		err := rollback("7")
+
+		// then
+		Expect(err).To(MatchError(`there is no revision 7 of TrafficPermission "tp-1"`))
+	})
+})
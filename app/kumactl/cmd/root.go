@@ -7,13 +7,17 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/cmd/apply"
+	"github.com/kumahq/kuma/app/kumactl/cmd/backup"
 	"github.com/kumahq/kuma/app/kumactl/cmd/completion"
 	"github.com/kumahq/kuma/app/kumactl/cmd/config"
+	"github.com/kumahq/kuma/app/kumactl/cmd/debug"
 	"github.com/kumahq/kuma/app/kumactl/cmd/delete"
 	"github.com/kumahq/kuma/app/kumactl/cmd/generate"
 	"github.com/kumahq/kuma/app/kumactl/cmd/get"
 	"github.com/kumahq/kuma/app/kumactl/cmd/inspect"
 	"github.com/kumahq/kuma/app/kumactl/cmd/install"
+	"github.com/kumahq/kuma/app/kumactl/cmd/revoke"
+	"github.com/kumahq/kuma/app/kumactl/cmd/rollback"
 	"github.com/kumahq/kuma/app/kumactl/cmd/uninstall"
 	"github.com/kumahq/kuma/app/kumactl/cmd/version"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
@@ -84,11 +88,16 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(apply.NewApplyCmd(root))
 	cmd.AddCommand(completion.NewCompletionCommand())
 	cmd.AddCommand(config.NewConfigCmd(root))
+	cmd.AddCommand(debug.NewDebugCmd(root))
 	cmd.AddCommand(delete.NewDeleteCmd(root))
+	cmd.AddCommand(backup.NewExportCmd(root))
 	cmd.AddCommand(generate.NewGenerateCmd(root))
 	cmd.AddCommand(get.NewGetCmd(root))
+	cmd.AddCommand(backup.NewImportCmd(root))
 	cmd.AddCommand(inspect.NewInspectCmd(root))
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(revoke.NewRevokeCmd(root))
+	cmd.AddCommand(rollback.NewRollbackCmd(root))
 	cmd.AddCommand(uninstall.NewUninstallCmd())
 	cmd.AddCommand(version.NewCmd(root))
 
@@ -32,6 +32,14 @@ func InstallTracingFS() fs.FS {
 	return fsys
 }
 
+func InstallObservabilityFS() fs.FS {
+	fsys, err := fs.Sub(InstallData, "install/k8s/observability")
+	if err != nil {
+		panic(err)
+	}
+	return fsys
+}
+
 func InstallDemoFS() fs.FS {
 	fsys, err := fs.Sub(InstallData, "install/k8s/demo")
 	if err != nil {
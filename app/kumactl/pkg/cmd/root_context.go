@@ -21,6 +21,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	oidc_cli "github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/cli"
 	util_files "github.com/kumahq/kuma/pkg/util/files"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
@@ -51,6 +52,10 @@ type RootRuntime struct {
 	NewZoneIngressTokenClient    func(util_http.Client) tokens.ZoneIngressTokenClient
 	NewZoneTokenClient           func(util_http.Client) tokens.ZoneTokenClient
 	NewAPIServerClient           func(util_http.Client) kumactl_resources.ApiServerClient
+	NewRevisionsClient           func(util_http.Client) kumactl_resources.RevisionsClient
+	NewBatchClient               func(util_http.Client) kumactl_resources.BatchClient
+	NewSLORulesClient            func(util_http.Client) kumactl_resources.SLORulesClient
+	NewGraphClient               func(util_http.Client) kumactl_resources.GraphClient
 	Registry                     registry.TypeRegistry
 }
 
@@ -76,6 +81,7 @@ type RootContext struct {
 	InstallGatewayKongEnterpriseContext install_context.InstallGatewayKongEnterpriseContext
 	InstallTracingContext               install_context.InstallTracingContext
 	InstallLoggingContext               install_context.InstallLoggingContext
+	InstallObservabilityContext         install_context.InstallObservabilityContext
 }
 
 func DefaultRootContext() *RootContext {
@@ -85,7 +91,8 @@ func DefaultRootContext() *RootContext {
 			Registry:               registry.Global(),
 			NewBaseAPIServerClient: client.ApiServerClient,
 			AuthnPlugins: map[string]plugins.AuthnPlugin{
-				cli.AuthType: &cli.TokenAuthnPlugin{},
+				cli.AuthType:      &cli.TokenAuthnPlugin{},
+				oidc_cli.AuthType: oidc_cli.NewOIDCAuthnPlugin(),
 			},
 			NewResourceStore: func(client util_http.Client) core_store.ResourceStore {
 				return kumactl_resources.NewResourceStore(client, registry.Global().ObjectDescriptors())
@@ -102,6 +109,10 @@ func DefaultRootContext() *RootContext {
 			NewZoneIngressTokenClient:    tokens.NewZoneIngressTokenClient,
 			NewZoneTokenClient:           tokens.NewZoneTokenClient,
 			NewAPIServerClient:           kumactl_resources.NewAPIServerClient,
+			NewRevisionsClient:           kumactl_resources.NewRevisionsClient,
+			NewBatchClient:               kumactl_resources.NewBatchClient,
+			NewSLORulesClient:            kumactl_resources.NewSLORulesClient,
+			NewGraphClient:               kumactl_resources.NewGraphClient,
 		},
 		InstallCpContext:                    install_context.DefaultInstallCpContext(),
 		InstallCRDContext:                   install_context.DefaultInstallCrdsContext(),
@@ -111,6 +122,7 @@ func DefaultRootContext() *RootContext {
 		InstallGatewayKongEnterpriseContext: install_context.DefaultInstallGatewayKongEnterpriseContext(),
 		InstallTracingContext:               install_context.DefaultInstallTracingContext(),
 		InstallLoggingContext:               install_context.DefaultInstallLoggingContext(),
+		InstallObservabilityContext:         install_context.DefaultInstallObservabilityContext(),
 		GenerateContext:                     generate_context.DefaultGenerateContext(),
 	}
 }
@@ -176,7 +188,17 @@ func (rc *RootContext) BaseAPIServerClient() (util_http.Client, error) {
 		if !ok {
 			return nil, errors.Errorf("authentication plugin of type %q not found", controlPlane.Coordinates.ApiServer.AuthType)
 		}
-		client, err = plugin.DecorateClient(client, controlPlane.Coordinates.ApiServer.AuthConf)
+		if refreshPlugin, ok := plugin.(plugins.AuthnRefreshPlugin); ok {
+			client, err = refreshPlugin.DecorateClientWithRefresh(client, controlPlane.Coordinates.ApiServer.AuthConf, func(authConf map[string]string) {
+				// refreshed credentials are saved, so the next command does not have to refresh them again
+				controlPlane.Coordinates.ApiServer.AuthConf = authConf
+				if err := rc.SaveConfig(); err != nil {
+					core.Log.WithName("kumactl").Error(err, "could not save refreshed credentials", "controlPlane", controlPlane.Name)
+				}
+			})
+		} else {
+			client, err = plugin.DecorateClient(client, controlPlane.Coordinates.ApiServer.AuthConf)
+		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to decorate client with authentication type %q", controlPlane.Coordinates.ApiServer.AuthType)
 		}
@@ -281,6 +303,38 @@ func (rc *RootContext) CurrentZoneTokenClient() (tokens.ZoneTokenClient, error)
 	return rc.Runtime.NewZoneTokenClient(client), nil
 }
 
+func (rc *RootContext) CurrentRevisionsClient() (kumactl_resources.RevisionsClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewRevisionsClient(client), nil
+}
+
+func (rc *RootContext) CurrentSLORulesClient() (kumactl_resources.SLORulesClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewSLORulesClient(client), nil
+}
+
+func (rc *RootContext) CurrentGraphClient() (kumactl_resources.GraphClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewGraphClient(client), nil
+}
+
+func (rc *RootContext) CurrentBatchClient() (kumactl_resources.BatchClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewBatchClient(client), nil
+}
+
 func (rc *RootContext) IsFirstTimeUsage() bool {
 	if rc.Args.ConfigFile != "" {
 		return !util_files.FileExists(rc.Args.ConfigFile)
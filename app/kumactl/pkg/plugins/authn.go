@@ -1,8 +1,30 @@
 package plugins
 
-import util_http "github.com/kumahq/kuma/pkg/util/http"
+import (
+	"context"
+	"io"
+
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
 
 type AuthnPlugin interface {
 	Validate(map[string]string) error
 	DecorateClient(util_http.Client, map[string]string) (util_http.Client, error)
 }
+
+// AuthnLoginPlugin is implemented by plugins that obtain credentials interactively when a Control Plane is added.
+type AuthnLoginPlugin interface {
+	AuthnPlugin
+	// Login returns the authentication configuration extended with obtained credentials.
+	// Instructions for the user are written to out.
+	Login(ctx context.Context, authConf map[string]string, out io.Writer) (map[string]string, error)
+}
+
+// AuthnRefreshPlugin is implemented by plugins that refresh credentials, so the refreshed credentials can be saved
+// in the configuration and reused by the next command.
+type AuthnRefreshPlugin interface {
+	AuthnPlugin
+	// DecorateClientWithRefresh decorates the client like DecorateClient.
+	// onRefresh is called with the authentication configuration extended with refreshed credentials.
+	DecorateClientWithRefresh(client util_http.Client, authConf map[string]string, onRefresh func(map[string]string)) (util_http.Client, error)
+}
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// ErrBatchNotSupported is returned when the Control Plane does not expose the batch endpoint.
+var ErrBatchNotSupported = errors.New("applying resources in a batch is not supported by the Control Plane")
+
+type BatchClient interface {
+	// Apply creates or updates all resources or none of them.
+	Apply(ctx context.Context, resources []model.Resource) error
+}
+
+func NewBatchClient(client util_http.Client) BatchClient {
+	return &httpBatchClient{
+		Client: client,
+	}
+}
+
+type httpBatchClient struct {
+	Client util_http.Client
+}
+
+func (h *httpBatchClient) Apply(ctx context.Context, resources []model.Resource) error {
+	items := make([]*rest.Resource, len(resources))
+	for i, res := range resources {
+		items[i] = rest.From.Resource(res)
+	}
+	body, err := json.Marshal(struct {
+		Items []*rest.Resource `json:"items"`
+	}{Items: items})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", "/_batch", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("content-type", "application/json")
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return err
+	}
+	if statusCode == http.StatusNotFound {
+		return ErrBatchNotSupported
+	}
+	if statusCode != http.StatusOK {
+		return errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	return nil
+}
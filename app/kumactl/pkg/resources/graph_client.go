@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/graph"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type GraphClient interface {
+	Get(ctx context.Context, mesh string) (*graph.Graph, error)
+}
+
+func NewGraphClient(client util_http.Client) GraphClient {
+	return &httpGraphClient{
+		Client: client,
+	}
+}
+
+type httpGraphClient struct {
+	Client util_http.Client
+}
+
+func (h *httpGraphClient) Get(ctx context.Context, mesh string) (*graph.Graph, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/graph", mesh), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	g := &graph.Graph{}
+	if err := json.Unmarshal(b, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
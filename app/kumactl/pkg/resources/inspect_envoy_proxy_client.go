@@ -1,13 +1,16 @@
 package resources
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 
 	"github.com/pkg/errors"
 
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
@@ -15,6 +18,9 @@ import (
 
 type InspectEnvoyProxyClient interface {
 	ConfigDump(ctx context.Context, rk core_model.ResourceKey) ([]byte, error)
+	Stats(ctx context.Context, rk core_model.ResourceKey, filter string) ([]byte, error)
+	Clusters(ctx context.Context, rk core_model.ResourceKey) ([]byte, error)
+	SetLogLevel(ctx context.Context, rk core_model.ResourceKey, request api_server_types.LogLevelRequest) (*api_server_types.LogLevelsResponse, error)
 }
 
 func NewInspectEnvoyProxyClient(resDesc core_model.ResourceTypeDescriptor, client util_http.Client) InspectEnvoyProxyClient {
@@ -32,7 +38,51 @@ type httpInspectEnvoyProxyClient struct {
 var _ InspectEnvoyProxyClient = &httpInspectEnvoyProxyClient{}
 
 func (h *httpInspectEnvoyProxyClient) ConfigDump(ctx context.Context, rk core_model.ResourceKey) ([]byte, error) {
-	resUrl, err := h.buildURL(rk)
+	return h.get(ctx, rk, "xds", nil)
+}
+
+func (h *httpInspectEnvoyProxyClient) Stats(ctx context.Context, rk core_model.ResourceKey, filter string) ([]byte, error) {
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	return h.get(ctx, rk, "stats", query)
+}
+
+func (h *httpInspectEnvoyProxyClient) Clusters(ctx context.Context, rk core_model.ResourceKey) ([]byte, error) {
+	return h.get(ctx, rk, "clusters", nil)
+}
+
+func (h *httpInspectEnvoyProxyClient) SetLogLevel(ctx context.Context, rk core_model.ResourceKey, request api_server_types.LogLevelRequest) (*api_server_types.LogLevelsResponse, error) {
+	resUrl, err := h.buildURL(rk, "logging", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the url")
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", resUrl.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	statusCode, b, err := doRequest(h.client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	resp := &api_server_types.LogLevelsResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (h *httpInspectEnvoyProxyClient) get(ctx context.Context, rk core_model.ResourceKey, path string, query url.Values) ([]byte, error) {
+	resUrl, err := h.buildURL(rk, path, query)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not construct the url")
 	}
@@ -50,7 +100,7 @@ func (h *httpInspectEnvoyProxyClient) ConfigDump(ctx context.Context, rk core_mo
 	return b, nil
 }
 
-func (h *httpInspectEnvoyProxyClient) buildURL(rk core_model.ResourceKey) (*url.URL, error) {
+func (h *httpInspectEnvoyProxyClient) buildURL(rk core_model.ResourceKey, path string, query url.Values) (*url.URL, error) {
 	var prefix string
 	if h.resDesc.Scope == core_model.ScopeMesh {
 		prefix = fmt.Sprintf("/meshes/%s", rk.Mesh)
@@ -60,5 +110,10 @@ func (h *httpInspectEnvoyProxyClient) buildURL(rk core_model.ResourceKey) (*url.
 	if h.resDesc.Name == mesh.ZoneIngressType {
 		plural = "zoneingresses"
 	}
-	return url.Parse(fmt.Sprintf("%s/%s/%s/xds", prefix, plural, rk.Name))
+	u, err := url.Parse(fmt.Sprintf("%s/%s/%s/%s", prefix, plural, rk.Name, path))
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+	return u, nil
 }
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type RevisionsClient interface {
+	List(ctx context.Context, desc model.ResourceTypeDescriptor, mesh string, name string) (*history.RevisionList, error)
+}
+
+func NewRevisionsClient(client util_http.Client) RevisionsClient {
+	return &httpRevisionsClient{
+		Client: client,
+	}
+}
+
+type httpRevisionsClient struct {
+	Client util_http.Client
+}
+
+func (h *httpRevisionsClient) List(ctx context.Context, desc model.ResourceTypeDescriptor, mesh string, name string) (*history.RevisionList, error) {
+	resUrl := fmt.Sprintf("/%s/%s/_revisions", desc.WsPath, name)
+	if desc.Scope == model.ScopeMesh {
+		resUrl = fmt.Sprintf("/meshes/%s%s", mesh, resUrl)
+	}
+	req, err := http.NewRequest("GET", resUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == 404 {
+		return nil, errors.New("revision history is not enabled on the Control Plane")
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	list := &history.RevisionList{}
+	if err := json.Unmarshal(b, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/slo"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type SLORulesClient interface {
+	Get(ctx context.Context, mesh string) (*slo.RuleFile, error)
+}
+
+func NewSLORulesClient(client util_http.Client) SLORulesClient {
+	return &httpSLORulesClient{
+		Client: client,
+	}
+}
+
+type httpSLORulesClient struct {
+	Client util_http.Client
+}
+
+func (h *httpSLORulesClient) Get(ctx context.Context, mesh string) (*slo.RuleFile, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/slo-rules", mesh), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	rules := &slo.RuleFile{}
+	if err := json.Unmarshal(b, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -0,0 +1,104 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	user_issuer "github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/issuer"
+	dp_issuer "github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zone"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	DataplaneTokenType   = "dataplane"
+	ZoneTokenType        = "zone"
+	ZoneIngressTokenType = "zone-ingress"
+	UserTokenType        = "user"
+)
+
+// RevocationsKey returns a key of the Secret (or GlobalSecret) in which revoked tokens of the given type are stored.
+func RevocationsKey(tokenType string, mesh string) (core_model.ResourceKey, error) {
+	switch tokenType {
+	case DataplaneTokenType:
+		return dp_issuer.DataplaneTokenRevocationsSecretKey(mesh), nil
+	case ZoneTokenType:
+		return zone.TokenRevocationsGlobalSecretKey, nil
+	case ZoneIngressTokenType:
+		return zoneingress.ZoneIngressTokenRevocationsGlobalSecretKey, nil
+	case UserTokenType:
+		return user_issuer.UserTokenRevocationsGlobalSecretKey, nil
+	default:
+		return core_model.ResourceKey{}, errors.Errorf("unsupported token type %q", tokenType)
+	}
+}
+
+// RevokedTokenClaims are claims of the token that are required to revoke it.
+// The token is not verified, it's only parsed.
+type RevokedTokenClaims struct {
+	// Mesh is set only in dataplane tokens
+	Mesh string
+	jwt.RegisteredClaims
+}
+
+func ParseRevokedToken(token string) (*RevokedTokenClaims, error) {
+	claims := &RevokedTokenClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return nil, errors.Wrap(err, "could not parse token")
+	}
+	if claims.ID == "" {
+		return nil, errors.New("token does not have an ID (jti claim) and can't be revoked")
+	}
+	return claims, nil
+}
+
+// GetRevocationList returns the revocation list stored under the key. The list is empty if it does not exist yet.
+func GetRevocationList(ctx context.Context, rs core_store.ResourceStore, key core_model.ResourceKey) (core_tokens.RevocationList, core_model.Resource, error) {
+	resource := core_tokens.NewRevocationsResource(key)
+	if err := rs.Get(ctx, resource, core_store.GetBy(key)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, errors.Wrap(err, "failed to get the revocation list")
+	}
+	list, err := core_tokens.ParseRevocationList(resource.GetSpec().(*system_proto.Secret).GetData().GetValue())
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse the revocation list in %q", key.Name)
+	}
+	return list, resource, nil
+}
+
+// SaveRevocationList creates or updates the revocation list stored under the key.
+// The existing resource is the one returned by GetRevocationList.
+func SaveRevocationList(ctx context.Context, rs core_store.ResourceStore, key core_model.ResourceKey, existing core_model.Resource, list core_tokens.RevocationList) error {
+	data, err := list.Marshal()
+	if err != nil {
+		return err
+	}
+	spec := &system_proto.Secret{
+		Data: util_proto.Bytes(data),
+	}
+	if existing != nil {
+		if err := existing.SetSpec(spec); err != nil {
+			return err
+		}
+		err = rs.Update(ctx, existing)
+	} else {
+		resource := core_tokens.NewRevocationsResource(key)
+		if err := resource.SetSpec(spec); err != nil {
+			return err
+		}
+		err = rs.Create(ctx, resource, core_store.CreateBy(key))
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to save the revocation list")
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	kumactl_client "github.com/kumahq/kuma/app/kumactl/pkg/client"
 	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
 	config_kumactl "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/access"
 	tokens_server "github.com/kumahq/kuma/pkg/tokens/builtin/server"
 	zone_access "github.com/kumahq/kuma/pkg/tokens/builtin/zone/access"
@@ -41,6 +42,7 @@ var _ = Describe("Zone Ingress Tokens Client", func() {
 			&zoneStaticTokenIssuer{},
 			access.NoopDpTokenAccess{},
 			zone_access.NoopZoneTokenAccess{},
+			audit.NopLogger(),
 		))
 		server = httptest.NewServer(container.ServeMux)
 	})
@@ -27,6 +27,7 @@ protoc/pkg/test/apis/sample/v1alpha1:
 protoc/plugins:
 	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/provided/config/*.proto
 	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/builtin/config/*.proto
+	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/vault/config/*.proto
 
 KUMA_GUI_GIT_URL=https://github.com/kumahq/kuma-gui.git
 KUMA_GUI_VERSION=master
@@ -0,0 +1,189 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	core_access "github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// batchEndpoints applies several resources at once. Every resource is validated before any of them is applied
+// and then either all of them are applied or none.
+type batchEndpoints struct {
+	resManager     manager.ResourceManager
+	descriptors    map[model.ResourceType]model.ResourceTypeDescriptor
+	resourceAccess access.ResourceAccess
+	transactions   store.Transactions
+	auditLogger    audit.Logger
+}
+
+type batchRequest struct {
+	Items []json.RawMessage `json:"items"`
+}
+
+func (b *batchEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.POST("/_batch").To(b.applyBatch).
+		Doc("Create or update several resources atomically").
+		Returns(200, "OK", nil))
+}
+
+func (b *batchEndpoints) applyBatch(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	req := batchRequest{}
+	if err := request.ReadEntity(&req); err != nil {
+		rest_errors.HandleError(response, err, "Could not process resources")
+		return
+	}
+
+	resources, err := b.parseAndValidate(req)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not process resources")
+		return
+	}
+
+	events := make([]audit.Event, len(resources))
+	for i, res := range resources {
+		event, err := b.authorize(ctx, res)
+		if err != nil {
+			if errors.Is(err, &core_access.AccessDeniedError{}) {
+				b.auditLogger.Log(ctx, audit.AccessDenied(event, err))
+				rest_errors.HandleError(response, err, "Access Denied")
+				return
+			}
+			event.Err = err
+			b.auditLogger.Log(ctx, event)
+			rest_errors.HandleError(response, err, fmt.Sprintf("Could not process %s %q", event.ResourceType, event.Key.Name))
+			return
+		}
+		events[i] = event
+	}
+
+	var failed *audit.Event
+	err = manager.InTransaction(ctx, b.resManager, b.transactions, func(ctx context.Context, rm manager.ResourceManager) error {
+		for i := range events {
+			if err := b.apply(ctx, rm, resources[i], events[i].Operation); err != nil {
+				failed = &events[i]
+				return err
+			}
+		}
+		return nil
+	})
+	for _, event := range events {
+		event.Err = err
+		b.auditLogger.Log(ctx, event)
+	}
+	if err != nil {
+		rest_errors.HandleError(response, err, fmt.Sprintf("Could not apply %s %q", failed.ResourceType, failed.Key.Name))
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+func (b *batchEndpoints) parseAndValidate(req batchRequest) ([]model.Resource, error) {
+	var verr validators.ValidationError
+	if len(req.Items) == 0 {
+		verr.AddViolation("items", "must have at least one resource")
+	}
+	var resources []model.Resource
+	keys := map[string]bool{}
+	for i, item := range req.Items {
+		path := validators.RootedAt("items").Index(i)
+
+		meta := rest.ResourceMeta{}
+		if err := json.Unmarshal(item, &meta); err != nil {
+			verr.AddViolationAt(path, err.Error())
+			continue
+		}
+		desc, ok := b.descriptors[model.ResourceType(meta.Type)]
+		if !ok {
+			verr.AddViolationAt(path.Field("type"), fmt.Sprintf("unknown type %q", meta.Type))
+			continue
+		}
+		if desc.ReadOnly {
+			verr.AddViolationAt(path.Field("type"), fmt.Sprintf("resources of type %q cannot be modified via the HTTP API on this control plane", meta.Type))
+			continue
+		}
+		restRes := rest.Resource{Spec: desc.NewObject().GetSpec()}
+		if err := json.Unmarshal(item, &restRes); err != nil {
+			verr.AddViolationAt(path, err.Error())
+			continue
+		}
+		if desc.Scope == model.ScopeGlobal {
+			restRes.Meta.Mesh = model.NoMesh
+		}
+		if err := mesh.ValidateMeta(restRes.Meta.Name, restRes.Meta.Mesh, desc.Scope); err.HasViolations() {
+			verr.AddErrorAt(path, err)
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s", desc.Name, restRes.Meta.Mesh, restRes.Meta.Name)
+		if keys[key] {
+			verr.AddViolationAt(path, "resource is duplicated")
+			continue
+		}
+		keys[key] = true
+
+		res := desc.NewObject()
+		res.SetMeta(&restRes.Meta)
+		_ = res.SetSpec(restRes.Spec)
+		if err := res.Validate(); err != nil {
+			if specErr, ok := err.(*validators.ValidationError); ok {
+				verr.AddErrorAt(path, *specErr)
+			} else {
+				verr.AddViolationAt(path, err.Error())
+			}
+			continue
+		}
+		resources = append(resources, res)
+	}
+	return resources, verr.OrNil()
+}
+
+func (b *batchEndpoints) authorize(ctx context.Context, res model.Resource) (audit.Event, error) {
+	event := audit.Event{
+		Operation:    audit.Create,
+		ResourceType: res.Descriptor().Name,
+		Key:          model.MetaToResourceKey(res.GetMeta()),
+		After:        res.GetSpec(),
+	}
+	existing := res.Descriptor().NewObject()
+	if err := b.resManager.Get(ctx, existing, store.GetBy(event.Key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return event, err
+		}
+		return event, b.resourceAccess.ValidateCreate(event.Key, res.GetSpec(), res.Descriptor(), user.FromCtx(ctx))
+	}
+	event.Operation = audit.Update
+	event.Before = existing.GetSpec()
+	return event, b.resourceAccess.ValidateUpdate(event.Key, res.GetSpec(), res.Descriptor(), user.FromCtx(ctx))
+}
+
+func (b *batchEndpoints) apply(ctx context.Context, rm manager.ResourceManager, res model.Resource, operation audit.Operation) error {
+	key := model.MetaToResourceKey(res.GetMeta())
+	if operation == audit.Create {
+		created := res.Descriptor().NewObject()
+		_ = created.SetSpec(res.GetSpec())
+		return rm.Create(ctx, created, store.CreateBy(key))
+	}
+	existing := res.Descriptor().NewObject()
+	if err := rm.Get(ctx, existing, store.GetBy(key)); err != nil {
+		return err
+	}
+	_ = existing.SetSpec(res.GetSpec())
+	return rm.Update(ctx, existing)
+}
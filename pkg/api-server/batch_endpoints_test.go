@@ -0,0 +1,253 @@
+package api_server_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type getFailingStore struct {
+	store.ResourceStore
+	err error
+}
+
+func (s *getFailingStore) Get(ctx context.Context, res model.Resource, opts ...store.GetOptionsFunc) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.ResourceStore.Get(ctx, res, opts...)
+}
+
+var _ = Describe("Batch Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore *getFailingStore
+	var client resourceApiClient
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = &getFailingStore{ResourceStore: memory.NewStore()}
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+		client = resourceApiClient{
+			apiServer.Address(),
+			"/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		err := resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	postBatch := func(json string) *http.Response {
+		response, err := http.Post("http://"+apiServer.Address()+"/_batch", "application/json", bytes.NewBufferString(json))
+		Expect(err).ToNot(HaveOccurred())
+		return response
+	}
+
+	countTrafficPermissions := func() int {
+		list := core_mesh.TrafficPermissionResourceList{}
+		Expect(resourceStore.List(context.Background(), &list)).To(Succeed())
+		return len(list.Items)
+	}
+
+	It("should apply all resources", func() {
+		// when
+		response := postBatch(`
+		{
+			"items": [
+				{
+					"type": "Mesh",
+					"name": "demo"
+				},
+				{
+					"type": "TrafficPermission",
+					"mesh": "demo",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				}
+			]
+		}`)
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		// and
+		tp := core_mesh.NewTrafficPermissionResource()
+		err := resourceStore.Get(context.Background(), tp, store.GetByKey("tp-1", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should update existing resources", func() {
+		// given
+		response := postBatch(`
+		{
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				}
+			]
+		}`)
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		// when
+		response = postBatch(`
+		{
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "web"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				}
+			]
+		}`)
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		tp := core_mesh.NewTrafficPermissionResource()
+		err := resourceStore.Get(context.Background(), tp, store.GetByKey("tp-1", model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tp.Spec.Sources[0].Match["kuma.io/service"]).To(Equal("web"))
+	})
+
+	It("should not apply anything when one of resources is invalid", func() {
+		// when
+		response := postBatch(`
+		{
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				},
+				{
+					"type": "Unknown",
+					"mesh": "default",
+					"name": "x"
+				},
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				}
+			]
+		}`)
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not process resources",
+			"details": "Resource is not valid",
+			"causes": [
+				{
+					"field": "items[1].type",
+					"message": "unknown type \"Unknown\""
+				},
+				{
+					"field": "items[2]",
+					"message": "resource is duplicated"
+				}
+			]
+		}`))
+
+		// and
+		Expect(countTrafficPermissions()).To(Equal(0))
+	})
+
+	It("should revert applied resources when applying one of them fails", func() {
+		// when
+		response := postBatch(`
+		{
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				},
+				{
+					"type": "TrafficPermission",
+					"mesh": "not-existing",
+					"name": "tp-2",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				}
+			]
+		}`)
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring(`Could not apply TrafficPermission \"tp-2\"`))
+
+		// and
+		Expect(countTrafficPermissions()).To(Equal(0))
+	})
+
+	It("should return internal error when resources cannot be read", func() {
+		// given
+		resourceStore.err = errors.New("store is unavailable")
+
+		// when
+		response := postBatch(`
+		{
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "tp-1",
+					"sources": [{"match": {"kuma.io/service": "*"}}],
+					"destinations": [{"match": {"kuma.io/service": "*"}}]
+				}
+			]
+		}`)
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring(`Could not process TrafficPermission \"tp-1\"`))
+		Expect(string(body)).ToNot(ContainSubstring("Access Denied"))
+	})
+})
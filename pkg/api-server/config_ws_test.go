@@ -59,8 +59,22 @@ var _ = Describe("Config WS", func() {
 			  "type": "tokens",
 			  "tokens": {
 			    "bootstrapAdminToken": true
+			  },
+			  "oidc": {
+			    "issuer": "",
+			    "audience": "",
+			    "jwksURL": "",
+			    "jwksFile": "",
+			    "usernameClaim": "sub",
+			    "groupsClaim": "groups",
+			    "groupsPrefix": "oidc:",
+			    "usernamePrefix": "oidc:"
 			  }
 			},
+			"audit": {
+			  "level": "none",
+			  "output": "stdout"
+			},
 			"corsAllowedDomains": [
 			  ".*"
 			],
@@ -89,6 +103,7 @@ var _ = Describe("Config WS", func() {
 			}
 		  },
 		  "defaults": {
+			"signingKeyAlgorithm": "RSA",
 			"skipMeshCreation": false
 		  },
 		  "diagnostics": {
@@ -103,7 +118,14 @@ var _ = Describe("Config WS", func() {
 		  },
 		  "dpServer": {
 			"auth": {
-			  "type": ""
+			  "type": "",
+			  "attestation": {
+			    "enabled": false,
+			    "tokenValidity": "1h0m0s",
+			    "staticKey": {
+			      "publicKeys": {}
+			    }
+			  }
 			},
 			"hds": {
 			  "checkDefaults": {
@@ -140,6 +162,11 @@ var _ = Describe("Config WS", func() {
 			  "maxResyncTimeout": "20s",
 			  "minResyncTimeout": "1s"
 			},
+			"slo": {
+			  "queryEndpoint": "",
+			  "queryTimeout": "5s",
+			  "refreshInterval": "1m0s"
+			},
 			"zone": {
 			  "subscriptionLimit": 10,
 			  "idleTimeout": "5m0s"
@@ -260,7 +287,14 @@ var _ = Describe("Config WS", func() {
             "tlsCertFile": "",
             "tlsKeyFile": "",
             "auth": {
-              "type": ""
+              "type": "",
+              "attestation": {
+                "enabled": false,
+                "tokenValidity": "1h0m0s",
+                "staticKey": {
+                  "publicKeys": {}
+                }
+              }
             },
             "hds": {
               "checkDefaults": {
@@ -307,6 +341,17 @@ var _ = Describe("Config WS", func() {
               "conflictRetryBaseBackoff": "100ms",
               "conflictRetryMaxTimes": 5
             },
+            "history": {
+              "enabled": false,
+              "limit": 10
+            },
+            "secrets": {
+              "encryption": {
+                "activeKeyId": "",
+                "keysFile": "",
+                "keys": {}
+              }
+            },
             "type": "memory"
           },
           "xdsServer": {
@@ -340,6 +385,16 @@ var _ = Describe("Config WS", func() {
               "viewConfigDump": {
                 "users": [ ],
                 "groups": ["mesh-system:unauthenticated","mesh-system:authenticated"]
+              },
+              "changeLogLevel": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
+              }
+            },
+            "rbac": {
+              "admin": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
               }
             }
           },
@@ -11,6 +11,7 @@ import (
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -31,7 +32,7 @@ func TestWs(t *testing.T) {
 	test.RunSpecs(t, "API Server Customization")
 }
 
-func createTestApiServer(store store.ResourceStore, config *config_api_server.ApiServerConfig, enableGUI bool, metrics core_metrics.Metrics, wsManager customization.APIManager) *api_server.ApiServer {
+func createTestApiServer(resourceStore store.ResourceStore, config *config_api_server.ApiServerConfig, enableGUI bool, metrics core_metrics.Metrics, wsManager customization.APIManager) *api_server.ApiServer {
 	// we have to manually search for port and put it into config. There is no way to retrieve port of running
 	// http.Server and we need it later for the client
 	port, err := test.GetFreePort()
@@ -53,13 +54,14 @@ func createTestApiServer(store store.ResourceStore, config *config_api_server.Ap
 	cfg := kuma_cp.DefaultConfig()
 	cfg.ApiServer = config
 	apiServer, err := api_server.NewApiServer(
-		manager.NewResourceManager(store),
+		manager.NewResourceManager(resourceStore),
+		config_manager.NewConfigManager(resourceStore),
 		xds_context.NewMeshContextBuilder(
-			manager.NewResourceManager(store),
+			manager.NewResourceManager(resourceStore),
 			server.MeshResourceTypes(server.HashMeshExcludedResources),
 			net.LookupIP,
 			cfg.Multizone.Zone.Name,
-			vips.NewPersistence(manager.NewResourceManager(store), config_manager.NewConfigManager(store)),
+			vips.NewPersistence(manager.NewResourceManager(resourceStore), config_manager.NewConfigManager(resourceStore)),
 			cfg.DNSServer.Domain,
 		),
 		wsManager,
@@ -75,6 +77,9 @@ func createTestApiServer(store store.ResourceStore, config *config_api_server.Ap
 			DataplaneTokenAccess: nil,
 		},
 		&test_runtime.DummyEnvoyAdminClient{},
+		nil,
+		audit.NopLogger(),
+		store.NoTransactions{},
 	)
 	Expect(err).ToNot(HaveOccurred())
 	return apiServer
@@ -0,0 +1,312 @@
+package api_server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/envoy/admin/access"
+)
+
+const (
+	// DefaultLogLevelTTL is used when the request to change log levels does not specify TTL
+	DefaultLogLevelTTL = 10 * time.Minute
+	// MaxLogLevelTTL limits how long proxies can log with changed levels, verbose levels affect performance of Envoy
+	MaxLogLevelTTL = 24 * time.Hour
+	// logLevelsRevertInterval is how often expired changes of log levels are looked for, so they are reverted at most that late
+	logLevelsRevertInterval = 5 * time.Second
+)
+
+// envoyLogLevels are levels accepted by /logging endpoint of Envoy
+var envoyLogLevels = []string{"trace", "debug", "info", "warning", "warn", "error", "critical", "off"}
+
+// envoyAdminProxy is a type of proxy which Envoy admin API is exposed through the API server
+type envoyAdminProxy struct {
+	path      string
+	nameParam string
+	desc      core_model.ResourceTypeDescriptor
+}
+
+var envoyAdminProxies = []envoyAdminProxy{
+	{path: "/meshes/{mesh}/dataplanes/{dataplane}", nameParam: "dataplane", desc: core_mesh.DataplaneResourceTypeDescriptor},
+	{path: "/zoneingresses/{zoneingress}", nameParam: "zoneingress", desc: core_mesh.ZoneIngressResourceTypeDescriptor},
+	{path: "/zoneegresses/{zoneegress}", nameParam: "zoneegress", desc: core_mesh.ZoneEgressResourceTypeDescriptor},
+}
+
+func (p envoyAdminProxy) key(request *restful.Request) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: request.PathParameter("mesh"),
+		Name: request.PathParameter(p.nameParam),
+	}
+}
+
+func (p envoyAdminProxy) params(ws *restful.WebService) []*restful.Parameter {
+	var params []*restful.Parameter
+	if p.desc.Scope == core_model.ScopeMesh {
+		params = append(params, ws.PathParameter("mesh", "mesh name").DataType("string"))
+	}
+	return append(params, ws.PathParameter(p.nameParam, fmt.Sprintf("%s name", p.nameParam)).DataType("string"))
+}
+
+type envoyAdminEndpoints struct {
+	rm               manager.ResourceManager
+	access           access.ConfigDumpAccess
+	envoyAdminClient admin.EnvoyAdminClient
+	logLevels        *admin.TemporaryLogLevels
+	auditLogger      audit.Logger
+	localZone        string
+	defaultAdminPort uint32
+}
+
+func (e *envoyAdminEndpoints) addEndpoints(ws *restful.WebService) {
+	for _, proxy := range envoyAdminProxies {
+		route := func(builder *restful.RouteBuilder) {
+			for _, param := range proxy.params(ws) {
+				builder.Param(param)
+			}
+			ws.Route(builder)
+		}
+		route(ws.GET(proxy.path + "/stats").To(e.inspectStats(proxy)).
+			Doc(fmt.Sprintf("inspect %s stats", proxy.desc.Name)).
+			Param(ws.QueryParameter("filter", "regex that stats names have to match").DataType("string")))
+		route(ws.GET(proxy.path + "/clusters").To(e.inspectClusters(proxy)).
+			Doc(fmt.Sprintf("inspect %s clusters with health and outlier detection status", proxy.desc.Name)))
+		route(ws.GET(proxy.path + "/listeners").To(e.inspectListeners(proxy)).
+			Doc(fmt.Sprintf("inspect %s listeners", proxy.desc.Name)))
+		route(ws.GET(proxy.path + "/logging").To(e.inspectLogLevels(proxy)).
+			Doc(fmt.Sprintf("inspect %s log levels", proxy.desc.Name)))
+		route(ws.PUT(proxy.path + "/logging").To(e.changeLogLevel(proxy)).
+			Doc(fmt.Sprintf("change %s log levels temporarily", proxy.desc.Name)))
+	}
+}
+
+func (e *envoyAdminEndpoints) addMethodNotAllowedEndpoints(ws *restful.WebService) {
+	methodNotAllowed := func(_ *restful.Request, response *restful.Response) {
+		kumaErr := types.Error{
+			Title:   "Method is not allowed",
+			Details: "It is not possible to access envoy admin API on Global CP. Please consider using Zone CP of the corresponding zone",
+		}
+		rest_errors.WriteError(response, http.StatusMethodNotAllowed, kumaErr)
+	}
+	for _, proxy := range envoyAdminProxies {
+		for _, builder := range []*restful.RouteBuilder{
+			ws.GET(proxy.path + "/stats"),
+			ws.GET(proxy.path + "/clusters"),
+			ws.GET(proxy.path + "/listeners"),
+			ws.GET(proxy.path + "/logging"),
+			ws.PUT(proxy.path + "/logging"),
+		} {
+			builder.To(methodNotAllowed)
+			for _, param := range proxy.params(ws) {
+				builder.Param(param)
+			}
+			ws.Route(builder)
+		}
+	}
+}
+
+func (e *envoyAdminEndpoints) inspectStats(proxy envoyAdminProxy) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		res, err := e.viewProxy(request, proxy)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get stats")
+			return
+		}
+		stats, err := e.envoyAdminClient.Stats(res, e.defaultAdminPort, request.QueryParameter("filter"))
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get stats")
+			return
+		}
+		response.Header().Set("content-type", "text/plain")
+		if _, err := response.Write(stats); err != nil {
+			core.Log.Error(err, "Could not write the response")
+		}
+	}
+}
+
+func (e *envoyAdminEndpoints) inspectClusters(proxy envoyAdminProxy) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		res, err := e.viewProxy(request, proxy)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get clusters")
+			return
+		}
+		clusters, err := e.envoyAdminClient.Clusters(res, e.defaultAdminPort)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get clusters")
+			return
+		}
+		if _, err := response.Write(clusters); err != nil {
+			core.Log.Error(err, "Could not write the response")
+		}
+	}
+}
+
+func (e *envoyAdminEndpoints) inspectListeners(proxy envoyAdminProxy) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		res, err := e.viewProxy(request, proxy)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get listeners")
+			return
+		}
+		listeners, err := e.envoyAdminClient.Listeners(res, e.defaultAdminPort)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get listeners")
+			return
+		}
+		if _, err := response.Write(listeners); err != nil {
+			core.Log.Error(err, "Could not write the response")
+		}
+	}
+}
+
+func (e *envoyAdminEndpoints) inspectLogLevels(proxy envoyAdminProxy) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		res, err := e.viewProxy(request, proxy)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get log levels")
+			return
+		}
+		levels, err := e.envoyAdminClient.LogLevels(res, e.defaultAdminPort)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get log levels")
+			return
+		}
+		resp := api_server_types.LogLevelsResponse{
+			Levels: levels,
+		}
+		revertAt, ok, err := e.logLevels.RevertAt(request.Request.Context(), res)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get log levels")
+			return
+		}
+		if ok {
+			resp.RevertAt = &revertAt
+		}
+		if err := response.WriteAsJson(resp); err != nil {
+			core.Log.Error(err, "Could not write the response")
+		}
+	}
+}
+
+func (e *envoyAdminEndpoints) changeLogLevel(proxy envoyAdminProxy) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		key := proxy.key(request)
+
+		req := api_server_types.LogLevelRequest{}
+		if err := request.ReadEntity(&req); err != nil {
+			rest_errors.HandleError(response, &validators.ValidationError{}, "Could not read a request")
+			return
+		}
+		ttl, verr := validateLogLevelRequest(req)
+		if verr.HasViolations() {
+			rest_errors.HandleError(response, verr.OrNil(), "Invalid request")
+			return
+		}
+
+		event := audit.Event{
+			Operation:    audit.SetLogLevel,
+			ResourceType: proxy.desc.Name,
+			Key:          key,
+			Details: map[string]string{
+				"logger": req.Logger,
+				"level":  req.Level,
+				"ttl":    ttl.String(),
+			},
+		}
+		if err := e.access.ValidateChangeLogLevel(key, proxy.desc, user.FromCtx(ctx)); err != nil {
+			e.auditLogger.Log(ctx, audit.AccessDenied(event, err))
+			rest_errors.HandleError(response, err, "Could not change log level")
+			return
+		}
+
+		res, err := e.getProxy(request, proxy)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not change log level")
+			return
+		}
+		levels, revertAt, err := e.logLevels.Set(ctx, res, e.defaultAdminPort, req.Logger, req.Level, ttl)
+		event.Err = err
+		e.auditLogger.Log(ctx, event)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not change log level")
+			return
+		}
+
+		if err := response.WriteAsJson(api_server_types.LogLevelsResponse{
+			Levels:   levels,
+			RevertAt: &revertAt,
+		}); err != nil {
+			core.Log.Error(err, "Could not write the response")
+		}
+	}
+}
+
+func validateLogLevelRequest(req api_server_types.LogLevelRequest) (time.Duration, validators.ValidationError) {
+	verr := validators.ValidationError{}
+	validLevel := false
+	for _, level := range envoyLogLevels {
+		if req.Level == level {
+			validLevel = true
+		}
+	}
+	if !validLevel {
+		verr.AddViolation("level", fmt.Sprintf("must be one of: %v", envoyLogLevels))
+	}
+	ttl := DefaultLogLevelTTL
+	if req.TTL != "" {
+		dur, err := time.ParseDuration(req.TTL)
+		switch {
+		case err != nil:
+			verr.AddViolation("ttl", "is invalid: "+err.Error())
+		case dur <= 0:
+			verr.AddViolation("ttl", "must be positive")
+		case dur > MaxLogLevelTTL:
+			verr.AddViolation("ttl", "must not be longer than "+MaxLogLevelTTL.String())
+		}
+		ttl = dur
+	}
+	return ttl, verr
+}
+
+// viewProxy checks an access to view the Envoy admin API of the proxy and returns the proxy.
+func (e *envoyAdminEndpoints) viewProxy(request *restful.Request, proxy envoyAdminProxy) (admin.ResourceWithAddress, error) {
+	if err := e.access.ValidateViewConfigDump(proxy.key(request), proxy.desc, user.FromCtx(request.Request.Context())); err != nil {
+		return nil, err
+	}
+	return e.getProxy(request, proxy)
+}
+
+func (e *envoyAdminEndpoints) getProxy(request *restful.Request, proxy envoyAdminProxy) (admin.ResourceWithAddress, error) {
+	key := proxy.key(request)
+	res := proxy.desc.NewObject()
+	if err := e.rm.Get(request.Request.Context(), res, store.GetByKey(key.Name, key.Mesh)); err != nil {
+		return nil, err
+	}
+	if zi, ok := res.(*core_mesh.ZoneIngressResource); ok && zi.IsRemoteIngress(e.localZone) {
+		verr := validators.ValidationError{}
+		verr.AddViolation("zoneingress", "resides in another zone, use Zone CP of the corresponding zone")
+		return nil, verr.OrNil()
+	}
+	withAddress, ok := res.(admin.ResourceWithAddress)
+	if !ok {
+		return nil, errors.Errorf("resource of type %s does not expose envoy admin API", proxy.desc.Name)
+	}
+	return withAddress, nil
+}
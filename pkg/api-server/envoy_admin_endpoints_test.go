@@ -0,0 +1,160 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("Envoy Admin Endpoints", func() {
+
+	var apiServer *api_server.ApiServer
+
+	startApiServer := func(mode config_core.CpMode) {
+		resourceStore := memory.NewStore()
+		rm := manager.NewResourceManager(resourceStore)
+		for _, resource := range []core_model.Resource{
+			newMesh("mesh-1"),
+			newDataplane().
+				meta("backend-1", "mesh-1").
+				admin(3301).
+				inbound80to81("backend", "192.168.0.1").
+				build(),
+		} {
+			Expect(rm.Create(context.Background(), resource, store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))).To(Succeed())
+		}
+
+		m, err := metrics.NewMetrics("Zone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, m, func(config *kuma_cp.Config) {
+			config.Mode = mode
+		})
+		stop := make(chan struct{})
+		DeferCleanup(func() {
+			close(stop)
+		})
+		go func() {
+			defer GinkgoRecover()
+			Expect(apiServer.Start(stop)).To(Succeed())
+		}()
+		Eventually(func() error {
+			_, err := http.Get((&url.URL{Scheme: "http", Host: apiServer.Address()}).String())
+			return err
+		}, "3s").ShouldNot(HaveOccurred())
+	}
+
+	do := func(method string, path string, body string) (int, []byte) {
+		u := (&url.URL{
+			Scheme: "http",
+			Host:   apiServer.Address(),
+		}).String() + path
+		req, err := http.NewRequest(method, u, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		bytes, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, bytes
+	}
+
+	Context("on Zone CP", func() {
+		BeforeEach(func() {
+			startApiServer(config_core.Zone)
+		})
+
+		It("should return stats of the dataplane", func() {
+			// when
+			status, body := do("GET", "/meshes/mesh-1/dataplanes/backend-1/stats?filter=cluster%5C.backend", "")
+
+			// then
+			Expect(status).To(Equal(200))
+			Expect(string(body)).To(Equal("envoyAdminAddress: 1.1.1.1:3301\nfilter: cluster\\.backend\n"))
+		})
+
+		It("should return clusters of the dataplane", func() {
+			// when
+			status, body := do("GET", "/meshes/mesh-1/dataplanes/backend-1/clusters", "")
+
+			// then
+			Expect(status).To(Equal(200))
+			Expect(body).To(matchers.MatchGoldenJSON(path.Join("testdata", "envoy_admin_clusters.json")))
+		})
+
+		It("should change log level of the dataplane temporarily", func() {
+			// when
+			status, body := do("PUT", "/meshes/mesh-1/dataplanes/backend-1/logging", `{"logger": "http", "level": "debug", "ttl": "1m"}`)
+
+			// then
+			Expect(status).To(Equal(200))
+			resp := api_server_types.LogLevelsResponse{}
+			Expect(json.Unmarshal(body, &resp)).To(Succeed())
+			Expect(resp.Levels).To(Equal(map[string]string{
+				"admin": "info",
+				"http":  "debug",
+			}))
+			Expect(resp.RevertAt).ToNot(BeNil())
+
+			// when
+			status, body = do("GET", "/meshes/mesh-1/dataplanes/backend-1/logging", "")
+
+			// then
+			Expect(status).To(Equal(200))
+			levels := api_server_types.LogLevelsResponse{}
+			Expect(json.Unmarshal(body, &levels)).To(Succeed())
+			Expect(levels.RevertAt).To(Equal(resp.RevertAt))
+		})
+
+		It("should validate log level request", func() {
+			// when
+			status, body := do("PUT", "/meshes/mesh-1/dataplanes/backend-1/logging", `{"level": "verbose", "ttl": "48h"}`)
+
+			// then
+			Expect(status).To(Equal(400))
+			Expect(body).To(matchers.MatchGoldenJSON(path.Join("testdata", "envoy_admin_invalid_log_level.json")))
+		})
+
+		It("should return not found for unknown dataplane", func() {
+			// when
+			status, _ := do("GET", "/meshes/mesh-1/dataplanes/unknown/listeners", "")
+
+			// then
+			Expect(status).To(Equal(404))
+		})
+	})
+
+	Context("on Global CP", func() {
+		BeforeEach(func() {
+			startApiServer(config_core.Global)
+		})
+
+		It("should not allow to access envoy admin API", func() {
+			// when
+			status, body := do("PUT", "/meshes/mesh-1/dataplanes/backend-1/logging", `{"level": "debug"}`)
+
+			// then
+			Expect(status).To(Equal(405))
+			Expect(body).To(matchers.MatchGoldenJSON(path.Join("testdata", "envoy_admin_global.json")))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/graph"
+	"github.com/kumahq/kuma/pkg/slo"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+)
+
+type graphEndpoints struct {
+	builder        xds_context.MeshContextBuilder
+	resourceAccess access.ResourceAccess
+	// querier is used to enrich the graph with traffic stats, it's nil if there is no query endpoint configured
+	querier slo.Querier
+}
+
+func (g *graphEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/graph").To(g.inspectGraph).
+		Doc("Inspect the graph of services in the mesh").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil))
+}
+
+func (g *graphEndpoints) inspectGraph(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+
+	if err := g.resourceAccess.ValidateList(
+		meshName,
+		core_mesh.DataplaneResourceTypeDescriptor,
+		user.FromCtx(request.Request.Context()),
+	); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	meshContext, err := g.builder.Build(request.Request.Context(), meshName)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not build MeshContext")
+		return
+	}
+
+	result := graph.Build(meshContext)
+	if g.querier != nil {
+		if err := graph.AddStats(request.Request.Context(), g.querier, meshName, result); err != nil {
+			// the graph is still useful without stats
+			log.Error(err, "could not retrieve traffic stats", "mesh", meshName)
+		}
+	}
+
+	if err := response.WriteAsJson(result); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
@@ -0,0 +1,89 @@
+package api_server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("Graph Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		// fake Prometheus which returns traffic from web to backend for request rate and error rate queries
+		prometheus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			value := "3.5"
+			if strings.Contains(req.URL.Query().Get("query"), `envoy_response_code_class="5"`) {
+				value = "0.875"
+			}
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{"kuma_io_services":",web,","envoy_cluster_name":"backend"},"value":[1435781451.781,"` + value + `"]}]}}`))
+		}))
+		DeferCleanup(prometheus.Close)
+
+		resourceStore := memory.NewStore()
+		rm := manager.NewResourceManager(resourceStore)
+		resources := []core_model.Resource{
+			newMesh("default"),
+			newDataplane().meta("web-1", "default").inbound80to81("web", "192.168.0.1").outbound8080("backend", "240.0.0.1").build(),
+			newDataplane().meta("backend-1", "default").inbound80to81("backend", "192.168.0.2").build(),
+		}
+		for _, resource := range resources {
+			Expect(rm.Create(context.Background(), resource, store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))).To(Succeed())
+		}
+
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics, func(cfg *kuma_cp.Config) {
+			cfg.Metrics.SLO.QueryEndpoint = prometheus.URL
+		})
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes/default/graph",
+		}
+
+		stop = make(chan struct{})
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(apiServer.Start(stop)).To(Succeed())
+		}()
+
+		waitForServer(&client)
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should return the graph of services with traffic stats", func() {
+		// when
+		response, err := http.Get("http://" + apiServer.Address() + "/meshes/default/graph")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(matchers.MatchGoldenJSON(path.Join("testdata", "graph.json")))
+	})
+})
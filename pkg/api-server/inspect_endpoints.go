@@ -11,6 +11,7 @@ import (
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
+	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -72,11 +73,12 @@ func addInspectEndpoints(
 	cfg *kuma_cp.Config,
 	builder xds_context.MeshContextBuilder,
 	rm manager.ResourceManager,
+	resourceAccess resources_access.ResourceAccess,
 	configDumpAccess access.ConfigDumpAccess,
 	envoyAdminClient admin.EnvoyAdminClient,
 ) {
 	ws.Route(
-		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/policies").To(inspectDataplane(cfg, builder)).
+		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/policies").To(inspectDataplane(cfg, builder, resourceAccess)).
 			Doc("inspect dataplane matched policies").
 			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
 			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
@@ -122,7 +124,7 @@ func addInspectEndpoints(
 
 	for _, desc := range registry.Global().ObjectDescriptors(core_model.AllowedToInspect()) {
 		ws.Route(
-			ws.GET(fmt.Sprintf("/meshes/{mesh}/%s/{name}/dataplanes", desc.WsPath)).To(inspectPolicies(desc.Name, builder, cfg)).
+			ws.GET(fmt.Sprintf("/meshes/{mesh}/%s/{name}/dataplanes", desc.WsPath)).To(inspectPolicies(desc, builder, cfg, resourceAccess)).
 				Doc("inspect policies").
 				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
 				Param(ws.PathParameter("name", "resource name").DataType("string")).
@@ -131,11 +133,20 @@ func addInspectEndpoints(
 	}
 }
 
-func inspectDataplane(cfg *kuma_cp.Config, builder xds_context.MeshContextBuilder) restful.RouteFunction {
+func inspectDataplane(cfg *kuma_cp.Config, builder xds_context.MeshContextBuilder, resourceAccess resources_access.ResourceAccess) restful.RouteFunction {
 	return func(request *restful.Request, response *restful.Response) {
 		meshName := request.PathParameter("mesh")
 		dataplaneName := request.PathParameter("dataplane")
 
+		if err := resourceAccess.ValidateInspect(
+			core_model.ResourceKey{Mesh: meshName, Name: dataplaneName},
+			core_mesh.NewDataplaneResource().Descriptor(),
+			user.FromCtx(request.Request.Context()),
+		); err != nil {
+			rest_errors.HandleError(response, err, "Access Denied")
+			return
+		}
+
 		meshContext, err := builder.Build(context.Background(), meshName)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not build MeshContext")
@@ -166,14 +177,24 @@ func inspectDataplane(cfg *kuma_cp.Config, builder xds_context.MeshContextBuilde
 }
 
 func inspectPolicies(
-	resType core_model.ResourceType,
+	desc core_model.ResourceTypeDescriptor,
 	builder xds_context.MeshContextBuilder,
 	cfg *kuma_cp.Config,
+	resourceAccess resources_access.ResourceAccess,
 ) restful.RouteFunction {
 	return func(request *restful.Request, response *restful.Response) {
 		meshName := request.PathParameter("mesh")
 		policyName := request.PathParameter("name")
 
+		if err := resourceAccess.ValidateInspect(
+			core_model.ResourceKey{Mesh: meshName, Name: policyName},
+			desc,
+			user.FromCtx(request.Request.Context()),
+		); err != nil {
+			rest_errors.HandleError(response, err, "Access Denied")
+			return
+		}
+
 		meshContext, err := builder.Build(context.Background(), meshName)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not list Dataplanes")
@@ -195,7 +216,7 @@ func inspectPolicies(
 			}
 			if matchedPolicies != nil {
 				for policy, attachments := range core_xds.GroupByPolicy(matchedPolicies, dp.Spec.Networking) {
-					if policy.Type == resType && policy.Key.Name == policyName && policy.Key.Mesh == meshName {
+					if policy.Type == desc.Name && policy.Key.Name == policyName && policy.Key.Mesh == meshName {
 						attachmentList := []api_server_types.AttachmentEntry{}
 						for _, attachment := range attachments {
 							attachmentList = append(attachmentList, api_server_types.AttachmentEntry{
@@ -211,7 +232,7 @@ func inspectPolicies(
 				}
 			} else {
 				for policy, attachments := range gatewayEntriesByPolicy(proxy, gatewayEntries) {
-					if policy.Type == resType && policy.Key.Name == policyName && policy.Key.Mesh == meshName {
+					if policy.Type == desc.Name && policy.Key.Name == policyName && policy.Key.Mesh == meshName {
 						result.Items = append(result.Items, attachments...)
 					}
 				}
@@ -239,7 +260,11 @@ func inspectDataplaneXDS(
 
 		ctx := request.Request.Context()
 
-		if err := access.ValidateViewConfigDump(user.FromCtx(ctx)); err != nil {
+		if err := access.ValidateViewConfigDump(
+			core_model.ResourceKey{Mesh: meshName, Name: dataplaneName},
+			core_mesh.NewDataplaneResource().Descriptor(),
+			user.FromCtx(ctx),
+		); err != nil {
 			rest_errors.HandleError(response, err, "Could not get config_dump")
 			return
 		}
@@ -275,7 +300,11 @@ func inspectZoneIngressXDS(
 
 		ctx := request.Request.Context()
 
-		if err := access.ValidateViewConfigDump(user.FromCtx(ctx)); err != nil {
+		if err := access.ValidateViewConfigDump(
+			core_model.ResourceKey{Mesh: core_model.NoMesh, Name: zoneIngressName},
+			core_mesh.NewZoneIngressResource().Descriptor(),
+			user.FromCtx(ctx),
+		); err != nil {
 			rest_errors.HandleError(response, err, "Could not get config_dump")
 			return
 		}
@@ -315,7 +344,11 @@ func inspectZoneEgressXDS(
 		name := request.PathParameter("zoneegress")
 		ctx := request.Request.Context()
 
-		if err := access.ValidateViewConfigDump(user.FromCtx(ctx)); err != nil {
+		if err := access.ValidateViewConfigDump(
+			core_model.ResourceKey{Mesh: core_model.NoMesh, Name: name},
+			core_mesh.NewZoneEgressResource().Descriptor(),
+			user.FromCtx(ctx),
+		); err != nil {
 			rest_errors.HandleError(response, err, "Could not get config_dump")
 			return
 		}
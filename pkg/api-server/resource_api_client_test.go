@@ -13,8 +13,10 @@ import (
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -113,7 +115,7 @@ func putSampleResourceIntoStore(resourceStore store.ResourceStore, name string,
 type configModifier func(config *kuma_cp.Config)
 
 func createTestApiServer(
-	store store.ResourceStore,
+	resourceStore store.ResourceStore,
 	config *config_api_server.ApiServerConfig,
 	enableGUI bool,
 	metrics core_metrics.Metrics,
@@ -140,14 +142,20 @@ func createTestApiServer(
 		modifier(&cfg)
 	}
 
+	var revisions history.RevisionStore
+	if cfg.Store.History.Enabled {
+		revisions = history.NewConfigRevisionStore(config_manager.NewConfigManager(resourceStore), cfg.Store.History.Limit)
+	}
+
 	apiServer, err := api_server.NewApiServer(
-		manager.NewResourceManager(store),
+		manager.NewResourceManager(resourceStore),
+		config_manager.NewConfigManager(resourceStore),
 		xds_context.NewMeshContextBuilder(
-			manager.NewResourceManager(store),
+			manager.NewResourceManager(resourceStore),
 			server.MeshResourceTypes(server.HashMeshExcludedResources),
 			net.LookupIP,
 			cfg.Multizone.Zone.Name,
-			vips.NewPersistence(manager.NewResourceManager(store), config_manager.NewConfigManager(store)),
+			vips.NewPersistence(manager.NewResourceManager(resourceStore), config_manager.NewConfigManager(resourceStore)),
 			cfg.DNSServer.Domain,
 		),
 		customization.NewAPIList(),
@@ -161,9 +169,12 @@ func createTestApiServer(
 		runtime.Access{
 			ResourceAccess:       resources_access.NewAdminResourceAccess(cfg.Access.Static.AdminResources),
 			DataplaneTokenAccess: nil,
-			ConfigDumpAccess:     access.NewStaticConfigDumpAccess(cfg.Access.Static.ViewConfigDump),
+			ConfigDumpAccess:     access.NewStaticConfigDumpAccess(cfg.Access.Static.ViewConfigDump, cfg.Access.Static.ChangeLogLevel),
 		},
 		&test_runtime.DummyEnvoyAdminClient{},
+		revisions,
+		audit.NopLogger(),
+		store.NoTransactions{},
 	)
 	Expect(err).ToNot(HaveOccurred())
 	return apiServer
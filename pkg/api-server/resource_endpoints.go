@@ -9,8 +9,10 @@ import (
 
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -35,6 +37,8 @@ type resourceEndpoints struct {
 	resManager     manager.ResourceManager
 	descriptor     model.ResourceTypeDescriptor
 	resourceAccess access.ResourceAccess
+	revisions      history.RevisionStore
+	auditLogger    audit.Logger
 }
 
 func (r *resourceEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
@@ -82,6 +86,7 @@ func (r *resourceEndpoints) listResources(request *restful.Request, response *re
 	meshName := r.meshFromRequest(request)
 
 	if err := r.resourceAccess.ValidateList(
+		meshName,
 		r.descriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
@@ -152,19 +157,29 @@ func (r *resourceEndpoints) createOrUpdateResource(request *restful.Request, res
 }
 
 func (r *resourceEndpoints) createResource(ctx context.Context, name string, meshName string, spec model.ResourceSpec, response *restful.Response) {
+	event := audit.Event{
+		Operation:    audit.Create,
+		ResourceType: r.descriptor.Name,
+		Key:          model.ResourceKey{Mesh: meshName, Name: name},
+		After:        spec,
+	}
 	if err := r.resourceAccess.ValidateCreate(
-		model.ResourceKey{Mesh: meshName, Name: name},
+		event.Key,
 		spec,
 		r.descriptor,
 		user.FromCtx(ctx),
 	); err != nil {
+		r.auditLogger.Log(ctx, audit.AccessDenied(event, err))
 		rest_errors.HandleError(response, err, "Access Denied")
 		return
 	}
 
 	res := r.descriptor.NewObject()
 	_ = res.SetSpec(spec)
-	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName)); err != nil {
+	err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName))
+	event.Err = err
+	r.auditLogger.Log(ctx, event)
+	if err != nil {
 		rest_errors.HandleError(response, err, "Could not create a resource")
 	} else {
 		response.WriteHeader(201)
@@ -172,19 +187,30 @@ func (r *resourceEndpoints) createResource(ctx context.Context, name string, mes
 }
 
 func (r *resourceEndpoints) updateResource(ctx context.Context, res model.Resource, restRes rest.Resource, response *restful.Response) {
+	event := audit.Event{
+		Operation:    audit.Update,
+		ResourceType: r.descriptor.Name,
+		Key:          model.MetaToResourceKey(res.GetMeta()),
+		Before:       res.GetSpec(),
+		After:        restRes.Spec,
+	}
 	_ = res.SetSpec(restRes.Spec)
 
 	if err := r.resourceAccess.ValidateUpdate(
-		model.ResourceKey{Mesh: res.GetMeta().GetMesh(), Name: res.GetMeta().GetName()},
+		event.Key,
 		res.GetSpec(),
 		r.descriptor,
 		user.FromCtx(ctx),
 	); err != nil {
+		r.auditLogger.Log(ctx, audit.AccessDenied(event, err))
 		rest_errors.HandleError(response, err, "Access Denied")
 		return
 	}
 
-	if err := r.resManager.Update(ctx, res); err != nil {
+	err := r.resManager.Update(ctx, res)
+	event.Err = err
+	r.auditLogger.Log(ctx, event)
+	if err != nil {
 		rest_errors.HandleError(response, err, "Could not update a resource")
 	} else {
 		response.WriteHeader(200)
@@ -221,17 +247,27 @@ func (r *resourceEndpoints) deleteResource(request *restful.Request, response *r
 		return
 	}
 
+	event := audit.Event{
+		Operation:    audit.Delete,
+		ResourceType: r.descriptor.Name,
+		Key:          model.ResourceKey{Mesh: meshName, Name: name},
+		Before:       resource.GetSpec(),
+	}
 	if err := r.resourceAccess.ValidateDelete(
-		model.ResourceKey{Mesh: meshName, Name: name},
+		event.Key,
 		resource.GetSpec(),
 		resource.Descriptor(),
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
+		r.auditLogger.Log(request.Request.Context(), audit.AccessDenied(event, err))
 		rest_errors.HandleError(response, err, "Access Denied")
 		return
 	}
 
-	if err := r.resManager.Delete(request.Request.Context(), resource, store.DeleteByKey(name, meshName)); err != nil {
+	err := r.resManager.Delete(request.Request.Context(), resource, store.DeleteByKey(name, meshName))
+	event.Err = err
+	r.auditLogger.Log(request.Request.Context(), event)
+	if err != nil {
 		rest_errors.HandleError(response, err, "Could not delete a resource")
 	}
 }
@@ -0,0 +1,43 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+func (r *resourceEndpoints) addRevisionsEndpoint(ws *restful.WebService, pathPrefix string) {
+	ws.Route(ws.GET(pathPrefix+"/{name}/_revisions").To(r.listRevisions).
+		Doc(fmt.Sprintf("List revisions of a %s", r.descriptor.Name)).
+		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
+		Returns(200, "OK", nil))
+}
+
+func (r *resourceEndpoints) listRevisions(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := r.meshFromRequest(request)
+	key := model.ResourceKey{Mesh: meshName, Name: name}
+
+	if err := r.resourceAccess.ValidateGet(
+		key,
+		r.descriptor,
+		user.FromCtx(request.Request.Context()),
+	); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	revisions, err := r.revisions.List(request.Request.Context(), r.descriptor.Name, key)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve revisions")
+		return
+	}
+	if err := response.WriteAsJson(revisions); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
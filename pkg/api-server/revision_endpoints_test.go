@@ -0,0 +1,108 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Revision Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var client resourceApiClient
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics, func(cfg *kuma_cp.Config) {
+			cfg.Store.History.Enabled = true
+		})
+		client = resourceApiClient{
+			apiServer.Address(),
+			"/meshes/default/traffic-permissions",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		err := resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should list revisions of a resource", func() {
+		// given
+		revisions := history.NewConfigRevisionStore(config_manager.NewConfigManager(resourceStore), 10)
+		key := model.ResourceKey{Mesh: model.DefaultMesh, Name: "tp-1"}
+		err := revisions.Append(context.Background(), core_mesh.TrafficPermissionType, key, history.Revision{
+			Operation: history.Create,
+			User:      "john.doe@example.com",
+			Groups:    []string{"mesh-system:authenticated"},
+			Time:      time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			Spec:      json.RawMessage(`{"sources":[{"match":{"kuma.io/service":"web"}}]}`),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		response := client.get("tp-1/_revisions")
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		bytes, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes).To(MatchJSON(`
+		{
+			"type": "TrafficPermission",
+			"mesh": "default",
+			"name": "tp-1",
+			"items": [
+				{
+					"revision": 1,
+					"operation": "CREATE",
+					"user": "john.doe@example.com",
+					"groups": ["mesh-system:authenticated"],
+					"time": "2022-01-01T00:00:00Z",
+					"spec": {"sources":[{"match":{"kuma.io/service":"web"}}]}
+				}
+			]
+		}`))
+	})
+
+	It("should return empty list when there are no revisions", func() {
+		// when
+		response := client.get("not-existing/_revisions")
+
+		// then
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		bytes, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes).To(MatchJSON(`{"type": "TrafficPermission", "mesh": "default", "name": "not-existing", "items": []}`))
+	})
+})
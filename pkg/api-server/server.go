@@ -26,16 +26,22 @@ import (
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/dns/vips"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
+	admin_access "github.com/kumahq/kuma/pkg/envoy/admin/access"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
+	"github.com/kumahq/kuma/pkg/slo"
 	"github.com/kumahq/kuma/pkg/tokens/builtin"
 	tokens_server "github.com/kumahq/kuma/pkg/tokens/builtin/server"
 	util_prometheus "github.com/kumahq/kuma/pkg/util/prometheus"
@@ -80,6 +86,7 @@ func init() {
 
 func NewApiServer(
 	resManager manager.ResourceManager,
+	configManager config_manager.ConfigManager,
 	meshContextBuilder xds_context.MeshContextBuilder,
 	wsManager customization.APIInstaller,
 	defs []model.ResourceTypeDescriptor,
@@ -90,6 +97,9 @@ func NewApiServer(
 	authenticator authn.Authenticator,
 	access runtime.Access,
 	envoyAdminClient admin.EnvoyAdminClient,
+	revisions history.RevisionStore,
+	auditLogger audit.Logger,
+	transactions store.Transactions,
 ) (*ApiServer, error) {
 	serverConfig := cfg.ApiServer
 	container := restful.NewContainer()
@@ -121,8 +131,12 @@ func NewApiServer(
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	addResourcesEndpoints(ws, defs, resManager, cfg, access.ResourceAccess)
-	addInspectEndpoints(ws, cfg, meshContextBuilder, resManager, access.ConfigDumpAccess, envoyAdminClient)
+	addResourcesEndpoints(ws, defs, resManager, cfg, access.ResourceAccess, revisions, auditLogger, transactions)
+	addInspectEndpoints(ws, cfg, meshContextBuilder, resManager, access.ResourceAccess, access.ConfigDumpAccess, envoyAdminClient)
+	addEnvoyAdminEndpoints(ws, cfg, resManager, configManager, access.ConfigDumpAccess, envoyAdminClient, auditLogger)
+	if err := addGraphEndpoints(ws, cfg, meshContextBuilder, access.ResourceAccess); err != nil {
+		return nil, errors.Wrap(err, "could not create graph endpoints")
+	}
 	container.Add(ws)
 
 	if err := addIndexWsEndpoints(ws, getInstanceId, getClusterId, enableGUI); err != nil {
@@ -135,7 +149,7 @@ func NewApiServer(
 	container.Add(configWs)
 	container.Add(versionsWs())
 	container.Add(zonesWs(resManager))
-	container.Add(tokenWs(resManager, access))
+	container.Add(tokenWs(resManager, access, auditLogger))
 
 	container.Filter(cors.Filter)
 
@@ -156,7 +170,7 @@ func NewApiServer(
 	return newApiServer, nil
 }
 
-func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDescriptor, resManager manager.ResourceManager, cfg *kuma_cp.Config, resourceAccess resources_access.ResourceAccess) {
+func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDescriptor, resManager manager.ResourceManager, cfg *kuma_cp.Config, resourceAccess resources_access.ResourceAccess, revisions history.RevisionStore, auditLogger audit.Logger, transactions store.Transactions) {
 	dpOverviewEndpoints := dataplaneOverviewEndpoints{
 		resManager:     resManager,
 		resourceAccess: resourceAccess,
@@ -192,16 +206,34 @@ func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDesc
 	}
 	globalInsightsEndpoints.addEndpoint(ws)
 
+	sloRulesEndpoints := sloRulesEndpoints{
+		resManager:     resManager,
+		resourceAccess: resourceAccess,
+	}
+	sloRulesEndpoints.addEndpoint(ws)
+
+	batchEndpoints := batchEndpoints{
+		resManager:     resManager,
+		descriptors:    map[model.ResourceType]model.ResourceTypeDescriptor{},
+		resourceAccess: resourceAccess,
+		transactions:   transactions,
+		auditLogger:    auditLogger,
+	}
+	batchEndpoints.addEndpoint(ws)
+
 	for _, definition := range defs {
 		defType := definition.Name
 		if cfg.ApiServer.ReadOnly || (defType == mesh.DataplaneType && cfg.Mode == config_core.Global) || (defType != mesh.DataplaneType && cfg.Mode == config_core.Zone) {
 			definition.ReadOnly = true
 		}
+		batchEndpoints.descriptors[defType] = definition
 		endpoints := resourceEndpoints{
 			mode:           cfg.Mode,
 			resManager:     resManager,
 			descriptor:     definition,
 			resourceAccess: resourceAccess,
+			revisions:      revisions,
+			auditLogger:    auditLogger,
 		}
 		switch defType {
 		case mesh.ServiceInsightType:
@@ -220,23 +252,63 @@ func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDesc
 				endpoints.addFindEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
 				endpoints.addListEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
 				endpoints.addListEndpoint(ws, "/"+definition.WsPath) // listing all resources in all meshes
+				if revisions != nil && history.TrackedTypes().Apply(definition) {
+					endpoints.addRevisionsEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
+				}
 			case model.ScopeGlobal:
 				endpoints.addCreateOrUpdateEndpoint(ws, "/"+definition.WsPath)
 				endpoints.addDeleteEndpoint(ws, "/"+definition.WsPath)
 				endpoints.addFindEndpoint(ws, "/"+definition.WsPath)
 				endpoints.addListEndpoint(ws, "/"+definition.WsPath)
+				if revisions != nil && history.TrackedTypes().Apply(definition) {
+					endpoints.addRevisionsEndpoint(ws, "/"+definition.WsPath)
+				}
 			}
 		}
 	}
 }
 
-func tokenWs(resManager manager.ResourceManager, access runtime.Access) *restful.WebService {
+func addEnvoyAdminEndpoints(ws *restful.WebService, cfg *kuma_cp.Config, resManager manager.ResourceManager, configManager config_manager.ConfigManager, configDumpAccess admin_access.ConfigDumpAccess, envoyAdminClient admin.EnvoyAdminClient, auditLogger audit.Logger) {
+	endpoints := envoyAdminEndpoints{
+		rm:               resManager,
+		access:           configDumpAccess,
+		envoyAdminClient: envoyAdminClient,
+		logLevels:        admin.NewTemporaryLogLevels(envoyAdminClient, configManager),
+		auditLogger:      auditLogger,
+		localZone:        cfg.Multizone.Zone.Name,
+		defaultAdminPort: cfg.GetEnvoyAdminPort(),
+	}
+	if cfg.Mode == config_core.Global {
+		endpoints.addMethodNotAllowedEndpoints(ws)
+	} else {
+		endpoints.addEndpoints(ws)
+	}
+}
+
+func addGraphEndpoints(ws *restful.WebService, cfg *kuma_cp.Config, builder xds_context.MeshContextBuilder, resourceAccess resources_access.ResourceAccess) error {
+	endpoints := graphEndpoints{
+		builder:        builder,
+		resourceAccess: resourceAccess,
+	}
+	if sloCfg := cfg.Metrics.SLO; sloCfg.QueryEndpoint != "" {
+		querier, err := slo.NewPrometheusQuerier(sloCfg.QueryEndpoint, sloCfg.QueryTimeout)
+		if err != nil {
+			return err
+		}
+		endpoints.querier = querier
+	}
+	endpoints.addEndpoint(ws)
+	return nil
+}
+
+func tokenWs(resManager manager.ResourceManager, access runtime.Access, auditLogger audit.Logger) *restful.WebService {
 	return tokens_server.NewWebservice(
 		builtin.NewDataplaneTokenIssuer(resManager),
 		builtin.NewZoneIngressTokenIssuer(resManager),
 		builtin.NewZoneTokenIssuer(resManager),
 		access.DataplaneTokenAccess,
 		access.ZoneTokenAccess,
+		auditLogger,
 	)
 }
 
@@ -368,8 +440,13 @@ func (a *ApiServer) notAvailableHandler(writer http.ResponseWriter, request *htt
 
 func SetupServer(rt runtime.Runtime) error {
 	cfg := rt.Config()
+	var revisions history.RevisionStore
+	if cfg.Store.History.Enabled {
+		revisions = history.NewConfigRevisionStore(rt.ConfigManager(), cfg.Store.History.Limit)
+	}
 	apiServer, err := NewApiServer(
 		rt.ResourceManager(),
+		rt.ConfigManager(),
 		xds_context.NewMeshContextBuilder(
 			rt.ResourceManager(),
 			server.MeshResourceTypes(server.HashMeshExcludedResources),
@@ -388,9 +465,25 @@ func SetupServer(rt runtime.Runtime) error {
 		rt.APIServerAuthenticator(),
 		rt.Access(),
 		rt.EnvoyAdminClient(),
+		revisions,
+		rt.AuditLogger(),
+		rt.Transactions(),
 	)
 	if err != nil {
 		return err
 	}
+	if cfg.Mode != config_core.Global {
+		logLevelsReverter := admin.NewLogLevelsReverter(
+			rt.EnvoyAdminClient(),
+			rt.ConfigManager(),
+			rt.ReadOnlyResourceManager(),
+			rt.AuditLogger(),
+			cfg.GetEnvoyAdminPort(),
+			logLevelsRevertInterval,
+		)
+		if err := rt.Add(logLevelsReverter); err != nil {
+			return err
+		}
+	}
 	return rt.Add(apiServer)
 }
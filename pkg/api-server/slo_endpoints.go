@@ -0,0 +1,49 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/slo"
+)
+
+type sloRulesEndpoints struct {
+	resManager     manager.ResourceManager
+	resourceAccess access.ResourceAccess
+}
+
+func (s *sloRulesEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/slo-rules").To(s.generateRules).
+		Doc("Generate Prometheus recording and alerting rules of SLOs in the mesh").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil))
+}
+
+func (s *sloRulesEndpoints) generateRules(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+
+	if err := s.resourceAccess.ValidateList(
+		meshName,
+		core_mesh.SLOResourceTypeDescriptor,
+		user.FromCtx(request.Request.Context()),
+	); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	slos := &core_mesh.SLOResourceList{}
+	if err := s.resManager.List(request.Request.Context(), slos, store.ListByMesh(meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve SLOs")
+		return
+	}
+
+	if err := response.WriteAsJson(slo.GenerateRules(slos.Items)); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
@@ -0,0 +1,101 @@
+package api_server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("SLO rules Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes/default/slo-rules",
+		}
+
+		stop = make(chan struct{})
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(apiServer.Start(stop)).To(Succeed())
+		}()
+
+		waitForServer(&client)
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		Expect(resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("other", model.NoMesh))).To(Succeed())
+
+		backend := core_mesh.NewSLOResource()
+		backend.Spec = &mesh_proto.SLO{
+			Service: "backend",
+			Conf: &mesh_proto.SLO_Conf{
+				Target: 99.9,
+				Window: durationpb.New(30 * 24 * time.Hour),
+				Objective: &mesh_proto.SLO_Conf_Availability_{
+					Availability: &mesh_proto.SLO_Conf_Availability{},
+				},
+			},
+		}
+		Expect(resourceStore.Create(context.Background(), backend, store.CreateByKey("backend-availability", "default"))).To(Succeed())
+
+		web := core_mesh.NewSLOResource()
+		web.Spec = &mesh_proto.SLO{
+			Service: "web",
+			Conf: &mesh_proto.SLO_Conf{
+				Target: 99,
+				Window: durationpb.New(7 * 24 * time.Hour),
+				Objective: &mesh_proto.SLO_Conf_Latency_{
+					Latency: &mesh_proto.SLO_Conf_Latency{
+						Threshold: durationpb.New(500 * time.Millisecond),
+					},
+				},
+			},
+		}
+		Expect(resourceStore.Create(context.Background(), web, store.CreateByKey("web-latency", "other"))).To(Succeed())
+	})
+
+	It("should generate rules of SLOs in the mesh", func() {
+		// when
+		response, err := http.Get("http://" + apiServer.Address() + "/meshes/default/slo-rules")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(matchers.MatchGoldenJSON(path.Join("testdata", "slo_rules.json")))
+	})
+})
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"time"
+)
+
+type LogLevelRequest struct {
+	// Logger is a name of the Envoy logger, e.g. "http". Empty logger changes all loggers.
+	Logger string `json:"logger,omitempty"`
+	Level  string `json:"level"`
+	// TTL is a duration after which log levels are reverted, e.g. "10m".
+	TTL string `json:"ttl,omitempty"`
+}
+
+type LogLevelsResponse struct {
+	Levels map[string]string `json:"levels"`
+	// RevertAt is a time when changed log levels are reverted.
+	RevertAt *time.Time `json:"revertAt,omitempty"`
+}
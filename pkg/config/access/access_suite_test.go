@@ -0,0 +1,11 @@
+package access_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestAccess(t *testing.T) {
+	test.RunSpecs(t, "Access Config Suite")
+}
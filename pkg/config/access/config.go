@@ -6,7 +6,10 @@ import (
 	"github.com/kumahq/kuma/pkg/config"
 )
 
-const StaticType = "static"
+const (
+	StaticType = "static"
+	RBACType   = "rbac"
+)
 
 func DefaultAccessConfig() AccessConfig {
 	return AccessConfig{
@@ -32,26 +35,42 @@ func DefaultAccessConfig() AccessConfig {
 				Users:  []string{},
 				Groups: []string{"mesh-system:unauthenticated", "mesh-system:authenticated"},
 			},
+			ChangeLogLevel: ChangeLogLevelStaticAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
+		},
+		RBAC: RBACAccessConfig{
+			Admin: AdminRBACAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
 		},
 	}
 }
 
 // AccessConfig defines a configuration for acccess control
 type AccessConfig struct {
-	// Type of the access strategy (available values: "static")
+	// Type of the access strategy (available values: "static", "rbac")
 	Type string `yaml:"type" envconfig:"KUMA_ACCESS_TYPE"`
 	// Configuration of static access strategy
 	Static StaticAccessConfig `yaml:"static"`
+	// Configuration of role-based access strategy
+	RBAC RBACAccessConfig `yaml:"rbac"`
 }
 
 func (r AccessConfig) Sanitize() {
 }
 
 func (r AccessConfig) Validate() error {
-	if r.Type == "" {
+	switch r.Type {
+	case "":
 		return errors.New("Type has to be defined")
+	case StaticType, RBACType:
+		return nil
+	default:
+		return errors.Errorf("Type has to be one of %q, %q, got %q", StaticType, RBACType, r.Type)
 	}
-	return nil
 }
 
 var _ config.Config = &AccessConfig{}
@@ -68,6 +87,8 @@ type StaticAccessConfig struct {
 	GenerateZoneToken GenerateZoneTokenStaticAccessConfig `yaml:"generateZoneToken"`
 	// ViewConfigDump defines an access to getting envoy config dump
 	ViewConfigDump ViewConfigDumpStaticAccessConfig `yaml:"viewConfigDump"`
+	// ChangeLogLevel defines an access to changing envoy log levels
+	ChangeLogLevel ChangeLogLevelStaticAccessConfig `yaml:"changeLogLevel"`
 }
 
 type AdminResourcesStaticAccessConfig struct {
@@ -104,3 +125,24 @@ type ViewConfigDumpStaticAccessConfig struct {
 	// List of groups that are allowed to get envoy config dump
 	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_GET_CONFIG_DUMP_GROUPS"`
 }
+
+type ChangeLogLevelStaticAccessConfig struct {
+	// List of users that are allowed to change envoy log levels
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_STATIC_CHANGE_LOG_LEVEL_USERS"`
+	// List of groups that are allowed to change envoy log levels
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_CHANGE_LOG_LEVEL_GROUPS"`
+}
+
+// RBACAccessConfig a role-based access strategy configuration.
+// Permissions are granted by AccessRole and AccessRoleBinding resources.
+type RBACAccessConfig struct {
+	// Admin defines users and groups that are granted every permission regardless of roles
+	Admin AdminRBACAccessConfig `yaml:"admin"`
+}
+
+type AdminRBACAccessConfig struct {
+	// List of users that are granted every permission
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_RBAC_ADMIN_USERS"`
+	// List of groups that are granted every permission
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_RBAC_ADMIN_GROUPS"`
+}
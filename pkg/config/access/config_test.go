@@ -0,0 +1,39 @@
+package access_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/config/access"
+)
+
+var _ = Describe("AccessConfig", func() {
+	DescribeTable("should accept known types",
+		func(typ string) {
+			// given
+			cfg := access.DefaultAccessConfig()
+			cfg.Type = typ
+
+			// expect
+			Expect(cfg.Validate()).To(Succeed())
+		},
+		Entry("static", access.StaticType),
+		Entry("rbac", access.RBACType),
+	)
+
+	DescribeTable("should reject invalid types",
+		func(typ string, expectedErr string) {
+			// given
+			cfg := access.DefaultAccessConfig()
+			cfg.Type = typ
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("empty", "", "Type has to be defined"),
+		Entry("unknown", "custom-rbac", `Type has to be one of "static", "rbac", got "custom-rbac"`),
+	)
+})
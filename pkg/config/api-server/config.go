@@ -22,6 +22,8 @@ type ApiServerConfig struct {
 	Auth ApiServerAuth `yaml:"auth"`
 	// Authentication configuration for API Server
 	Authn ApiServerAuthn `yaml:"authn"`
+	// Audit log configuration of the API Server
+	Audit ApiServerAuditConfig `yaml:"audit"`
 }
 
 // API Server HTTP configuration
@@ -79,12 +81,23 @@ type ApiServerAuth struct {
 
 // Api Server Authentication configuration
 type ApiServerAuthn struct {
-	// Type of authentication mechanism (available values: "clientCerts")
+	// Type of authentication mechanism (available values: "adminClientCerts", "tokens", "oidc")
 	Type string `yaml:"type" envconfig:"kuma_api_server_authn_type"`
 	// Localhost is authenticated as a user admin of group admin
 	LocalhostIsAdmin bool `yaml:"localhostIsAdmin" envconfig:"kuma_api_server_authn_localhost_is_admin"`
 	// Configuration for tokens authentication
 	Tokens ApiServerAuthnTokens `yaml:"tokens"`
+	// Configuration for OIDC authentication
+	OIDC ApiServerAuthnOIDC `yaml:"oidc"`
+}
+
+func (a *ApiServerAuthn) Validate() error {
+	if a.Type == "oidc" {
+		if err := a.OIDC.Validate(); err != nil {
+			return errors.Wrap(err, ".OIDC not valid")
+		}
+	}
+	return nil
 }
 
 type ApiServerAuthnTokens struct {
@@ -92,6 +105,66 @@ type ApiServerAuthnTokens struct {
 	BootstrapAdminToken bool `yaml:"bootstrapAdminToken" envconfig:"kuma_api_server_authn_tokens_bootstrap_admin_token"`
 }
 
+// ApiServerAuthnOIDC configures validation of ID tokens issued by an OpenID Connect provider.
+type ApiServerAuthnOIDC struct {
+	// Issuer URL of the OIDC provider. It has to match "iss" claim of the token
+	Issuer string `yaml:"issuer" envconfig:"kuma_api_server_authn_oidc_issuer"`
+	// Audience of the token, usually the client ID of kumactl in the OIDC provider. It has to match "aud" claim of the token
+	Audience string `yaml:"audience" envconfig:"kuma_api_server_authn_oidc_audience"`
+	// URL of JSON Web Key Set of the OIDC provider. If both JwksURL and JwksFile are empty, the URL is discovered from the Issuer
+	JwksURL string `yaml:"jwksURL" envconfig:"kuma_api_server_authn_oidc_jwks_url"`
+	// Path to a file with JSON Web Key Set of the OIDC provider
+	JwksFile string `yaml:"jwksFile" envconfig:"kuma_api_server_authn_oidc_jwks_file"`
+	// Claim of the token that is used as a name of the user
+	UsernameClaim string `yaml:"usernameClaim" envconfig:"kuma_api_server_authn_oidc_username_claim"`
+	// Prefix added to the name of the user, so users of the OIDC provider don't clash with Kuma users like "mesh-system:admin".
+	// Names with the "mesh-system:" prefix are rejected regardless of this setting
+	UsernamePrefix string `yaml:"usernamePrefix" envconfig:"kuma_api_server_authn_oidc_username_prefix"`
+	// Claim of the token that contains groups of the user
+	GroupsClaim string `yaml:"groupsClaim" envconfig:"kuma_api_server_authn_oidc_groups_claim"`
+	// Prefix added to every group from GroupsClaim, so groups of the OIDC provider don't clash with Kuma groups like "mesh-system:admin".
+	// Set it to empty only if the OIDC provider can't issue groups with the "mesh-system:" prefix
+	GroupsPrefix string `yaml:"groupsPrefix" envconfig:"kuma_api_server_authn_oidc_groups_prefix"`
+}
+
+func (a *ApiServerAuthnOIDC) Validate() error {
+	if a.Issuer == "" {
+		return errors.New("Issuer cannot be empty")
+	}
+	if a.Audience == "" {
+		return errors.New("Audience cannot be empty")
+	}
+	if a.JwksURL != "" && a.JwksFile != "" {
+		return errors.New("JwksURL and JwksFile cannot be both specified")
+	}
+	if a.UsernameClaim == "" {
+		return errors.New("UsernameClaim cannot be empty")
+	}
+	return nil
+}
+
+// API Server audit log configuration
+type ApiServerAuditConfig struct {
+	// Level of the audit log (available values: "none", "metadata", "spec").
+	// "metadata" records user, resource, operation and its outcome of every mutating request and token issuance,
+	// "spec" additionally records a diff of the resource spec.
+	Level string `yaml:"level" envconfig:"kuma_api_server_audit_level"`
+	// Destination of the audit log. Either "stdout" or a path to a file to which JSON lines are appended
+	Output string `yaml:"output" envconfig:"kuma_api_server_audit_output"`
+}
+
+func (a *ApiServerAuditConfig) Validate() error {
+	switch a.Level {
+	case "none", "metadata", "spec":
+	default:
+		return errors.Errorf("Level has to be one of: none, metadata, spec")
+	}
+	if a.Level != "none" && a.Output == "" {
+		return errors.New("Output cannot be empty")
+	}
+	return nil
+}
+
 func (a *ApiServerConfig) Sanitize() {
 }
 
@@ -102,6 +175,12 @@ func (a *ApiServerConfig) Validate() error {
 	if err := a.HTTPS.Validate(); err != nil {
 		return errors.Wrap(err, ".HTTP not valid")
 	}
+	if err := a.Authn.Validate(); err != nil {
+		return errors.Wrap(err, ".Authn not valid")
+	}
+	if err := a.Audit.Validate(); err != nil {
+		return errors.Wrap(err, ".Audit not valid")
+	}
 	return nil
 }
 
@@ -130,6 +209,16 @@ func DefaultApiServerConfig() *ApiServerConfig {
 			Tokens: ApiServerAuthnTokens{
 				BootstrapAdminToken: true,
 			},
+			OIDC: ApiServerAuthnOIDC{
+				UsernameClaim:  "sub",
+				UsernamePrefix: "oidc:",
+				GroupsClaim:    "groups",
+				GroupsPrefix:   "oidc:",
+			},
+		},
+		Audit: ApiServerAuditConfig{
+			Level:  "none",
+			Output: "stdout",
 		},
 	}
 }
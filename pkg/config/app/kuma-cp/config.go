@@ -1,6 +1,7 @@
 package kuma_cp
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,6 +20,7 @@ import (
 	"github.com/kumahq/kuma/pkg/config/plugins/runtime"
 	"github.com/kumahq/kuma/pkg/config/xds"
 	"github.com/kumahq/kuma/pkg/config/xds/bootstrap"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 )
 
 var _ config.Config = &Config{}
@@ -27,12 +29,17 @@ var _ config.Config = &Defaults{}
 
 type Defaults struct {
 	SkipMeshCreation bool `yaml:"skipMeshCreation" envconfig:"kuma_defaults_skip_mesh_creation"`
+	// SigningKeyAlgorithm is the algorithm of default token signing keys created by the Control Plane
+	SigningKeyAlgorithm string `yaml:"signingKeyAlgorithm" envconfig:"kuma_defaults_signing_key_algorithm"`
 }
 
 func (d *Defaults) Sanitize() {
 }
 
 func (d *Defaults) Validate() error {
+	if _, err := util_tls.NewKeyType(d.SigningKeyAlgorithm, 0); err != nil {
+		return errors.Wrap(err, ".SigningKeyAlgorithm is not valid")
+	}
 	return nil
 }
 
@@ -40,6 +47,7 @@ type Metrics struct {
 	Dataplane *DataplaneMetrics `yaml:"dataplane"`
 	Zone      *ZoneMetrics      `yaml:"zone"`
 	Mesh      *MeshMetrics      `yaml:"mesh"`
+	SLO       *SLOMetrics       `yaml:"slo"`
 }
 
 func (m *Metrics) Sanitize() {
@@ -49,6 +57,9 @@ func (m *Metrics) Validate() error {
 	if err := m.Dataplane.Validate(); err != nil {
 		return errors.Wrap(err, "Dataplane validation failed")
 	}
+	if err := m.SLO.Validate(); err != nil {
+		return errors.Wrap(err, "SLO validation failed")
+	}
 	return nil
 }
 
@@ -99,6 +110,39 @@ func (d *MeshMetrics) Validate() error {
 	return nil
 }
 
+type SLOMetrics struct {
+	// QueryEndpoint is an address of Prometheus compatible query API used to compute error budget of SLOs
+	// and traffic stats of the service graph, e.g. http://prometheus-server.kuma-metrics:80.
+	// If empty, neither of them is computed.
+	QueryEndpoint string `yaml:"queryEndpoint" envconfig:"kuma_metrics_slo_query_endpoint"`
+	// QueryTimeout is a timeout of a single query
+	QueryTimeout time.Duration `yaml:"queryTimeout" envconfig:"kuma_metrics_slo_query_timeout"`
+	// RefreshInterval is a minimal time between computing error budget of SLOs in a Mesh
+	RefreshInterval time.Duration `yaml:"refreshInterval" envconfig:"kuma_metrics_slo_refresh_interval"`
+}
+
+func (s *SLOMetrics) Sanitize() {
+}
+
+func (s *SLOMetrics) Validate() error {
+	if s.QueryEndpoint != "" {
+		u, err := url.Parse(s.QueryEndpoint)
+		if err != nil {
+			return errors.Wrap(err, "QueryEndpoint is not a valid URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.New("QueryEndpoint has to be an http or https URL")
+		}
+	}
+	if s.QueryTimeout <= 0 {
+		return errors.New("QueryTimeout should be greater than 0")
+	}
+	if s.RefreshInterval <= 0 {
+		return errors.New("RefreshInterval should be greater than 0")
+	}
+	return nil
+}
+
 type Reports struct {
 	// If true then usage stats will be reported
 	Enabled bool `yaml:"enabled" envconfig:"kuma_reports_enabled"`
@@ -172,7 +216,8 @@ var DefaultConfig = func() Config {
 		BootstrapServer:            bootstrap.DefaultBootstrapServerConfig(),
 		Runtime:                    runtime.DefaultRuntimeConfig(),
 		Defaults: &Defaults{
-			SkipMeshCreation: false,
+			SkipMeshCreation:    false,
+			SigningKeyAlgorithm: util_tls.RSAKeyAlgorithm,
 		},
 		Metrics: &Metrics{
 			Dataplane: &DataplaneMetrics{
@@ -187,6 +232,10 @@ var DefaultConfig = func() Config {
 				MinResyncTimeout: 1 * time.Second,
 				MaxResyncTimeout: 20 * time.Second,
 			},
+			SLO: &SLOMetrics{
+				QueryTimeout:    5 * time.Second,
+				RefreshInterval: 1 * time.Minute,
+			},
 		},
 		Reports: &Reports{
 			Enabled: false,
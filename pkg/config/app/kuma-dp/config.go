@@ -139,6 +139,29 @@ type DataplaneRuntime struct {
 	ResourcePath string `yaml:"resourcePath,omitempty" envconfig:"kuma_dataplane_runtime_resource_path"`
 	// ResourceVars are the StringToString values that can fill the Resource template
 	ResourceVars map[string]string `yaml:"resourceVars,omitempty"`
+	// Attestation defines how kuma-dp exchanges a proof of its identity for a short-lived dataplane token.
+	// The token is renewed in the background, so it does not have to be provided via TokenPath or Token.
+	Attestation Attestation `yaml:"attestation,omitempty"`
+	// WorkloadAPI defines the SPIFFE Workload API server that serves the identity of the dataplane to the application.
+	WorkloadAPI WorkloadAPI `yaml:"workloadAPI,omitempty"`
+}
+
+type Attestation struct {
+	// Provider is a name of the attestation provider enabled in the control plane, e.g. "staticKey".
+	// Empty value disables attestation.
+	Provider string `yaml:"provider,omitempty" envconfig:"kuma_dataplane_runtime_attestation_provider"`
+	// KeyFile is a path to a PEM-encoded private key that signs the evidence of the "staticKey" provider.
+	KeyFile string `yaml:"keyFile,omitempty" envconfig:"kuma_dataplane_runtime_attestation_key_file"`
+}
+
+type WorkloadAPI struct {
+	// SocketPath is a path to the Unix socket on which SPIFFE Workload API is served.
+	// Empty value disables the Workload API.
+	SocketPath string `yaml:"socketPath,omitempty" envconfig:"kuma_dataplane_runtime_workload_api_socket_path"`
+	// SocketGroup is a name or an ID of the group whose users can access the socket in addition to the user of kuma-dp and root.
+	// The group has to be the primary group of the process connecting to the socket, supplementary groups are not checked.
+	// Empty value restricts the socket to the user of kuma-dp and root.
+	SocketGroup string `yaml:"socketGroup,omitempty" envconfig:"kuma_dataplane_runtime_workload_api_socket_group"`
 }
 
 var _ config.Config = &Config{}
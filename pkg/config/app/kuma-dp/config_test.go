@@ -64,6 +64,10 @@ var _ = Describe("Config", func() {
 				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":                     "envoy.sh",
 				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":                      "/var/run/envoy",
 				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":                      "/tmp/token",
+				"KUMA_DATAPLANE_RUNTIME_ATTESTATION_PROVIDER":            "staticKey",
+				"KUMA_DATAPLANE_RUNTIME_ATTESTATION_KEY_FILE":            "/tmp/attestation.key",
+				"KUMA_DATAPLANE_RUNTIME_WORKLOAD_API_SOCKET_PATH":        "/tmp/workload-api.sock",
+				"KUMA_DATAPLANE_RUNTIME_WORKLOAD_API_SOCKET_GROUP":       "app",
 				"KUMA_DNS_ENABLED":                                       "true",
 				"KUMA_DNS_CORE_DNS_PORT":                                 "5300",
 				"KUMA_DNS_CORE_DNS_EMPTY_PORT":                           "5301",
@@ -97,6 +101,10 @@ var _ = Describe("Config", func() {
 			Expect(cfg.DataplaneRuntime.BinaryPath).To(Equal("envoy.sh"))
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
+			Expect(cfg.DataplaneRuntime.Attestation.Provider).To(Equal("staticKey"))
+			Expect(cfg.DataplaneRuntime.Attestation.KeyFile).To(Equal("/tmp/attestation.key"))
+			Expect(cfg.DataplaneRuntime.WorkloadAPI.SocketPath).To(Equal("/tmp/workload-api.sock"))
+			Expect(cfg.DataplaneRuntime.WorkloadAPI.SocketGroup).To(Equal("app"))
 			Expect(cfg.DNS.Enabled).To(BeTrue())
 			Expect(cfg.DNS.CoreDNSPort).To(Equal(uint32(5300)))
 			Expect(cfg.DNS.CoreDNSEmptyPort).To(Equal(uint32(5301)))
@@ -32,6 +32,10 @@ type StoreConfig struct {
 	Cache CacheStoreConfig `yaml:"cache"`
 	// Upsert configuration
 	Upsert UpsertConfig `yaml:"upsert"`
+	// History configuration
+	History HistoryConfig `yaml:"history"`
+	// Secrets configuration
+	Secrets SecretsStoreConfig `yaml:"secrets"`
 }
 
 func DefaultStoreConfig() *StoreConfig {
@@ -41,6 +45,8 @@ func DefaultStoreConfig() *StoreConfig {
 		Kubernetes: k8s.DefaultKubernetesStoreConfig(),
 		Cache:      DefaultCacheStoreConfig(),
 		Upsert:     DefaultUpsertConfig(),
+		History:    DefaultHistoryConfig(),
+		Secrets:    DefaultSecretsStoreConfig(),
 	}
 }
 
@@ -48,6 +54,7 @@ func (s *StoreConfig) Sanitize() {
 	s.Kubernetes.Sanitize()
 	s.Postgres.Sanitize()
 	s.Cache.Sanitize()
+	s.Secrets.Sanitize()
 }
 
 func (s *StoreConfig) Validate() error {
@@ -60,15 +67,22 @@ func (s *StoreConfig) Validate() error {
 		if err := s.Kubernetes.Validate(); err != nil {
 			return errors.Wrap(err, "Kubernetes validation failed")
 		}
-		return nil
 	case MemoryStore:
-		return nil
 	default:
 		return errors.Errorf("Type should be either %s, %s or %s", PostgresStore, KubernetesStore, MemoryStore)
 	}
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
 	}
+	if err := s.History.Validate(); err != nil {
+		return errors.Wrap(err, "History validation failed")
+	}
+	if err := s.Secrets.Validate(); err != nil {
+		return errors.Wrap(err, "Secrets validation failed")
+	}
+	if s.Type == KubernetesStore && s.Secrets.Encryption.Enabled() {
+		return errors.New("Secrets.Encryption cannot be used with the kubernetes store. Use encryption at rest of Kubernetes instead")
+	}
 	return nil
 }
 
@@ -118,3 +132,89 @@ func (u *UpsertConfig) Validate() error {
 }
 
 var _ config.Config = &UpsertConfig{}
+
+func DefaultHistoryConfig() HistoryConfig {
+	return HistoryConfig{
+		Enabled: false,
+		Limit:   10,
+	}
+}
+
+type HistoryConfig struct {
+	// If true then previous revisions of user-managed resources are recorded.
+	// Only changes made through the Control Plane are recorded, changes applied
+	// with kubectl on Kubernetes bypass it.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_store_history_enabled"`
+	// Number of revisions that are kept for every resource
+	Limit int `yaml:"limit" envconfig:"kuma_store_history_limit"`
+}
+
+func (h *HistoryConfig) Sanitize() {
+}
+
+func (h *HistoryConfig) Validate() error {
+	if h.Limit < 1 {
+		return errors.New("Limit has to be greater than 0")
+	}
+	return nil
+}
+
+var _ config.Config = &HistoryConfig{}
+
+func DefaultSecretsStoreConfig() SecretsStoreConfig {
+	return SecretsStoreConfig{
+		Encryption: SecretsEncryptionConfig{
+			Keys: map[string]string{},
+		},
+	}
+}
+
+type SecretsStoreConfig struct {
+	// Encryption at rest of Secrets and GlobalSecrets
+	Encryption SecretsEncryptionConfig `yaml:"encryption"`
+}
+
+func (s *SecretsStoreConfig) Sanitize() {
+	s.Encryption.Sanitize()
+}
+
+func (s *SecretsStoreConfig) Validate() error {
+	if err := s.Encryption.Validate(); err != nil {
+		return errors.Wrap(err, "Encryption validation failed")
+	}
+	return nil
+}
+
+var _ config.Config = &SecretsStoreConfig{}
+
+type SecretsEncryptionConfig struct {
+	// ID of the key encryption key used to encrypt secrets. If empty, secrets are stored in plaintext
+	ActiveKeyId string `yaml:"activeKeyId" envconfig:"kuma_store_secrets_encryption_active_key_id"`
+	// Path to a YAML file with key encryption keys in the format "<key id>: <base64 encoded AES key>"
+	KeysFile string `yaml:"keysFile" envconfig:"kuma_store_secrets_encryption_keys_file"`
+	// Key encryption keys in the format "<key id>: <base64 encoded AES key>". Merged with keys from KeysFile
+	Keys map[string]string `yaml:"keys" envconfig:"kuma_store_secrets_encryption_keys"`
+}
+
+// Enabled returns true if secrets are encrypted at rest.
+func (s *SecretsEncryptionConfig) Enabled() bool {
+	return s.ActiveKeyId != ""
+}
+
+func (s *SecretsEncryptionConfig) Sanitize() {
+	for id := range s.Keys {
+		s.Keys[id] = config.SanitizedValue
+	}
+}
+
+func (s *SecretsEncryptionConfig) Validate() error {
+	if !s.Enabled() && (s.KeysFile != "" || len(s.Keys) > 0) {
+		return errors.New("ActiveKeyId has to be set when keys are defined")
+	}
+	if s.Enabled() && s.KeysFile == "" && len(s.Keys) == 0 {
+		return errors.New("either KeysFile or Keys has to be defined when ActiveKeyId is set")
+	}
+	return nil
+}
+
+var _ config.Config = &SecretsEncryptionConfig{}
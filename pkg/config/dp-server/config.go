@@ -1,6 +1,8 @@
 package dp_server
 
 import (
+	"path"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,15 +39,71 @@ type DpServerAuthConfig struct {
 	// Type of authentication. Available values: "serviceAccountToken", "dpToken", "none".
 	// If empty, autoconfigured based on the environment - "serviceAccountToken" on Kubernetes, "dpToken" on Universal.
 	Type string `yaml:"type" envconfig:"kuma_dp_server_auth_type"`
+	// Attestation defines how data plane proxies exchange a proof of their identity for a short-lived dataplane token.
+	Attestation DpServerAttestationConfig `yaml:"attestation"`
 }
 
 func (a *DpServerAuthConfig) Validate() error {
 	if a.Type != "" && a.Type != DpServerAuthNone && a.Type != DpServerAuthDpToken && a.Type != DpServerAuthServiceAccountToken {
 		return errors.Errorf("Type is invalid. Available values are: %q, %q, %q", DpServerAuthDpToken, DpServerAuthServiceAccountToken, DpServerAuthNone)
 	}
+	if err := a.Attestation.Validate(); err != nil {
+		return errors.Wrap(err, "Attestation is invalid")
+	}
+	return nil
+}
+
+// Attestation configuration for Dataplane Server
+type DpServerAttestationConfig struct {
+	// Enabled if true then data plane proxies can exchange a proof of their identity for a dataplane token.
+	// kuma-dp renews the token in the background, so tokens don't have to be distributed to the machines.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_dp_server_auth_attestation_enabled"`
+	// TokenValidity defines how long dataplane tokens issued on attestation are valid.
+	TokenValidity time.Duration `yaml:"tokenValidity" envconfig:"kuma_dp_server_auth_attestation_token_validity"`
+	// StaticKey defines a configuration of the "staticKey" attestation provider.
+	StaticKey StaticKeyAttestationConfig `yaml:"staticKey"`
+}
+
+func (a *DpServerAttestationConfig) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+	if a.TokenValidity <= 0 {
+		return errors.New("TokenValidity must be greater than 0s")
+	}
+	if len(a.StaticKey.PublicKeys) == 0 {
+		return errors.New("at least one attestation provider has to be configured")
+	}
+	for file, dataplanes := range a.StaticKey.PublicKeys {
+		if _, _, err := ParseStaticKeyDataplanes(dataplanes); err != nil {
+			return errors.Wrapf(err, "StaticKey.PublicKeys[%s] is invalid", file)
+		}
+	}
 	return nil
 }
 
+// StaticKeyAttestationConfig defines the "staticKey" attestation provider.
+// A proof of a data plane proxy is a short-lived JWT signed with a key provisioned on its machine.
+type StaticKeyAttestationConfig struct {
+	// PublicKeys maps paths to PEM-encoded public keys to data plane proxies that can be attested with them in the "mesh/name" format.
+	// Both mesh and name are patterns (see path.Match), e.g. "demo/backend-*". Use "*/*" to trust the key for every proxy.
+	PublicKeys map[string]string `yaml:"publicKeys" envconfig:"kuma_dp_server_auth_attestation_static_key_public_keys"`
+}
+
+// ParseStaticKeyDataplanes parses patterns of the mesh and the name of data plane proxies in the "mesh/name" format.
+func ParseStaticKeyDataplanes(dataplanes string) (string, string, error) {
+	parts := strings.Split(dataplanes, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("%q has to be in the \"mesh/name\" format", dataplanes)
+	}
+	for _, pattern := range parts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return "", "", errors.Wrapf(err, "%q is not a valid pattern", pattern)
+		}
+	}
+	return parts[0], parts[1], nil
+}
+
 func (a *DpServerConfig) Sanitize() {
 }
 
@@ -64,6 +122,13 @@ func DefaultDpServerConfig() *DpServerConfig {
 		Port: 5678,
 		Auth: DpServerAuthConfig{
 			Type: "", // autoconfigured from the environment
+			Attestation: DpServerAttestationConfig{
+				Enabled:       false,
+				TokenValidity: time.Hour,
+				StaticKey: StaticKeyAttestationConfig{
+					PublicKeys: map[string]string{},
+				},
+			},
 		},
 		Hds: DefaultHdsConfig(),
 	}
@@ -109,6 +109,13 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Store.Upsert.ConflictRetryBaseBackoff).To(Equal(4 * time.Second))
 			Expect(cfg.Store.Upsert.ConflictRetryMaxTimes).To(Equal(uint(10)))
 
+			Expect(cfg.Store.History.Enabled).To(BeTrue())
+			Expect(cfg.Store.History.Limit).To(Equal(25))
+
+			Expect(cfg.Store.Secrets.Encryption.ActiveKeyId).To(Equal("key-2"))
+			Expect(cfg.Store.Secrets.Encryption.KeysFile).To(Equal("/path/to/keys.yaml"))
+			Expect(cfg.Store.Secrets.Encryption.Keys).To(Equal(map[string]string{"key-1": "a2V5LTE=", "key-2": "a2V5LTI="}))
+
 			Expect(cfg.Store.Postgres.TLS.Mode).To(Equal(postgres.VerifyFull))
 			Expect(cfg.Store.Postgres.TLS.CertPath).To(Equal("/path/to/cert"))
 			Expect(cfg.Store.Postgres.TLS.KeyPath).To(Equal("/path/to/key"))
@@ -127,6 +134,16 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.ApiServer.Authn.LocalhostIsAdmin).To(Equal(false))
 			Expect(cfg.ApiServer.Authn.Type).To(Equal("custom-authn"))
 			Expect(cfg.ApiServer.Authn.Tokens.BootstrapAdminToken).To(BeFalse())
+			Expect(cfg.ApiServer.Authn.OIDC.Issuer).To(Equal("https://idp.example.com"))
+			Expect(cfg.ApiServer.Authn.OIDC.Audience).To(Equal("kumactl"))
+			Expect(cfg.ApiServer.Authn.OIDC.JwksURL).To(Equal("https://idp.example.com/keys"))
+			Expect(cfg.ApiServer.Authn.OIDC.JwksFile).To(Equal("/jwks.json"))
+			Expect(cfg.ApiServer.Authn.OIDC.UsernameClaim).To(Equal("email"))
+			Expect(cfg.ApiServer.Authn.OIDC.UsernamePrefix).To(Equal("sso:"))
+			Expect(cfg.ApiServer.Authn.OIDC.GroupsClaim).To(Equal("roles"))
+			Expect(cfg.ApiServer.Authn.OIDC.GroupsPrefix).To(Equal("sso:"))
+			Expect(cfg.ApiServer.Audit.Level).To(Equal("spec"))
+			Expect(cfg.ApiServer.Audit.Output).To(Equal("/var/log/kuma/audit.log"))
 			Expect(cfg.ApiServer.CorsAllowedDomains).To(Equal([]string{"https://kuma", "https://someapi"}))
 
 			// nolint: staticcheck
@@ -204,6 +221,7 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Multizone.Zone.KDS.MaxMsgSize).To(Equal(uint32(2)))
 
 			Expect(cfg.Defaults.SkipMeshCreation).To(BeTrue())
+			Expect(cfg.Defaults.SigningKeyAlgorithm).To(Equal("ECDSA-P256"))
 
 			Expect(cfg.Diagnostics.ServerPort).To(Equal(uint32(5003)))
 			Expect(cfg.Diagnostics.DebugEndpoints).To(BeTrue())
@@ -223,10 +241,16 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Metrics.Mesh.MaxResyncTimeout).To(Equal(27 * time.Second))
 			Expect(cfg.Metrics.Dataplane.SubscriptionLimit).To(Equal(47))
 			Expect(cfg.Metrics.Dataplane.IdleTimeout).To(Equal(1 * time.Minute))
+			Expect(cfg.Metrics.SLO.QueryEndpoint).To(Equal("http://prometheus:9090"))
+			Expect(cfg.Metrics.SLO.QueryTimeout).To(Equal(3 * time.Second))
+			Expect(cfg.Metrics.SLO.RefreshInterval).To(Equal(2 * time.Minute))
 
 			Expect(cfg.DpServer.TlsCertFile).To(Equal("/test/path"))
 			Expect(cfg.DpServer.TlsKeyFile).To(Equal("/test/path/key"))
 			Expect(cfg.DpServer.Auth.Type).To(Equal("dpToken"))
+			Expect(cfg.DpServer.Auth.Attestation.Enabled).To(BeTrue())
+			Expect(cfg.DpServer.Auth.Attestation.TokenValidity).To(Equal(30 * time.Minute))
+			Expect(cfg.DpServer.Auth.Attestation.StaticKey.PublicKeys).To(Equal(map[string]string{"/keys/a.pem": "demo/backend-*", "/keys/b.pem": "*/*"}))
 			Expect(cfg.DpServer.Port).To(Equal(9876))
 			Expect(cfg.DpServer.Hds.Enabled).To(BeFalse())
 			Expect(cfg.DpServer.Hds.Interval).To(Equal(11 * time.Second))
@@ -237,7 +261,7 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.DpServer.Hds.CheckDefaults.HealthyThreshold).To(Equal(uint32(8)))
 			Expect(cfg.DpServer.Hds.CheckDefaults.UnhealthyThreshold).To(Equal(uint32(9)))
 
-			Expect(cfg.Access.Type).To(Equal("custom-rbac"))
+			Expect(cfg.Access.Type).To(Equal("rbac"))
 			Expect(cfg.Access.Static.AdminResources.Users).To(Equal([]string{"ar-admin1", "ar-admin2"}))
 			Expect(cfg.Access.Static.AdminResources.Groups).To(Equal([]string{"ar-group1", "ar-group2"}))
 			Expect(cfg.Access.Static.GenerateDPToken.Users).To(Equal([]string{"dp-admin1", "dp-admin2"}))
@@ -248,6 +272,10 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Access.Static.GenerateZoneToken.Groups).To(Equal([]string{"zt-group1", "zt-group2"}))
 			Expect(cfg.Access.Static.ViewConfigDump.Users).To(Equal([]string{"zt-admin1", "zt-admin2"}))
 			Expect(cfg.Access.Static.ViewConfigDump.Groups).To(Equal([]string{"zt-group1", "zt-group2"}))
+			Expect(cfg.Access.Static.ChangeLogLevel.Users).To(Equal([]string{"ll-admin1", "ll-admin2"}))
+			Expect(cfg.Access.Static.ChangeLogLevel.Groups).To(Equal([]string{"ll-group1", "ll-group2"}))
+			Expect(cfg.Access.RBAC.Admin.Users).To(Equal([]string{"rbac-admin1", "rbac-admin2"}))
+			Expect(cfg.Access.RBAC.Admin.Groups).To(Equal([]string{"rbac-group1", "rbac-group2"}))
 
 			Expect(cfg.Experimental.MeshGateway).To(BeTrue())
 			Expect(cfg.Experimental.GatewayAPI).To(BeTrue())
@@ -283,6 +311,16 @@ store:
   upsert:
     conflictRetryBaseBackoff: 4s
     conflictRetryMaxTimes: 10
+  history:
+    enabled: true
+    limit: 25
+  secrets:
+    encryption:
+      activeKeyId: key-2
+      keysFile: /path/to/keys.yaml
+      keys:
+        key-1: a2V5LTE=
+        key-2: a2V5LTI=
 bootstrapServer:
   params:
     adminPort: 1234
@@ -309,6 +347,18 @@ apiServer:
     localhostIsAdmin: false
     tokens:
       bootstrapAdminToken: false
+    oidc:
+      issuer: https://idp.example.com
+      audience: kumactl
+      jwksURL: https://idp.example.com/keys
+      jwksFile: /jwks.json
+      usernameClaim: email
+      usernamePrefix: "sso:"
+      groupsClaim: roles
+      groupsPrefix: "sso:"
+  audit:
+    level: spec
+    output: /var/log/kuma/audit.log
   readOnly: true
   corsAllowedDomains:
     - https://kuma
@@ -414,6 +464,7 @@ dnsServer:
   serviceVipEnabled: false
 defaults:
   skipMeshCreation: true
+  signingKeyAlgorithm: ECDSA-P256
 diagnostics:
   serverPort: 5003
   debugEndpoints: true
@@ -431,12 +482,23 @@ metrics:
   dataplane:
     subscriptionLimit: 47
     idleTimeout: 1m
+  slo:
+    queryEndpoint: http://prometheus:9090
+    queryTimeout: 3s
+    refreshInterval: 2m
 dpServer:
   tlsCertFile: /test/path
   tlsKeyFile: /test/path/key
   port: 9876
   auth:
     type: dpToken
+    attestation:
+      enabled: true
+      tokenValidity: 30m
+      staticKey:
+        publicKeys:
+          /keys/a.pem: demo/backend-*
+          /keys/b.pem: "*/*"
   hds:
     enabled: false
     interval: 11s
@@ -448,7 +510,7 @@ dpServer:
       healthyThreshold: 8
       unhealthyThreshold: 9
 access:
-  type: custom-rbac
+  type: rbac
   static:
     adminResources:
       users: ["ar-admin1", "ar-admin2"]
@@ -465,6 +527,13 @@ access:
     viewConfigDump:
       users: ["zt-admin1", "zt-admin2"]
       groups: ["zt-group1", "zt-group2"]
+    changeLogLevel:
+      users: ["ll-admin1", "ll-admin2"]
+      groups: ["ll-group1", "ll-group2"]
+  rbac:
+    admin:
+      users: ["rbac-admin1", "rbac-admin2"]
+      groups: ["rbac-group1", "rbac-group2"]
 experimental:
   meshGateway: true
   gatewayAPI: true
@@ -500,6 +569,11 @@ experimental:
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                                                         "3s",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_BASE_BACKOFF":                                            "4s",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_MAX_TIMES":                                               "10",
+				"KUMA_STORE_HISTORY_ENABLED":                                                               "true",
+				"KUMA_STORE_HISTORY_LIMIT":                                                                 "25",
+				"KUMA_STORE_SECRETS_ENCRYPTION_ACTIVE_KEY_ID":                                              "key-2",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEYS_FILE":                                                  "/path/to/keys.yaml",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEYS":                                                       "key-1:a2V5LTE=,key-2:a2V5LTI=",
 				"KUMA_API_SERVER_READ_ONLY":                                                                "true",
 				"KUMA_API_SERVER_HTTP_PORT":                                                                "15681",
 				"KUMA_API_SERVER_HTTP_INTERFACE":                                                           "192.168.0.1",
@@ -513,6 +587,16 @@ experimental:
 				"KUMA_API_SERVER_AUTHN_TYPE":                                                               "custom-authn",
 				"KUMA_API_SERVER_AUTHN_LOCALHOST_IS_ADMIN":                                                 "false",
 				"KUMA_API_SERVER_AUTHN_TOKENS_BOOTSTRAP_ADMIN_TOKEN":                                       "false",
+				"KUMA_API_SERVER_AUTHN_OIDC_ISSUER":                                                        "https://idp.example.com",
+				"KUMA_API_SERVER_AUTHN_OIDC_AUDIENCE":                                                      "kumactl",
+				"KUMA_API_SERVER_AUTHN_OIDC_JWKS_URL":                                                      "https://idp.example.com/keys",
+				"KUMA_API_SERVER_AUTHN_OIDC_JWKS_FILE":                                                     "/jwks.json",
+				"KUMA_API_SERVER_AUTHN_OIDC_USERNAME_CLAIM":                                                "email",
+				"KUMA_API_SERVER_AUTHN_OIDC_USERNAME_PREFIX":                                               "sso:",
+				"KUMA_API_SERVER_AUTHN_OIDC_GROUPS_CLAIM":                                                  "roles",
+				"KUMA_API_SERVER_AUTHN_OIDC_GROUPS_PREFIX":                                                 "sso:",
+				"KUMA_API_SERVER_AUDIT_LEVEL":                                                              "spec",
+				"KUMA_API_SERVER_AUDIT_OUTPUT":                                                             "/var/log/kuma/audit.log",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_GRPC_PORT":                                              "3333",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_PORT":                                                   "2222",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_DEFAULT_FETCH_TIMEOUT":                                  "45s",
@@ -581,6 +665,7 @@ experimental:
 				"KUMA_MULTIZONE_ZONE_KDS_MAX_MSG_SIZE":                                                     "2",
 				"KUMA_MULTIZONE_GLOBAL_KDS_ZONE_INSIGHT_FLUSH_INTERVAL":                                    "5s",
 				"KUMA_DEFAULTS_SKIP_MESH_CREATION":                                                         "true",
+				"KUMA_DEFAULTS_SIGNING_KEY_ALGORITHM":                                                      "ECDSA-P256",
 				"KUMA_DIAGNOSTICS_SERVER_PORT":                                                             "5003",
 				"KUMA_DIAGNOSTICS_DEBUG_ENDPOINTS":                                                         "true",
 				"KUMA_XDS_SERVER_DATAPLANE_STATUS_FLUSH_INTERVAL":                                          "7s",
@@ -592,9 +677,15 @@ experimental:
 				"KUMA_METRICS_MESH_MIN_RESYNC_TIMEOUT":                                                     "35s",
 				"KUMA_METRICS_DATAPLANE_SUBSCRIPTION_LIMIT":                                                "47",
 				"KUMA_METRICS_DATAPLANE_IDLE_TIMEOUT":                                                      "1m",
+				"KUMA_METRICS_SLO_QUERY_ENDPOINT":                                                          "http://prometheus:9090",
+				"KUMA_METRICS_SLO_QUERY_TIMEOUT":                                                           "3s",
+				"KUMA_METRICS_SLO_REFRESH_INTERVAL":                                                        "2m",
 				"KUMA_DP_SERVER_TLS_CERT_FILE":                                                             "/test/path",
 				"KUMA_DP_SERVER_TLS_KEY_FILE":                                                              "/test/path/key",
 				"KUMA_DP_SERVER_AUTH_TYPE":                                                                 "dpToken",
+				"KUMA_DP_SERVER_AUTH_ATTESTATION_ENABLED":                                                  "true",
+				"KUMA_DP_SERVER_AUTH_ATTESTATION_TOKEN_VALIDITY":                                           "30m",
+				"KUMA_DP_SERVER_AUTH_ATTESTATION_STATIC_KEY_PUBLIC_KEYS":                                   "/keys/a.pem:demo/backend-*,/keys/b.pem:*/*",
 				"KUMA_DP_SERVER_PORT":                                                                      "9876",
 				"KUMA_DP_SERVER_HDS_ENABLED":                                                               "false",
 				"KUMA_DP_SERVER_HDS_INTERVAL":                                                              "11s",
@@ -604,7 +695,7 @@ experimental:
 				"KUMA_DP_SERVER_HDS_CHECK_NO_TRAFFIC_INTERVAL":                                             "7s",
 				"KUMA_DP_SERVER_HDS_CHECK_HEALTHY_THRESHOLD":                                               "8",
 				"KUMA_DP_SERVER_HDS_CHECK_UNHEALTHY_THRESHOLD":                                             "9",
-				"KUMA_ACCESS_TYPE":                                                                         "custom-rbac",
+				"KUMA_ACCESS_TYPE":                                                                         "rbac",
 				"KUMA_ACCESS_STATIC_ADMIN_RESOURCES_USERS":                                                 "ar-admin1,ar-admin2",
 				"KUMA_ACCESS_STATIC_ADMIN_RESOURCES_GROUPS":                                                "ar-group1,ar-group2",
 				"KUMA_ACCESS_STATIC_GENERATE_DP_TOKEN_USERS":                                               "dp-admin1,dp-admin2",
@@ -615,6 +706,10 @@ experimental:
 				"KUMA_ACCESS_STATIC_GENERATE_ZONE_TOKEN_GROUPS":                                            "zt-group1,zt-group2",
 				"KUMA_ACCESS_STATIC_GET_CONFIG_DUMP_USERS":                                                 "zt-admin1,zt-admin2",
 				"KUMA_ACCESS_STATIC_GET_CONFIG_DUMP_GROUPS":                                                "zt-group1,zt-group2",
+				"KUMA_ACCESS_STATIC_CHANGE_LOG_LEVEL_USERS":                                                "ll-admin1,ll-admin2",
+				"KUMA_ACCESS_STATIC_CHANGE_LOG_LEVEL_GROUPS":                                               "ll-group1,ll-group2",
+				"KUMA_ACCESS_RBAC_ADMIN_USERS":                                                             "rbac-admin1,rbac-admin2",
+				"KUMA_ACCESS_RBAC_ADMIN_GROUPS":                                                            "rbac-group1,rbac-group2",
 				"KUMA_EXPERIMENTAL_MESHGATEWAY":                                                            "true",
 				"KUMA_EXPERIMENTAL_GATEWAY_API":                                                            "true",
 				"KUMA_EXPERIMENTAL_KUBE_OUTBOUNDS_AS_VIPS":                                                 "true",
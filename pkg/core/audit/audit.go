@@ -0,0 +1,199 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("audit")
+
+type Level string
+
+const (
+	// LevelNone disables the audit log.
+	LevelNone Level = "none"
+	// LevelMetadata records who did what on which resource and the outcome of the operation.
+	LevelMetadata Level = "metadata"
+	// LevelSpec records everything from LevelMetadata and a diff of the resource spec.
+	LevelSpec Level = "spec"
+)
+
+type Operation string
+
+const (
+	Create         Operation = "CREATE"
+	Update         Operation = "UPDATE"
+	Delete         Operation = "DELETE"
+	GenerateToken  Operation = "GENERATE_TOKEN"
+	SetLogLevel    Operation = "SET_LOG_LEVEL"
+	RevertLogLevel Operation = "REVERT_LOG_LEVEL"
+)
+
+type TokenType string
+
+const (
+	DataplaneToken   TokenType = "dataplane"
+	ZoneIngressToken TokenType = "zone-ingress"
+	ZoneToken        TokenType = "zone"
+	UserToken        TokenType = "user"
+)
+
+type Outcome string
+
+const (
+	Success Outcome = "SUCCESS"
+	Denied  Outcome = "DENIED"
+	Failure Outcome = "FAILURE"
+)
+
+// Entry is a single record of the audit log.
+// Diff is a JSON Merge Patch (RFC 7386) that transforms the spec before the operation into the spec after the operation.
+type Entry struct {
+	Time         time.Time          `json:"time"`
+	User         string             `json:"user"`
+	Groups       []string           `json:"groups,omitempty"`
+	Operation    Operation          `json:"operation"`
+	ResourceType model.ResourceType `json:"resourceType,omitempty"`
+	Mesh         string             `json:"mesh,omitempty"`
+	Name         string             `json:"name,omitempty"`
+	TokenType    TokenType          `json:"tokenType,omitempty"`
+	Outcome      Outcome            `json:"outcome"`
+	Error        string             `json:"error,omitempty"`
+	Diff         json.RawMessage    `json:"diff,omitempty"`
+	Details      map[string]string  `json:"details,omitempty"`
+}
+
+// Event describes an audited operation. Before and After are specs of the resource, either of them can be nil.
+type Event struct {
+	Operation    Operation
+	ResourceType model.ResourceType
+	Key          model.ResourceKey
+	TokenType    TokenType
+	Before       model.ResourceSpec
+	After        model.ResourceSpec
+	Err          error
+	Denied       bool
+	// Details are parameters of operations that don't change resources, e.g. the new log level
+	Details map[string]string
+}
+
+// AccessDenied marks the event as rejected by the access control.
+func AccessDenied(event Event, err error) Event {
+	event.Denied = true
+	event.Err = err
+	return event
+}
+
+type Logger interface {
+	Log(ctx context.Context, event Event)
+}
+
+// NewLogger creates Logger that writes every Entry as a single line of JSON to the writer.
+func NewLogger(level Level, writer io.Writer) Logger {
+	if level == LevelNone {
+		return NopLogger()
+	}
+	return &jsonLinesLogger{
+		level:  level,
+		writer: writer,
+	}
+}
+
+type jsonLinesLogger struct {
+	sync.Mutex
+	level  Level
+	writer io.Writer
+}
+
+var _ Logger = &jsonLinesLogger{}
+
+func (j *jsonLinesLogger) Log(ctx context.Context, event Event) {
+	u := user.FromCtx(ctx)
+	entry := Entry{
+		Time:         core.Now(),
+		User:         u.Name,
+		Groups:       u.Groups,
+		Operation:    event.Operation,
+		ResourceType: event.ResourceType,
+		Mesh:         event.Key.Mesh,
+		Name:         event.Key.Name,
+		TokenType:    event.TokenType,
+		Outcome:      Success,
+		Details:      event.Details,
+	}
+	switch {
+	case event.Denied:
+		entry.Outcome = Denied
+	case event.Err != nil:
+		entry.Outcome = Failure
+	}
+	if event.Err != nil {
+		entry.Error = event.Err.Error()
+	}
+	if j.level == LevelSpec && entry.Outcome == Success && !sensitiveTypes[event.ResourceType] {
+		diff, err := Diff(event.Before, event.After)
+		if err != nil {
+			log.Error(err, "could not compute a diff of the resource", "type", event.ResourceType, "key", event.Key)
+		}
+		entry.Diff = diff
+	}
+
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		log.Error(err, "could not marshal an audit entry")
+		return
+	}
+	j.Lock()
+	defer j.Unlock()
+	if _, err := j.writer.Write(append(bytes, '\n')); err != nil {
+		log.Error(err, "could not write an audit entry")
+	}
+}
+
+// sensitiveTypes are never diffed, so their content does not leak out of the store into the audit log.
+var sensitiveTypes = map[model.ResourceType]bool{
+	system.SecretType:       true,
+	system.GlobalSecretType: true,
+}
+
+// Diff returns JSON Merge Patch between two specs. It returns nil if both specs are nil.
+func Diff(before, after model.ResourceSpec) (json.RawMessage, error) {
+	if before == nil && after == nil {
+		return nil, nil
+	}
+	beforeJSON, err := specToJSON(before)
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := specToJSON(after)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreateMergePatch(beforeJSON, afterJSON)
+}
+
+func specToJSON(spec model.ResourceSpec) ([]byte, error) {
+	if spec == nil {
+		return []byte("{}"), nil
+	}
+	return util_proto.ToJSON(spec)
+}
+
+func NopLogger() Logger {
+	return nopLogger{}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Log(context.Context, Event) {}
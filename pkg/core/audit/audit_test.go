@@ -0,0 +1,180 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+var _ = Describe("Audit Logger", func() {
+
+	var buf *bytes.Buffer
+	var ctx context.Context
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		}
+		buf = &bytes.Buffer{}
+		ctx = user.Ctx(context.Background(), user.User{
+			Name:   "john.doe@example.com",
+			Groups: []string{"team-a"},
+		})
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	before := &mesh_proto.TrafficPermission{
+		Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "web"}}},
+		Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+	}
+	after := &mesh_proto.TrafficPermission{
+		Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "web"}}},
+		Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "*"}}},
+	}
+	key := model.ResourceKey{Mesh: "default", Name: "tp-1"}
+
+	It("should write an entry with spec diff", func() {
+		// given
+		logger := audit.NewLogger(audit.LevelSpec, buf)
+
+		// when
+		logger.Log(ctx, audit.Event{
+			Operation:    audit.Update,
+			ResourceType: core_mesh.TrafficPermissionType,
+			Key:          key,
+			Before:       before,
+			After:        after,
+		})
+
+		// then
+		Expect(buf.String()).To(HaveSuffix("\n"))
+		Expect(buf.Bytes()).To(MatchJSON(`
+		{
+			"time": "2022-01-01T00:00:00Z",
+			"user": "john.doe@example.com",
+			"groups": ["team-a"],
+			"operation": "UPDATE",
+			"resourceType": "TrafficPermission",
+			"mesh": "default",
+			"name": "tp-1",
+			"outcome": "SUCCESS",
+			"diff": {"destinations": [{"match": {"kuma.io/service": "*"}}]}
+		}`))
+	})
+
+	It("should not write diff on metadata level", func() {
+		// given
+		logger := audit.NewLogger(audit.LevelMetadata, buf)
+
+		// when
+		logger.Log(ctx, audit.Event{
+			Operation:    audit.Delete,
+			ResourceType: core_mesh.TrafficPermissionType,
+			Key:          key,
+			Before:       before,
+		})
+
+		// then
+		Expect(buf.Bytes()).To(MatchJSON(`
+		{
+			"time": "2022-01-01T00:00:00Z",
+			"user": "john.doe@example.com",
+			"groups": ["team-a"],
+			"operation": "DELETE",
+			"resourceType": "TrafficPermission",
+			"mesh": "default",
+			"name": "tp-1",
+			"outcome": "SUCCESS"
+		}`))
+	})
+
+	It("should record denied and failed operations", func() {
+		// given
+		logger := audit.NewLogger(audit.LevelSpec, buf)
+		event := audit.Event{
+			Operation:    audit.Create,
+			ResourceType: core_mesh.TrafficPermissionType,
+			Key:          key,
+			After:        after,
+		}
+
+		// when
+		logger.Log(ctx, audit.AccessDenied(event, errors.New("access denied")))
+		event.Err = errors.New("mesh not found")
+		logger.Log(ctx, event)
+
+		// then
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(MatchJSON(`
+		{
+			"time": "2022-01-01T00:00:00Z",
+			"user": "john.doe@example.com",
+			"groups": ["team-a"],
+			"operation": "CREATE",
+			"resourceType": "TrafficPermission",
+			"mesh": "default",
+			"name": "tp-1",
+			"outcome": "DENIED",
+			"error": "access denied"
+		}`))
+		Expect(lines[1]).To(MatchJSON(`
+		{
+			"time": "2022-01-01T00:00:00Z",
+			"user": "john.doe@example.com",
+			"groups": ["team-a"],
+			"operation": "CREATE",
+			"resourceType": "TrafficPermission",
+			"mesh": "default",
+			"name": "tp-1",
+			"outcome": "FAILURE",
+			"error": "mesh not found"
+		}`))
+	})
+
+	It("should never write a diff of secrets", func() {
+		// given
+		logger := audit.NewLogger(audit.LevelSpec, buf)
+
+		// when
+		logger.Log(ctx, audit.Event{
+			Operation:    audit.Create,
+			ResourceType: system.SecretType,
+			Key:          key,
+			After: &system_proto.Secret{
+				Data: &wrapperspb.BytesValue{Value: []byte("top-secret")},
+			},
+		})
+
+		// then
+		Expect(buf.String()).ToNot(ContainSubstring("diff"))
+	})
+
+	It("should not write anything on none level", func() {
+		// given
+		logger := audit.NewLogger(audit.LevelNone, buf)
+
+		// when
+		logger.Log(ctx, audit.Event{Operation: audit.Delete, Key: key})
+
+		// then
+		Expect(buf.String()).To(BeEmpty())
+	})
+})
@@ -3,14 +3,17 @@ package bootstrap
 import (
 	"context"
 	"net"
+	"os"
 
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/dns/lookup"
@@ -24,9 +27,11 @@ import (
 	"github.com/kumahq/kuma/pkg/core/managers/apis/zoneingressinsight"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/zoneinsight"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
@@ -36,6 +41,7 @@ import (
 	runtime_reports "github.com/kumahq/kuma/pkg/core/runtime/reports"
 	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/dns/resolver"
 	"github.com/kumahq/kuma/pkg/dp-server/server"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
@@ -121,17 +127,16 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, builder.Metrics()))
 	builder.WithKDSContext(kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name))
 
-	builder.WithAccess(core_runtime.Access{
-		ResourceAccess:       resources_access.NewAdminResourceAccess(builder.Config().Access.Static.AdminResources),
-		DataplaneTokenAccess: tokens_access.NewStaticGenerateDataplaneTokenAccess(builder.Config().Access.Static.GenerateDPToken),
-		ZoneTokenAccess:      zone_access.NewStaticZoneTokenAccess(builder.Config().Access.Static.GenerateZoneToken),
-		ConfigDumpAccess:     access.NewStaticConfigDumpAccess(builder.Config().Access.Static.ViewConfigDump),
-	})
+	initializeAccess(builder)
 
 	if err := initializeAPIServerAuthenticator(builder); err != nil {
 		return nil, err
 	}
 
+	if err := initializeAuditLogger(cfg, builder); err != nil {
+		return nil, err
+	}
+
 	if err := initializeAfterBootstrap(cfg, builder); err != nil {
 		return nil, err
 	}
@@ -251,6 +256,11 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 		return err
 	}
 	builder.WithResourceStore(rs)
+	if txs, ok := rs.(core_store.Transactions); ok {
+		builder.WithTransactions(txs)
+	} else {
+		builder.WithTransactions(core_store.NoTransactions{})
+	}
 	eventBus := events.NewEventBus()
 	if err := plugin.EventListener(builder, eventBus); err != nil {
 		return err
@@ -282,12 +292,19 @@ func initializeSecretStore(cfg kuma_cp.Config, builder *core_runtime.Builder) er
 	if err != nil {
 		return errors.Wrapf(err, "could not retrieve secret store %s plugin", pluginName)
 	}
-	if ss, err := plugin.NewSecretStore(builder, pluginConfig); err != nil {
+	ss, err := plugin.NewSecretStore(builder, pluginConfig)
+	if err != nil {
 		return err
-	} else {
-		builder.WithSecretStore(ss)
-		return nil
 	}
+	if cfg.Store.Type != store.KubernetesStore { // encryption on Kubernetes is delegated to Kubernetes
+		cipher, err := secret_cipher.FromConfig(cfg.Store.Secrets.Encryption)
+		if err != nil {
+			return errors.Wrap(err, "could not configure encryption of secrets")
+		}
+		ss = secret_store.NewEncryptedSecretStore(ss, cipher)
+	}
+	builder.WithSecretStore(ss)
+	return nil
 }
 
 func initializeConfigStore(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
@@ -338,13 +355,50 @@ func initializeAPIServerAuthenticator(builder *core_runtime.Builder) error {
 	return nil
 }
 
+func initializeAccess(builder *core_runtime.Builder) {
+	if builder.Config().Access.Type == config_access.RBACType {
+		authorizer := rbac.NewAuthorizer(builder.ReadOnlyResourceManager(), builder.Config().Access.RBAC)
+		builder.WithAccess(core_runtime.Access{
+			ResourceAccess:       resources_access.NewRBACResourceAccess(authorizer),
+			DataplaneTokenAccess: tokens_access.NewRBACGenerateDataplaneTokenAccess(authorizer),
+			ZoneTokenAccess:      zone_access.NewRBACZoneTokenAccess(authorizer),
+			ConfigDumpAccess:     access.NewRBACConfigDumpAccess(authorizer),
+		})
+		return
+	}
+	builder.WithAccess(core_runtime.Access{
+		ResourceAccess:       resources_access.NewAdminResourceAccess(builder.Config().Access.Static.AdminResources),
+		DataplaneTokenAccess: tokens_access.NewStaticGenerateDataplaneTokenAccess(builder.Config().Access.Static.GenerateDPToken),
+		ZoneTokenAccess:      zone_access.NewStaticZoneTokenAccess(builder.Config().Access.Static.GenerateZoneToken),
+		ConfigDumpAccess:     access.NewStaticConfigDumpAccess(builder.Config().Access.Static.ViewConfigDump, builder.Config().Access.Static.ChangeLogLevel),
+	})
+}
+
+func initializeAuditLogger(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
+	level := audit.Level(cfg.ApiServer.Audit.Level)
+	if level == audit.LevelNone {
+		builder.WithAuditLogger(audit.NopLogger())
+		return nil
+	}
+	writer := os.Stdout
+	if cfg.ApiServer.Audit.Output != "stdout" {
+		file, err := os.OpenFile(cfg.ApiServer.Audit.Output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Wrap(err, "could not open audit log file")
+		}
+		writer = file
+	}
+	builder.WithAuditLogger(audit.NewLogger(level, writer))
+	return nil
+}
+
 func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
 	defaultManager := core_manager.NewResourceManager(builder.ResourceStore())
 	customizableManager := core_manager.NewCustomizableResourceManager(defaultManager, nil)
 
 	customizableManager.Customize(
 		mesh.MeshType,
-		mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.ResourceValidators().Mesh),
+		mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.ResourceValidators().Mesh, builder.Config().Defaults.SigningKeyAlgorithm),
 	)
 
 	rateLimitValidator := ratelimit_managers.RateLimitValidator{
@@ -393,15 +447,6 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 		zoneegressinsight.NewZoneEgressInsightManager(builder.ResourceStore(), builder.Config().Metrics.Dataplane),
 	)
 
-	var cipher secret_cipher.Cipher
-	switch cfg.Store.Type {
-	case store.KubernetesStore:
-		cipher = secret_cipher.None() // deliberately turn encryption off on Kubernetes
-	case store.MemoryStore, store.PostgresStore:
-		cipher = secret_cipher.TODO() // get back to encryption in universal case
-	default:
-		return errors.Errorf("unknown store type %s", cfg.Store.Type)
-	}
 	var secretValidator secret_manager.SecretValidator
 	switch cfg.Mode {
 	case config_core.Zone:
@@ -412,14 +457,21 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 
 	customizableManager.Customize(
 		system.SecretType,
-		secret_manager.NewSecretManager(builder.SecretStore(), cipher, secretValidator),
+		secret_manager.NewSecretManager(builder.SecretStore(), secretValidator),
 	)
 
 	customizableManager.Customize(
 		system.GlobalSecretType,
-		secret_manager.NewGlobalSecretManager(builder.SecretStore(), cipher),
+		secret_manager.NewGlobalSecretManager(builder.SecretStore()),
 	)
 
+	if cfg.Store.History.Enabled {
+		revisions := history.NewConfigRevisionStore(builder.ConfigManager(), cfg.Store.History.Limit)
+		for _, typ := range registry.Global().ObjectTypes(history.TrackedTypes()) {
+			customizableManager.Customize(typ, history.NewHistoryResourceManager(customizableManager.ResourceManager(typ), revisions))
+		}
+	}
+
 	builder.WithResourceManager(customizableManager)
 
 	if builder.Config().Store.Cache.Enabled {
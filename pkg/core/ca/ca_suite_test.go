@@ -0,0 +1,11 @@
+package ca_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCA(t *testing.T) {
+	test.RunSpecs(t, "CA Suite")
+}
@@ -16,7 +16,6 @@ import (
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	util_tls "github.com/kumahq/kuma/pkg/tls"
-	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
 )
 
 const (
@@ -33,13 +32,13 @@ func WithExpirationTime(expiration time.Duration) CertOptsFn {
 	}
 }
 
-func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValueTagSet, certOpts ...CertOptsFn) (*util_tls.KeyPair, error) {
+func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValueTagSet, keyType util_tls.KeyType, certOpts ...CertOptsFn) (*util_tls.KeyPair, error) {
 	caPrivateKey, caCert, err := loadKeyPair(ca)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load CA key pair")
 	}
 
-	workloadKey, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	workloadKey, err := keyType()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate a private key")
 	}
@@ -54,7 +53,8 @@ func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValu
 	return util_tls.ToKeyPair(workloadKey, workloadCert)
 }
 
-func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
+// WorkloadURIs returns URI SANs of a workload certificate: SPIFFE ID of every service and Kuma URI of every tag.
+func WorkloadURIs(trustDomain string, tags mesh_proto.MultiValueTagSet) ([]*url.URL, error) {
 	var uris []*url.URL
 	for _, service := range tags.Values(mesh_proto.ServiceTag) {
 		uri, err := spiffe.ParseID(fmt.Sprintf("spiffe://%s/%s", trustDomain, service), spiffe.AllowTrustDomainWorkload(trustDomain))
@@ -73,6 +73,14 @@ func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, p
 			uris = append(uris, u)
 		}
 	}
+	return uris, nil
+}
+
+func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
+	uris, err := WorkloadURIs(trustDomain, tags)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
 	serialNumber, err := newSerialNumber()
@@ -0,0 +1,56 @@
+package issuer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+)
+
+// DefaultRevocationListValidityPeriod is long, because the list is pushed to dataplanes whenever it changes
+// and Envoy rejects every peer once the list expires.
+const DefaultRevocationListValidityPeriod = 365 * 24 * time.Hour
+
+// NewRevocationList returns PEM encoded CRL of the CA with the given revoked certificates.
+func NewRevocationList(ca util_tls.KeyPair, revoked core_ca.RevokedCerts) ([]byte, error) {
+	caPrivateKey, caCert, err := loadKeyPair(ca)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load CA key pair")
+	}
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("key of the CA can't be used for signing")
+	}
+
+	now := core.Now()
+	template := &x509.RevocationList{
+		Number:     big.NewInt(now.UnixNano()),
+		ThisUpdate: now.Add(-DefaultAllowedClockSkew),
+		NextUpdate: now.Add(DefaultRevocationListValidityPeriod),
+	}
+	for _, cert := range revoked {
+		serial, ok := new(big.Int).SetString(cert.Serial, 16)
+		if !ok {
+			return nil, errors.Errorf("invalid serial number %q", cert.Serial)
+		}
+		template.RevokedCertificates = append(template.RevokedCertificates, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: cert.RevokedAt,
+		})
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, template, caCert, signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 revocation list")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}), nil
+}
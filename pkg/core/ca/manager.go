@@ -26,5 +26,11 @@ type Manager interface {
 	GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (KeyPair, error)
 }
 
+// SecretValidator is implemented by managers of backends that read Secrets uploaded by users after the backend was created.
+type SecretValidator interface {
+	// ValidateSecret validates data of the Secret of the mesh before it's created or updated.
+	ValidateSecret(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, name string, data []byte) error
+}
+
 // Managers hold Manager instance for each type of backend available (by default: builtin, provided)
 type Managers = map[string]Manager
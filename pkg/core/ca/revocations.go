@@ -0,0 +1,117 @@
+package ca
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// RevokedCert is a certificate of a dataplane that was revoked before it expired.
+type RevokedCert struct {
+	// Serial is the serial number of the certificate in hexadecimal format.
+	Serial string `json:"serial"`
+	// Dataplane is the name of the dataplane that the certificate was issued for.
+	Dataplane string `json:"dataplane"`
+	// Backend is the name of the CA backend that issued the certificate.
+	Backend   string    `json:"backend"`
+	RevokedAt time.Time `json:"revokedAt"`
+	// ExpiresAt is the expiration time of the certificate. There is no point in revoking the certificate after it.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// RevokedCerts keeps track of revoked certificates of dataplanes in a Mesh.
+// If a dataplane is compromised, it's more convenient to revoke its certificate than to rotate the whole CA.
+// The list is stored as JSON in the Secret returned by RevocationsSecretKey.
+type RevokedCerts []RevokedCert
+
+func RevocationsSecretKey(mesh string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: "dataplane-cert-revocations-" + mesh,
+	}
+}
+
+func ParseRevokedCerts(data []byte) (RevokedCerts, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var revoked RevokedCerts
+	if err := json.Unmarshal(data, &revoked); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+func (r RevokedCerts) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// LoadRevokedCerts returns certificates of the mesh that are revoked and not yet expired.
+func LoadRevokedCerts(ctx context.Context, manager manager.ReadOnlyResourceManager, mesh string, now time.Time) (RevokedCerts, error) {
+	secret := system.NewSecretResource()
+	if err := manager.Get(ctx, secret, core_store.GetBy(RevocationsSecretKey(mesh))); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	revoked, err := ParseRevokedCerts(secret.Spec.GetData().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return revoked.NotExpired(now), nil
+}
+
+func (r RevokedCerts) IsRevoked(serial string) bool {
+	for _, cert := range r {
+		if cert.Serial == serial {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RevokedCerts) NotExpired(now time.Time) RevokedCerts {
+	var result RevokedCerts
+	for _, cert := range r {
+		if cert.ExpiresAt.After(now) {
+			result = append(result, cert)
+		}
+	}
+	return result
+}
+
+func (r RevokedCerts) IssuedBy(backend string) RevokedCerts {
+	var result RevokedCerts
+	for _, cert := range r {
+		if cert.Backend == backend {
+			result = append(result, cert)
+		}
+	}
+	return result
+}
+
+// Serials returns sorted serial numbers of revoked certificates.
+func (r RevokedCerts) Serials() []string {
+	var serials []string
+	for _, cert := range r {
+		serials = append(serials, cert.Serial)
+	}
+	sort.Strings(serials)
+	return serials
+}
+
+// RevocationListIssuer is implemented by managers that can sign a certificate revocation list with the key of the CA.
+// Envoy checks only the list of the CA that issued the certificate of the peer, but it rejects the peer if the list is missing,
+// therefore the lists are distributed only when every trusted backend of the Mesh implements this interface.
+type RevocationListIssuer interface {
+	// GenerateRevocationList returns PEM encoded revocation list of the CA that issues dataplane certificates.
+	GenerateRevocationList(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, revoked RevokedCerts) ([][]byte, error)
+}
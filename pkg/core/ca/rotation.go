@@ -0,0 +1,105 @@
+package ca
+
+import (
+	"sort"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// RotationPhase describes how far the rotation of the CA of a Mesh has progressed.
+type RotationPhase string
+
+const (
+	// NoRotation means that every connected dataplane has a certificate issued by the enabled backend.
+	NoRotation RotationPhase = ""
+	// RotationTrusting means that dataplanes receive the union of roots of the old and the new backend,
+	// while their certificates are still issued by the old backend.
+	RotationTrusting RotationPhase = "trusting"
+	// RotationReissuing means that every connected dataplane trusts the new backend, so certificates are reissued from it.
+	RotationReissuing RotationPhase = "reissuing"
+)
+
+// Rotation is the state of the rotation of the CA of a Mesh after `mtls.enabledBackend` was switched to a new backend.
+// It is not stored anywhere, instead it is derived from the Mesh and the statistics reported in MeshInsight.
+// Once every connected dataplane reports a certificate issued by the new backend, the rotation is over
+// and the roots of the old backends are no longer distributed.
+type Rotation struct {
+	Phase RotationPhase
+	// From is a list of old backends that still issued certificates of connected dataplanes.
+	From []string
+	// To is the enabled backend.
+	To string
+	// Connected is a number of connected dataplanes with a certificate.
+	Connected uint32
+	// Trusting is a number of connected dataplanes that trust the enabled backend.
+	Trusting uint32
+	// Reissued is a number of connected dataplanes with a certificate issued by the enabled backend.
+	Reissued uint32
+}
+
+// NewRotation computes the state of the rotation. Insight can be nil if it was not computed yet.
+func NewRotation(mtls *mesh_proto.Mesh_Mtls, insight *mesh_proto.MeshInsight) Rotation {
+	rotation := Rotation{
+		To: mtls.GetEnabledBackend(),
+	}
+	if rotation.To == "" {
+		return rotation
+	}
+
+	backends := map[string]bool{}
+	for _, backend := range mtls.GetBackends() {
+		backends[backend.GetName()] = true
+	}
+
+	for name, stat := range insight.GetMTLS().GetIssuedBackends() {
+		if name == "" {
+			continue
+		}
+		connected := connectedDataplanes(stat)
+		rotation.Connected += connected
+		if name == rotation.To {
+			rotation.Reissued = connected
+			continue
+		}
+		// the old backend might have been removed from the Mesh, in which case it can no longer take part in the rotation
+		if connected > 0 && backends[name] {
+			rotation.From = append(rotation.From, name)
+		}
+	}
+	if len(rotation.From) == 0 {
+		return rotation
+	}
+	sort.Strings(rotation.From)
+
+	rotation.Trusting = connectedDataplanes(insight.GetMTLS().GetSupportedBackends()[rotation.To])
+	if rotation.Trusting < rotation.Connected {
+		rotation.Phase = RotationTrusting
+	} else {
+		rotation.Phase = RotationReissuing
+	}
+	return rotation
+}
+
+func connectedDataplanes(stat *mesh_proto.MeshInsight_DataplaneStat) uint32 {
+	return stat.GetOnline() + stat.GetPartiallyDegraded()
+}
+
+// InProgress returns true if certificates of some of the connected dataplanes are still issued by an old backend.
+func (r Rotation) InProgress() bool {
+	return r.Phase != NoRotation
+}
+
+// IssuingBackend returns the backend that should issue certificates of dataplanes.
+// Certificates are issued by the old backend until every connected dataplane trusts the new one,
+// otherwise a dataplane with the new certificate would be rejected by dataplanes that trust only the old root.
+func (r Rotation) IssuingBackend() string {
+	if r.Phase == RotationTrusting {
+		return r.From[0]
+	}
+	return r.To
+}
+
+// TrustedBackends returns backends which roots should be trusted by dataplanes.
+func (r Rotation) TrustedBackends() []string {
+	return append([]string{r.To}, r.From...)
+}
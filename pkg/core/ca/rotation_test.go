@@ -0,0 +1,115 @@
+package ca_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/ca"
+)
+
+var _ = Describe("Rotation", func() {
+
+	mtls := &mesh_proto.Mesh_Mtls{
+		EnabledBackend: "ca-2",
+		Backends: []*mesh_proto.CertificateAuthorityBackend{
+			{Name: "ca-1", Type: "builtin"},
+			{Name: "ca-2", Type: "builtin"},
+		},
+	}
+
+	type testCase struct {
+		insight         *mesh_proto.MeshInsight
+		phase           ca.RotationPhase
+		issuingBackend  string
+		trustedBackends []string
+	}
+
+	DescribeTable("should compute the state of the rotation",
+		func(given testCase) {
+			// when
+			rotation := ca.NewRotation(mtls, given.insight)
+
+			// then
+			Expect(rotation.Phase).To(Equal(given.phase))
+			Expect(rotation.IssuingBackend()).To(Equal(given.issuingBackend))
+			Expect(rotation.TrustedBackends()).To(Equal(given.trustedBackends))
+		},
+		Entry("no insight", testCase{
+			insight:         nil,
+			phase:           ca.NoRotation,
+			issuingBackend:  "ca-2",
+			trustedBackends: []string{"ca-2"},
+		}),
+		Entry("every dataplane has a certificate of the enabled backend", testCase{
+			insight: &mesh_proto.MeshInsight{
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-2": {Total: 2, Online: 2},
+					},
+				},
+			},
+			phase:           ca.NoRotation,
+			issuingBackend:  "ca-2",
+			trustedBackends: []string{"ca-2"},
+		}),
+		Entry("only offline dataplanes have a certificate of the old backend", testCase{
+			insight: &mesh_proto.MeshInsight{
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 1, Offline: 1},
+						"ca-2": {Total: 2, Online: 2},
+					},
+				},
+			},
+			phase:           ca.NoRotation,
+			issuingBackend:  "ca-2",
+			trustedBackends: []string{"ca-2"},
+		}),
+		Entry("old backend was removed from the mesh", testCase{
+			insight: &mesh_proto.MeshInsight{
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-0": {Total: 2, Online: 2},
+					},
+				},
+			},
+			phase:           ca.NoRotation,
+			issuingBackend:  "ca-2",
+			trustedBackends: []string{"ca-2"},
+		}),
+		Entry("not every dataplane trusts the new backend", testCase{
+			insight: &mesh_proto.MeshInsight{
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 2, Online: 1, PartiallyDegraded: 1},
+					},
+					SupportedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 2, Online: 1, PartiallyDegraded: 1},
+						"ca-2": {Total: 1, Online: 1},
+					},
+				},
+			},
+			phase:           ca.RotationTrusting,
+			issuingBackend:  "ca-1",
+			trustedBackends: []string{"ca-2", "ca-1"},
+		}),
+		Entry("every dataplane trusts the new backend", testCase{
+			insight: &mesh_proto.MeshInsight{
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 1, Online: 1},
+						"ca-2": {Total: 1, Online: 1},
+					},
+					SupportedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 2, Online: 2},
+						"ca-2": {Total: 2, Online: 2},
+					},
+				},
+			},
+			phase:           ca.RotationReissuing,
+			issuingBackend:  "ca-2",
+			trustedBackends: []string{"ca-2", "ca-1"},
+		}),
+	)
+})
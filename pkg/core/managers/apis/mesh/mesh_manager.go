@@ -22,22 +22,25 @@ func NewMeshManager(
 	caManagers core_ca.Managers,
 	registry core_registry.TypeRegistry,
 	validator MeshValidator,
+	signingKeyAlgorithm string,
 ) core_manager.ResourceManager {
 	return &meshManager{
-		store:         store,
-		otherManagers: otherManagers,
-		caManagers:    caManagers,
-		registry:      registry,
-		meshValidator: validator,
+		store:               store,
+		otherManagers:       otherManagers,
+		caManagers:          caManagers,
+		registry:            registry,
+		meshValidator:       validator,
+		signingKeyAlgorithm: signingKeyAlgorithm,
 	}
 }
 
 type meshManager struct {
-	store         core_store.ResourceStore
-	otherManagers core_manager.ResourceManager
-	caManagers    core_ca.Managers
-	registry      core_registry.TypeRegistry
-	meshValidator MeshValidator
+	store               core_store.ResourceStore
+	otherManagers       core_manager.ResourceManager
+	caManagers          core_ca.Managers
+	registry            core_registry.TypeRegistry
+	meshValidator       MeshValidator
+	signingKeyAlgorithm string
 }
 
 func (m *meshManager) Get(ctx context.Context, resource core_model.Resource, fs ...core_store.GetOptionsFunc) error {
@@ -79,7 +82,7 @@ func (m *meshManager) Create(ctx context.Context, resource core_model.Resource,
 	if err := m.store.Create(ctx, mesh, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
 		return err
 	}
-	if err := defaults_mesh.EnsureDefaultMeshResources(ctx, m.otherManagers, opts.Name); err != nil {
+	if err := defaults_mesh.EnsureDefaultMeshResources(ctx, m.otherManagers, opts.Name, m.signingKeyAlgorithm); err != nil {
 		return err
 	}
 	return nil
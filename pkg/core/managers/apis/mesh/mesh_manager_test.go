@@ -15,7 +15,6 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
-	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secrets_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secrets_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/core/tokens"
@@ -24,6 +23,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/ca/provided"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	test_resources "github.com/kumahq/kuma/pkg/test/resources"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
@@ -37,7 +37,7 @@ var _ = Describe("Mesh Manager", func() {
 
 	BeforeEach(func() {
 		resStore = memory.NewStore()
-		secretManager = secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), cipher.None(), nil)
+		secretManager = secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), nil)
 		builtinCaManager = ca_builtin.NewBuiltinCaManager(secretManager)
 		providedCaManager := provided.NewProvidedCaManager(datasource.NewDataSourceLoader(secretManager))
 		caManagers := core_ca.Managers{
@@ -47,7 +47,7 @@ var _ = Describe("Mesh Manager", func() {
 
 		manager := manager.NewResourceManager(resStore)
 		validator := mesh.NewMeshValidator(caManagers, resStore)
-		resManager = mesh.NewMeshManager(resStore, manager, caManagers, test_resources.Global(), validator)
+		resManager = mesh.NewMeshManager(resStore, manager, caManagers, test_resources.Global(), validator, util_tls.RSAKeyAlgorithm)
 	})
 
 	Describe("Create()", func() {
@@ -262,7 +262,7 @@ var _ = Describe("Mesh Manager", func() {
 	})
 
 	Describe("Update()", func() {
-		It("should not allow to change CA when mTLS is enabled", func() {
+		It("should not allow to change CA when mTLS is enabled and the previous backend is removed", func() {
 			// given
 			meshName := "mesh-1"
 			resKey := model.ResourceKey{
@@ -299,12 +299,63 @@ var _ = Describe("Mesh Manager", func() {
 				Violations: []validators.Violation{
 					{
 						Field:   "mtls.enabledBackend",
-						Message: "Changing CA when mTLS is enabled is forbidden. Disable mTLS first and then change the CA",
+						Message: `Changing CA when mTLS is enabled requires the previous backend "builtin-1" to stay in mtls.backends until certificates of all dataplanes are reissued. Keep the backend or disable mTLS first and then change the CA`,
 					},
 				},
 			}))
 		})
 
+		It("should rotate CA when mTLS is enabled and the previous backend is kept", func() {
+			// given
+			resKey := model.ResourceKey{
+				Name: "mesh-1",
+			}
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "builtin-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "builtin-1",
+								Type: "builtin",
+							},
+						},
+					},
+				},
+			}
+			Expect(resManager.Create(context.Background(), &mesh, store.CreateBy(resKey))).To(Succeed())
+
+			// when the CA is switched to the new backend
+			mesh.Spec.Mtls.Backends = append(mesh.Spec.Mtls.Backends, &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-2",
+				Type: "builtin",
+			})
+			mesh.Spec.Mtls.EnabledBackend = "builtin-2"
+			err := resManager.Update(context.Background(), &mesh)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and the CA of the new backend is created
+			newRoot, err := builtinCaManager.GetRootCert(context.Background(), "mesh-1", mesh.Spec.Mtls.Backends[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newRoot).To(HaveLen(1))
+
+			// and dataplanes with certificates of the previous backend trust both roots while keeping the old certificate
+			actual := core_mesh.NewMeshResource()
+			Expect(resManager.Get(context.Background(), actual, store.GetBy(resKey))).To(Succeed())
+			rotation := core_ca.NewRotation(actual.Spec.GetMtls(), &mesh_proto.MeshInsight{
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"builtin-1": {Online: 2},
+					},
+				},
+			})
+			Expect(rotation.Phase).To(Equal(core_ca.RotationTrusting))
+			Expect(rotation.IssuingBackend()).To(Equal("builtin-1"))
+			Expect(rotation.TrustedBackends()).To(Equal([]string{"builtin-2", "builtin-1"}))
+		})
+
 		It("should allow to change CA when mTLS is disabled", func() {
 			// given
 			meshName := "mesh-1"
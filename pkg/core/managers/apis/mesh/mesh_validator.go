@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 
@@ -87,10 +88,19 @@ func ValidateNoActiveDP(ctx context.Context, name string, store core_store.Resou
 	return nil
 }
 
+// validateMTLSBackendChange allows to switch the CA when mTLS is enabled only if the previous backend stays in the Mesh,
+// so dataplanes can trust both roots until every certificate is reissued by the new backend (see core_ca.Rotation).
 func (m *meshValidator) validateMTLSBackendChange(previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) error {
 	verr := validators.ValidationError{}
-	if previousMesh.MTLSEnabled() && newMesh.MTLSEnabled() && previousMesh.Spec.GetMtls().GetEnabledBackend() != newMesh.Spec.GetMtls().GetEnabledBackend() {
-		verr.AddViolation("mtls.enabledBackend", "Changing CA when mTLS is enabled is forbidden. Disable mTLS first and then change the CA")
+	previousBackend := previousMesh.Spec.GetMtls().GetEnabledBackend()
+	if !previousMesh.MTLSEnabled() || !newMesh.MTLSEnabled() || previousBackend == newMesh.Spec.GetMtls().GetEnabledBackend() {
+		return nil
 	}
+	for _, backend := range newMesh.Spec.GetMtls().GetBackends() {
+		if backend.GetName() == previousBackend {
+			return nil
+		}
+	}
+	verr.AddViolation("mtls.enabledBackend", fmt.Sprintf("Changing CA when mTLS is enabled requires the previous backend %q to stay in mtls.backends until certificates of all dataplanes are reissued. Keep the backend or disable mTLS first and then change the CA", previousBackend))
 	return verr.OrNil()
 }
@@ -0,0 +1,150 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// Types of tokens that can be referenced in rules of AccessRole.
+// Generating a token requires the "create" verb.
+const (
+	DataplaneTokenType   model.ResourceType = "DataplaneToken"
+	ZoneIngressTokenType model.ResourceType = "ZoneIngressToken"
+	ZoneTokenType        model.ResourceType = "ZoneToken"
+	UserTokenType        model.ResourceType = "UserToken"
+)
+
+// Authorizer checks whether the user was granted a verb on a type of resources in a mesh.
+// Mesh is empty for global resources and when operation spans all meshes.
+type Authorizer interface {
+	Authorize(user user.User, verb string, resourceType model.ResourceType, mesh string) error
+}
+
+type authorizer struct {
+	resManager manager.ReadOnlyResourceManager
+	usernames  map[string]bool
+	groups     map[string]bool
+}
+
+var _ Authorizer = &authorizer{}
+
+func NewAuthorizer(resManager manager.ReadOnlyResourceManager, cfg config_access.RBACAccessConfig) Authorizer {
+	a := &authorizer{
+		resManager: resManager,
+		usernames:  map[string]bool{},
+		groups:     map[string]bool{},
+	}
+	for _, usr := range cfg.Admin.Users {
+		a.usernames[usr] = true
+	}
+	for _, group := range cfg.Admin.Groups {
+		a.groups[group] = true
+	}
+	return a
+}
+
+func (a *authorizer) Authorize(u user.User, verb string, resourceType model.ResourceType, mesh string) error {
+	if a.isAdmin(u) {
+		return nil
+	}
+	roles, err := a.boundRoles(u)
+	if err != nil {
+		return err
+	}
+	if len(roles) > 0 {
+		roleList := &system.AccessRoleResourceList{}
+		if err := a.resManager.List(context.Background(), roleList); err != nil {
+			return err
+		}
+		for _, role := range roleList.Items {
+			if !roles[role.GetMeta().GetName()] {
+				continue
+			}
+			for _, rule := range role.Spec.GetRules() {
+				if contains(rule.GetVerbs(), verb) &&
+					contains(rule.GetTypes(), string(resourceType)) &&
+					(len(rule.GetMeshes()) == 0 || containsMesh(rule.GetMeshes(), mesh)) {
+					return nil
+				}
+			}
+		}
+	}
+	reason := fmt.Sprintf("user %q cannot %s the resource of type %q", u.String(), verb, resourceType)
+	if mesh != "" {
+		reason += fmt.Sprintf(" in mesh %q", mesh)
+	}
+	return &access.AccessDeniedError{Reason: reason}
+}
+
+func (a *authorizer) isAdmin(u user.User) bool {
+	if a.usernames[u.Name] {
+		return true
+	}
+	for _, group := range u.Groups {
+		if a.groups[group] {
+			return true
+		}
+	}
+	return false
+}
+
+// boundRoles returns names of the roles bound to the user or to any of its groups.
+func (a *authorizer) boundRoles(u user.User) (map[string]bool, error) {
+	bindings := &system.AccessRoleBindingResourceList{}
+	if err := a.resManager.List(context.Background(), bindings); err != nil {
+		return nil, err
+	}
+	roles := map[string]bool{}
+	for _, binding := range bindings.Items {
+		bound := contains(binding.Spec.GetUsers(), u.Name)
+		for _, group := range u.Groups {
+			if contains(binding.Spec.GetGroups(), group) {
+				bound = true
+			}
+		}
+		if !bound {
+			continue
+		}
+		for _, role := range binding.Spec.GetRoles() {
+			roles[role] = true
+		}
+	}
+	return roles, nil
+}
+
+// contains returns true if values contain the value or the wildcard.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == system.Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// containsMesh returns true if meshes contain the mesh or the wildcard.
+// The wildcard matches every mesh, but not global resources and operations that span all meshes,
+// otherwise a role limited to meshes could change AccessRoleBindings, GlobalSecrets or generate zone and user tokens.
+func containsMesh(meshes []string, mesh string) bool {
+	return mesh != "" && contains(meshes, mesh)
+}
+
+// MeshOf returns a mesh in which the resource resides from the perspective of access control.
+// Mesh resource is treated as a part of the mesh that it defines.
+func MeshOf(key model.ResourceKey, desc model.ResourceTypeDescriptor) string {
+	if desc.Name == core_mesh.MeshType {
+		return key.Name
+	}
+	if desc.Scope == model.ScopeGlobal {
+		return ""
+	}
+	return key.Mesh
+}
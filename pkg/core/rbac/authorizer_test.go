@@ -0,0 +1,219 @@
+package rbac_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Authorizer", func() {
+
+	var authorizer rbac.Authorizer
+	teamA := user.User{Name: "john.doe@example.com", Groups: []string{"team-a"}}
+	viewer := user.User{Name: "jane.doe@example.com", Groups: []string{"mesh-system:authenticated"}}
+	meshOperator := user.User{Name: "mesh.operator@example.com"}
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		authorizer = rbac.NewAuthorizer(manager.NewResourceManager(resourceStore), config_access.RBACAccessConfig{
+			Admin: config_access.AdminRBACAccessConfig{
+				Groups: []string{"mesh-system:admin"},
+			},
+		})
+
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleResource{
+			Spec: &system_proto.AccessRole{
+				Rules: []*system_proto.AccessRole_Rule{
+					{
+						Types:  []string{string(core_mesh.TrafficPermissionType), string(core_mesh.TrafficRouteType)},
+						Verbs:  []string{system.Wildcard},
+						Meshes: []string{"team-a"},
+					},
+					{
+						Types:  []string{string(rbac.DataplaneTokenType)},
+						Verbs:  []string{system.VerbCreate},
+						Meshes: []string{"team-a"},
+					},
+				},
+			},
+		}, store.CreateByKey("team-a-owner", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleResource{
+			Spec: &system_proto.AccessRole{
+				Rules: []*system_proto.AccessRole_Rule{
+					{
+						Types: []string{system.Wildcard},
+						Verbs: []string{system.VerbGet, system.VerbList},
+					},
+				},
+			},
+		}, store.CreateByKey("viewer", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleResource{
+			Spec: &system_proto.AccessRole{
+				Rules: []*system_proto.AccessRole_Rule{
+					{
+						Types:  []string{system.Wildcard},
+						Verbs:  []string{system.Wildcard},
+						Meshes: []string{system.Wildcard},
+					},
+				},
+			},
+		}, store.CreateByKey("mesh-operator", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleBindingResource{
+			Spec: &system_proto.AccessRoleBinding{
+				Groups: []string{"team-a"},
+				Roles:  []string{"team-a-owner"},
+			},
+		}, store.CreateByKey("team-a", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleBindingResource{
+			Spec: &system_proto.AccessRoleBinding{
+				Users: []string{viewer.Name},
+				Roles: []string{"viewer"},
+			},
+		}, store.CreateByKey("viewers", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleBindingResource{
+			Spec: &system_proto.AccessRoleBinding{
+				Users: []string{meshOperator.Name},
+				Roles: []string{"mesh-operator"},
+			},
+		}, store.CreateByKey("mesh-operators", model.NoMesh))).To(Succeed())
+	})
+
+	type testCase struct {
+		user         user.User
+		verb         string
+		resourceType model.ResourceType
+		mesh         string
+	}
+
+	DescribeTable("should allow",
+		func(given testCase) {
+			Expect(authorizer.Authorize(given.user, given.verb, given.resourceType, given.mesh)).To(Succeed())
+		},
+		Entry("admin to do anything", testCase{
+			user:         user.Admin,
+			verb:         system.VerbDelete,
+			resourceType: system.SecretType,
+			mesh:         "default",
+		}),
+		Entry("verb granted by wildcard in bound mesh", testCase{
+			user:         teamA,
+			verb:         system.VerbUpdate,
+			resourceType: core_mesh.TrafficRouteType,
+			mesh:         "team-a",
+		}),
+		Entry("generating token in bound mesh", testCase{
+			user:         teamA,
+			verb:         system.VerbCreate,
+			resourceType: rbac.DataplaneTokenType,
+			mesh:         "team-a",
+		}),
+		Entry("role with no meshes in any mesh", testCase{
+			user:         viewer,
+			verb:         system.VerbGet,
+			resourceType: core_mesh.DataplaneType,
+			mesh:         "team-b",
+		}),
+		Entry("role with no meshes for global resources", testCase{
+			user:         viewer,
+			verb:         system.VerbList,
+			resourceType: core_mesh.ZoneIngressType,
+			mesh:         "",
+		}),
+		Entry("role with wildcard mesh in any mesh", testCase{
+			user:         meshOperator,
+			verb:         system.VerbDelete,
+			resourceType: system.SecretType,
+			mesh:         "team-b",
+		}),
+	)
+
+	DescribeTable("should deny",
+		func(given testCase) {
+			err := authorizer.Authorize(given.user, given.verb, given.resourceType, given.mesh)
+			Expect(err).To(MatchError(&access.AccessDeniedError{}))
+		},
+		Entry("type in other mesh", testCase{
+			user:         teamA,
+			verb:         system.VerbCreate,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "team-b",
+		}),
+		Entry("type in all meshes", testCase{
+			user:         teamA,
+			verb:         system.VerbList,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "",
+		}),
+		Entry("type that is not granted", testCase{
+			user:         teamA,
+			verb:         system.VerbCreate,
+			resourceType: core_mesh.HealthCheckType,
+			mesh:         "team-a",
+		}),
+		Entry("verb that is not granted", testCase{
+			user:         viewer,
+			verb:         system.VerbDelete,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "team-a",
+		}),
+		Entry("role with wildcard mesh to change access role bindings", testCase{
+			user:         meshOperator,
+			verb:         system.VerbCreate,
+			resourceType: system.AccessRoleBindingType,
+			mesh:         rbac.MeshOf(model.ResourceKey{Name: "ops"}, system.AccessRoleBindingResourceTypeDescriptor),
+		}),
+		Entry("role with wildcard mesh to change global secrets", testCase{
+			user:         meshOperator,
+			verb:         system.VerbUpdate,
+			resourceType: system.GlobalSecretType,
+			mesh:         rbac.MeshOf(model.ResourceKey{Name: "signing-key"}, system.GlobalSecretResourceTypeDescriptor),
+		}),
+		Entry("role with wildcard mesh to generate zone token", testCase{
+			user:         meshOperator,
+			verb:         system.VerbCreate,
+			resourceType: rbac.ZoneTokenType,
+			mesh:         "",
+		}),
+		Entry("role with wildcard mesh to generate user token", testCase{
+			user:         meshOperator,
+			verb:         system.VerbCreate,
+			resourceType: rbac.UserTokenType,
+			mesh:         "",
+		}),
+		Entry("user without bindings", testCase{
+			user:         user.Anonymous,
+			verb:         system.VerbGet,
+			resourceType: core_mesh.MeshType,
+			mesh:         "default",
+		}),
+	)
+
+	It("should explain why access is denied", func() {
+		// when
+		err := authorizer.Authorize(teamA, system.VerbDelete, core_mesh.TrafficPermissionType, "team-b")
+
+		// then
+		Expect(err).To(MatchError(`access denied: user "john.doe@example.com/team-a" cannot delete the resource of type "TrafficPermission" in mesh "team-b"`))
+	})
+
+	It("should treat Mesh as a part of the mesh it defines", func() {
+		// when
+		mesh := rbac.MeshOf(model.ResourceKey{Name: "team-a"}, core_mesh.NewMeshResource().Descriptor())
+
+		// then
+		Expect(mesh).To(Equal("team-a"))
+	})
+})
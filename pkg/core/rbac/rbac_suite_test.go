@@ -0,0 +1,11 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestRBAC(t *testing.T) {
+	test.RunSpecs(t, "RBAC Suite")
+}
@@ -42,7 +42,7 @@ func (a *adminResourceAccess) ValidateDelete(key model.ResourceKey, spec model.R
 	return a.validateAdminAccess(user, descriptor)
 }
 
-func (a *adminResourceAccess) ValidateList(descriptor model.ResourceTypeDescriptor, user user.User) error {
+func (a *adminResourceAccess) ValidateList(_ string, descriptor model.ResourceTypeDescriptor, user user.User) error {
 	return a.validateAdminAccess(user, descriptor)
 }
 
@@ -50,6 +50,10 @@ func (a *adminResourceAccess) ValidateGet(key model.ResourceKey, descriptor mode
 	return a.validateAdminAccess(user, descriptor)
 }
 
+func (a *adminResourceAccess) ValidateInspect(key model.ResourceKey, descriptor model.ResourceTypeDescriptor, user user.User) error {
+	return a.validateAdminAccess(user, descriptor)
+}
+
 func (r *adminResourceAccess) validateAdminAccess(u user.User, descriptor model.ResourceTypeDescriptor) error {
 	if !descriptor.AdminOnly {
 		return nil
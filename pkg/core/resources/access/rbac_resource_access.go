@@ -0,0 +1,47 @@
+package access
+
+import (
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacResourceAccess struct {
+	authorizer rbac.Authorizer
+}
+
+func NewRBACResourceAccess(authorizer rbac.Authorizer) ResourceAccess {
+	return &rbacResourceAccess{
+		authorizer: authorizer,
+	}
+}
+
+var _ ResourceAccess = &rbacResourceAccess{}
+
+func (r *rbacResourceAccess) ValidateCreate(key model.ResourceKey, _ model.ResourceSpec, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbCreate, desc.Name, rbac.MeshOf(key, desc))
+}
+
+func (r *rbacResourceAccess) ValidateUpdate(key model.ResourceKey, _ model.ResourceSpec, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbUpdate, desc.Name, rbac.MeshOf(key, desc))
+}
+
+func (r *rbacResourceAccess) ValidateDelete(key model.ResourceKey, _ model.ResourceSpec, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbDelete, desc.Name, rbac.MeshOf(key, desc))
+}
+
+func (r *rbacResourceAccess) ValidateList(mesh string, desc model.ResourceTypeDescriptor, user user.User) error {
+	if desc.Scope == model.ScopeGlobal {
+		mesh = ""
+	}
+	return r.authorizer.Authorize(user, system.VerbList, desc.Name, mesh)
+}
+
+func (r *rbacResourceAccess) ValidateGet(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbGet, desc.Name, rbac.MeshOf(key, desc))
+}
+
+func (r *rbacResourceAccess) ValidateInspect(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbInspect, desc.Name, rbac.MeshOf(key, desc))
+}
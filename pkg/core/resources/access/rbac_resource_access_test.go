@@ -0,0 +1,80 @@
+package access_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("RBAC Resource Access", func() {
+	var resourceAccess resources_access.ResourceAccess
+	teamA := user.User{Name: "john doe", Groups: []string{"team-a"}}
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		resourceAccess = resources_access.NewRBACResourceAccess(
+			rbac.NewAuthorizer(manager.NewResourceManager(resourceStore), config_access.RBACAccessConfig{}),
+		)
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleResource{
+			Spec: &system_proto.AccessRole{
+				Rules: []*system_proto.AccessRole_Rule{{
+					Types:  []string{string(mesh.MeshType), string(mesh.CircuitBreakerType)},
+					Verbs:  []string{system.VerbGet, system.VerbList, system.VerbUpdate, system.VerbInspect},
+					Meshes: []string{"team-a"},
+				}},
+			},
+		}, store.CreateByKey("team-a", model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), &system.AccessRoleBindingResource{
+			Spec: &system_proto.AccessRoleBinding{
+				Groups: []string{"team-a"},
+				Roles:  []string{"team-a"},
+			},
+		}, store.CreateByKey("team-a", model.NoMesh))).To(Succeed())
+	})
+
+	It("should allow granted verbs in the mesh", func() {
+		desc := mesh.NewCircuitBreakerResource().Descriptor()
+		key := model.ResourceKey{Mesh: "team-a", Name: "cb-1"}
+
+		Expect(resourceAccess.ValidateGet(key, desc, teamA)).To(Succeed())
+		Expect(resourceAccess.ValidateList("team-a", desc, teamA)).To(Succeed())
+		Expect(resourceAccess.ValidateUpdate(key, nil, desc, teamA)).To(Succeed())
+		Expect(resourceAccess.ValidateInspect(key, desc, teamA)).To(Succeed())
+	})
+
+	It("should deny verbs that are not granted", func() {
+		desc := mesh.NewCircuitBreakerResource().Descriptor()
+		key := model.ResourceKey{Mesh: "team-a", Name: "cb-1"}
+
+		Expect(resourceAccess.ValidateCreate(key, nil, desc, teamA)).ToNot(Succeed())
+		Expect(resourceAccess.ValidateDelete(key, nil, desc, teamA)).ToNot(Succeed())
+	})
+
+	It("should deny access to other meshes", func() {
+		desc := mesh.NewCircuitBreakerResource().Descriptor()
+
+		Expect(resourceAccess.ValidateGet(model.ResourceKey{Mesh: "team-b", Name: "cb-1"}, desc, teamA)).ToNot(Succeed())
+		Expect(resourceAccess.ValidateList("", desc, teamA)).To(MatchError(`access denied: user "john doe/team-a" cannot list the resource of type "CircuitBreaker"`))
+	})
+
+	It("should scope Mesh resource to the mesh it defines", func() {
+		desc := mesh.NewMeshResource().Descriptor()
+
+		Expect(resourceAccess.ValidateGet(model.ResourceKey{Name: "team-a"}, desc, teamA)).To(Succeed())
+		Expect(resourceAccess.ValidateGet(model.ResourceKey{Name: "team-b"}, desc, teamA)).ToNot(Succeed())
+		Expect(resourceAccess.ValidateList("", desc, teamA)).ToNot(Succeed())
+	})
+})
@@ -125,10 +125,47 @@ func (d *DataplaneResource) GetPrometheusEndpoint(mesh *MeshResource) (*mesh_pro
 			return nil, err
 		}
 		proto.Merge(&cfg, &dpCfg)
+		cfg.Aggregate = mergeAggregateMetrics(cfg.Aggregate)
 	}
 	return &cfg, nil
 }
 
+func (d *DataplaneResource) GetOpenTelemetryMetrics(mesh *MeshResource) (*mesh_proto.OpenTelemetryMetricsBackendConfig, error) {
+	if d == nil || mesh == nil || mesh.Meta.GetName() != d.Meta.GetMesh() || !mesh.HasOpenTelemetryMetricsEnabled() {
+		return nil, nil
+	}
+	cfg := mesh_proto.OpenTelemetryMetricsBackendConfig{}
+	strCfg := mesh.GetEnabledMetricsBackend().Conf
+	if err := util_proto.ToTyped(strCfg, &cfg); err != nil {
+		return nil, err
+	}
+
+	if d.Spec.GetMetrics().GetType() == mesh_proto.MetricsOpenTelemetryType {
+		dpCfg := mesh_proto.OpenTelemetryMetricsBackendConfig{}
+		if err := util_proto.ToTyped(d.Spec.Metrics.Conf, &dpCfg); err != nil {
+			return nil, err
+		}
+		proto.Merge(&cfg, &dpCfg)
+	}
+	return &cfg, nil
+}
+
+// mergeAggregateMetrics deduplicates application metrics endpoints by name.
+// A later endpoint (defined on a Dataplane) overrides an earlier one (defined on a Mesh).
+func mergeAggregateMetrics(aggregate []*mesh_proto.PrometheusAggregateMetricsConfig) []*mesh_proto.PrometheusAggregateMetricsConfig {
+	var merged []*mesh_proto.PrometheusAggregateMetricsConfig
+	indexByName := map[string]int{}
+	for _, app := range aggregate {
+		if i, ok := indexByName[app.Name]; ok {
+			merged[i] = app
+			continue
+		}
+		indexByName[app.Name] = len(merged)
+		merged = append(merged, app)
+	}
+	return merged
+}
+
 func (d *DataplaneResource) GetIP() string {
 	if d == nil {
 		return ""
@@ -2,6 +2,7 @@ package mesh_test
 
 import (
 	"net"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -380,6 +381,146 @@ var _ = Describe("Dataplane", func() {
 					Path: "/even-more-non-standard-path",
 				},
 			}),
+			Entry("dataplane.metrics.prometheus.aggregate overrides mesh.metrics.prometheus.aggregate by name", testCase{
+				dataplaneName: "backend-01",
+				dataplaneMesh: "demo",
+				dataplaneSpec: `
+                metrics:
+                  type: prometheus
+                  conf:
+                    aggregate:
+                    - name: app
+                      port: 8080
+                      path: /stats
+                    - name: sidecar
+                      enabled: false
+                    - name: worker
+                      port: 9090
+`,
+				meshName: "demo",
+				meshSpec: `
+                metrics:
+                  enabledBackend: prometheus-1
+                  backends:
+                  - name: prometheus-1
+                    type: prometheus
+                    conf:
+                      port: 1234
+                      path: /non-standard-path
+                      aggregate:
+                      - name: app
+                        port: 3000
+                      - name: sidecar
+                        port: 3001
+`,
+				expected: &mesh_proto.PrometheusMetricsBackendConfig{
+					Port: 1234,
+					Path: "/non-standard-path",
+					Aggregate: []*mesh_proto.PrometheusAggregateMetricsConfig{
+						{
+							Name: "app",
+							Port: 8080,
+							Path: "/stats",
+						},
+						{
+							Name:    "sidecar",
+							Enabled: util_proto.Bool(false),
+						},
+						{
+							Name: "worker",
+							Port: 9090,
+						},
+					},
+				},
+			}),
+		)
+	})
+
+	Describe("GetOpenTelemetryMetrics()", func() {
+
+		type testCase struct {
+			dataplaneSpec string
+			meshSpec      string
+			expected      *mesh_proto.OpenTelemetryMetricsBackendConfig
+		}
+
+		DescribeTable("should correctly determine effective OpenTelemetry config for given Dataplane and Mesh",
+			func(given testCase) {
+				// given
+				dataplane := &DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: &mesh_proto.Dataplane{},
+				}
+				Expect(util_proto.FromYAML([]byte(given.dataplaneSpec), dataplane.Spec)).To(Succeed())
+
+				// and
+				mesh := &MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "demo",
+					},
+					Spec: &mesh_proto.Mesh{},
+				}
+				Expect(util_proto.FromYAML([]byte(given.meshSpec), mesh.Spec)).To(Succeed())
+
+				// then
+				cfg, err := dataplane.GetOpenTelemetryMetrics(mesh)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cfg).To(MatchProto(given.expected))
+			},
+			Entry("mesh.metrics.opentelemetry == nil", testCase{
+				meshSpec: `
+                metrics:
+                  enabledBackend: prometheus-1
+                  backends:
+                  - name: prometheus-1
+                    type: prometheus
+`,
+				expected: nil,
+			}),
+			Entry("dataplane.metrics.opentelemetry == nil && mesh.metrics.opentelemetry != nil", testCase{
+				meshSpec: `
+                metrics:
+                  enabledBackend: otel-1
+                  backends:
+                  - name: otel-1
+                    type: opentelemetry
+                    conf:
+                      endpoint: otel-collector:4317
+                      interval: 30s
+`,
+				expected: &mesh_proto.OpenTelemetryMetricsBackendConfig{
+					Endpoint: "otel-collector:4317",
+					Interval: util_proto.Duration(30 * time.Second),
+				},
+			}),
+			Entry("dataplane.metrics.opentelemetry != nil && mesh.metrics.opentelemetry != nil", testCase{
+				dataplaneSpec: `
+                metrics:
+                  type: opentelemetry
+                  conf:
+                    filter: ^cluster
+                    usedOnly: true
+`,
+				meshSpec: `
+                metrics:
+                  enabledBackend: otel-1
+                  backends:
+                  - name: otel-1
+                    type: opentelemetry
+                    conf:
+                      endpoint: otel-collector:4317
+                      interval: 30s
+`,
+				expected: &mesh_proto.OpenTelemetryMetricsBackendConfig{
+					Endpoint: "otel-collector:4317",
+					Interval: util_proto.Duration(30 * time.Second),
+					Filter:   "^cluster",
+					UsedOnly: util_proto.Bool(true),
+				},
+			}),
 		)
 	})
 
@@ -67,9 +67,20 @@ func (d *DataplaneResource) Validate() error {
 		err.Add(validateProbes(d.Spec.GetProbes()))
 	}
 
+	err.Add(validateDataplaneMetrics(d.Spec.GetMetrics()))
+
 	return err.OrNil()
 }
 
+// validateDataplaneMetrics validates the configuration of metrics that overrides the one from the Mesh.
+func validateDataplaneMetrics(metrics *mesh_proto.MetricsBackend) validators.ValidationError {
+	var err validators.ValidationError
+	if metrics.GetType() == mesh_proto.MetricsPrometheusType {
+		err.AddErrorAt(validators.RootedAt("metrics").Field("conf"), validatePrometheus(metrics.GetConf()))
+	}
+	return err
+}
+
 // For networking section validation we need to take into account our legacy model.
 // Legacy model is detected by having interface defined on inbound listeners.
 // We do not allow networking.address with the old format. Instead, we recommend switching to the new format.
@@ -365,6 +365,33 @@ var _ = Describe("Dataplane", func() {
                 - field: networking.address
                   message:  address has to be valid IP address or domain name`,
 		}),
+		Entry("metrics: invalid aggregate entries", testCase{
+			dataplane: `
+                type: Dataplane
+                name: dp-1
+                mesh: default
+                networking:
+                  address: 192.168.0.1
+                  inbound:
+                    - port: 8080
+                      tags:
+                        kuma.io/service: backend
+                metrics:
+                  type: prometheus
+                  conf:
+                    aggregate:
+                    - name: app
+                      address: ..>_<..
+                      port: 0
+                    - name: disabled
+                      enabled: false`,
+			expected: `
+                violations:
+                - field: metrics.conf.aggregate[0].port
+                  message: port must be in the range [1, 65535]
+                - field: metrics.conf.aggregate[0].address
+                  message: has to be valid IP address or domain name`,
+		}),
 		Entry("networking: both inbounds and gateway are defined", testCase{
 			dataplane: `
                 type: Dataplane
@@ -14,6 +14,10 @@ func (m *MeshResource) HasPrometheusMetricsEnabled() bool {
 	return m != nil && m.GetEnabledMetricsBackend().GetType() == mesh_proto.MetricsPrometheusType
 }
 
+func (m *MeshResource) HasOpenTelemetryMetricsEnabled() bool {
+	return m != nil && m.GetEnabledMetricsBackend().GetType() == mesh_proto.MetricsOpenTelemetryType
+}
+
 func (m *MeshResource) GetEnabledMetricsBackend() *mesh_proto.MetricsBackend {
 	return m.GetMetricsBackend(m.Spec.GetMetrics().GetEnabledBackend())
 }
@@ -1,10 +1,14 @@
 package mesh
 
 import (
+	"encoding/pem"
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
+	"strings"
 
+	"github.com/asaskevich/govalidator"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -13,7 +17,9 @@ import (
 	"github.com/kumahq/kuma/pkg/util/proto"
 )
 
-var AllowedMTLSBackends = 1
+// AllowedMTLSBackends is a number of CA backends in the Mesh. Two backends are needed to rotate the CA,
+// the old one is kept until every dataplane receives a certificate issued by the new one.
+var AllowedMTLSBackends = 2
 
 func (m *MeshResource) Validate() error {
 	var verr validators.ValidationError
@@ -117,13 +123,23 @@ func validateLoggingBackend(backend *mesh_proto.LoggingBackend) validators.Valid
 	if err := accesslog.ValidateFormat(backend.Format); err != nil {
 		verr.AddViolation("format", err.Error())
 	}
+	if len(backend.JsonFormat) > 0 {
+		if backend.Format != "" {
+			verr.AddViolation("jsonFormat", "cannot be defined together with format")
+		}
+		if err := accesslog.ValidateJsonFormat(backend.JsonFormat); err != nil {
+			verr.AddViolation("jsonFormat", err.Error())
+		}
+	}
 	switch backend.GetType() {
 	case mesh_proto.LoggingFileType:
 		verr.AddError("config", validateLoggingFile(backend.Conf))
 	case mesh_proto.LoggingTcpType:
 		verr.AddError("config", validateLoggingTcp(backend.Conf))
+	case mesh_proto.LoggingOtelType:
+		verr.AddError("config", validateLoggingOtel(backend.Conf))
 	default:
-		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q", mesh_proto.LoggingTcpType, mesh_proto.LoggingFileType))
+		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q, %q", mesh_proto.LoggingTcpType, mesh_proto.LoggingFileType, mesh_proto.LoggingOtelType))
 	}
 	return verr
 }
@@ -146,6 +162,24 @@ func validateLoggingTcp(cfgStr *structpb.Struct) validators.ValidationError {
 	return verr
 }
 
+func validateLoggingOtel(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.OtelLoggingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+		return verr
+	}
+	host, port, err := net.SplitHostPort(cfg.Address)
+	if host == "" || port == "" || err != nil {
+		verr.AddViolation("address", "has to be in format of HOST:PORT")
+	}
+	return verr
+}
+
 func validateLoggingFile(cfgStr *structpb.Struct) validators.ValidationError {
 	var verr validators.ValidationError
 	cfg := mesh_proto.FileLoggingBackendConfig{}
@@ -189,8 +223,10 @@ func validateTracingBackend(backend *mesh_proto.TracingBackend) validators.Valid
 		verr.AddError("config", validateZipkin(backend.Conf))
 	case mesh_proto.TracingDatadogType:
 		verr.AddError("config", validateDatadog(backend.Conf))
+	case mesh_proto.TracingOpenTelemetryType:
+		verr.AddError("config", validateOpenTelemetryTracing(backend.Conf))
 	default:
-		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q", mesh_proto.TracingZipkinType, mesh_proto.TracingDatadogType))
+		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q, %q", mesh_proto.TracingZipkinType, mesh_proto.TracingDatadogType, mesh_proto.TracingOpenTelemetryType))
 	}
 	return verr
 }
@@ -211,6 +247,28 @@ func validateDatadog(cfgStr *structpb.Struct) validators.ValidationError {
 	return verr
 }
 
+func validateOpenTelemetryTracing(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.OpenTelemetryTracingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+
+	switch {
+	case cfg.Service == "" && cfg.Address == "":
+		verr.AddViolation("", "either service or address has to be defined")
+	case cfg.Service != "" && cfg.Address != "":
+		verr.AddViolation("", "service and address cannot be defined at the same time")
+	case cfg.Address != "":
+		host, port, err := net.SplitHostPort(cfg.Address)
+		if host == "" || port == "" || err != nil {
+			verr.AddViolation("address", "has to be in format of HOST:PORT")
+		}
+	}
+	return verr
+}
+
 func validateZipkin(cfgStr *structpb.Struct) validators.ValidationError {
 	var verr validators.ValidationError
 	cfg := mesh_proto.ZipkinTracingBackendConfig{}
@@ -244,8 +302,13 @@ func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
 		if usedNames[backend.Name] {
 			verr.AddViolationAt(validators.RootedAt("backends").Index(i).Field("name"), fmt.Sprintf("%q name is already used for another backend", backend.Name))
 		}
-		if backend.GetType() != mesh_proto.MetricsPrometheusType {
-			verr.AddViolationAt(validators.RootedAt("backends").Index(i).Field("type"), fmt.Sprintf("unknown backend type. Available backends: %q", mesh_proto.MetricsPrometheusType))
+		switch backend.GetType() {
+		case mesh_proto.MetricsPrometheusType:
+			verr.AddErrorAt(validators.RootedAt("backends").Index(i).Field("conf"), validatePrometheus(backend.Conf))
+		case mesh_proto.MetricsOpenTelemetryType:
+			verr.AddErrorAt(validators.RootedAt("backends").Index(i).Field("conf"), validateOpenTelemetryMetrics(backend.Conf))
+		default:
+			verr.AddViolationAt(validators.RootedAt("backends").Index(i).Field("type"), fmt.Sprintf("unknown backend type. Available backends: %q, %q", mesh_proto.MetricsPrometheusType, mesh_proto.MetricsOpenTelemetryType))
 		}
 		usedNames[backend.Name] = true
 	}
@@ -255,6 +318,63 @@ func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
 	return verr
 }
 
+func validatePrometheus(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.PrometheusMetricsBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	usedNames := map[string]bool{}
+	for i, app := range cfg.Aggregate {
+		path := validators.RootedAt("aggregate").Index(i)
+		if app.Name == "" {
+			verr.AddViolationAt(path.Field("name"), "cannot be empty")
+		} else if usedNames[app.Name] {
+			verr.AddViolationAt(path.Field("name"), fmt.Sprintf("%q name is already used for another application", app.Name))
+		}
+		usedNames[app.Name] = true
+		if app.GetEnabled() == nil || app.GetEnabled().GetValue() {
+			verr.Add(ValidatePort(path.Field("port"), app.Port))
+		}
+		if app.Address != "" && !govalidator.IsIP(app.Address) && !govalidator.IsDNSName(app.Address) {
+			verr.AddViolationAt(path.Field("address"), "has to be valid IP address or domain name")
+		}
+		if app.Path != "" && !strings.HasPrefix(app.Path, "/") {
+			verr.AddViolationAt(path.Field("path"), "has to start with /")
+		}
+	}
+	return verr
+}
+
+func validateOpenTelemetryMetrics(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.OpenTelemetryMetricsBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Endpoint == "" {
+		verr.AddViolation("endpoint", "cannot be empty")
+	} else if host, port, err := net.SplitHostPort(cfg.Endpoint); host == "" || port == "" || err != nil {
+		verr.AddViolation("endpoint", "has to be in format of HOST:PORT")
+	}
+	if cfg.Interval != nil {
+		verr.Add(ValidateDuration(validators.RootedAt("interval"), cfg.Interval))
+	}
+	if cfg.Filter != "" {
+		if _, err := regexp.Compile(cfg.Filter); err != nil {
+			verr.AddViolation("filter", fmt.Sprintf("has to be a valid regex: %s", err.Error()))
+		}
+	}
+	if cfg.Tls.GetCaCert() != "" {
+		if block, _ := pem.Decode([]byte(cfg.Tls.GetCaCert())); block == nil {
+			verr.AddViolation("tls.caCert", "has to be a PEM encoded certificate")
+		}
+	}
+	return verr
+}
+
 func validateZoneEgress(routing *mesh_proto.Routing, mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	var verr validators.ValidationError
 	if routing == nil {
@@ -45,6 +45,17 @@ var _ = Describe("Mesh", func() {
                 type: tcp
                 conf:
                   address: kibana:1234
+              - name: file-json
+                jsonFormat:
+                  start_time: '%START_TIME%'
+                  source: '%KUMA_SOURCE_SERVICE%'
+                type: file
+                conf:
+                  path: /path/to/file3
+              - name: otel-1
+                type: otel
+                conf:
+                  address: otel-collector:4317
               defaultBackend: tcp-1
             tracing:
               backends:
@@ -60,6 +71,14 @@ var _ = Describe("Mesh", func() {
                 type: zipkin
                 conf:
                   url: http://zipkin.local:9411/v2/spans
+              - name: otel-service
+                type: opentelemetry
+                conf:
+                  service: otel-collector
+              - name: otel-address
+                type: opentelemetry
+                conf:
+                  address: otel-collector.local:4317
               defaultBackend: zipkin-us
             metrics:
               enabledBackend: prom-1
@@ -69,6 +88,13 @@ var _ = Describe("Mesh", func() {
                 conf:
                   port: 5670
                   path: /metrics
+              - name: otel-1
+                type: opentelemetry
+                conf:
+                  endpoint: otel-collector:4317
+                  interval: 30s
+                  filter: "^cluster\\."
+                  usedOnly: true
             constraints:
               dataplaneProxy:
                 requirements:
@@ -127,10 +153,24 @@ var _ = Describe("Mesh", func() {
                     type: builtin`,
 				expected: `
                 violations:
-                - field: mtls.backends
-                  message: cannot have more than 1 backends
                 - field: mtls.backends[1].name
                   message: '"backend-1" name is already used for another backend'`,
+			}),
+			Entry("more than 2 ca backends", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: builtin
+                  - name: backend-2
+                    type: builtin
+                  - name: backend-3
+                    type: builtin`,
+				expected: `
+                violations:
+                - field: mtls.backends
+                  message: cannot have more than 2 backends`,
 			}),
 			Entry("enabledBackend of unknown name", testCase{
 				mesh: `
@@ -215,6 +255,31 @@ var _ = Describe("Mesh", func() {
                   defaultBackend: backend-1`,
 				expected: `
                 violations:
+                - field: logging.backends[0].config.address
+                  message: has to be in format of HOST:PORT`,
+			}),
+			Entry("otel logging address is empty", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: otel
+                    conf: {}`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config.address
+                  message: cannot be empty`,
+			}),
+			Entry("otel logging address is invalid", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: otel
+                    conf:
+                      address: otel-collector`,
+				expected: `
+                violations:
                 - field: logging.backends[0].config.address
                   message: has to be in format of HOST:PORT`,
 			}),
@@ -231,6 +296,26 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].config.path
                   message: cannot be empty`,
+			}),
+			Entry("invalid access log json format", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    format: "%START_TIME%"
+                    jsonFormat:
+                      start: "%START_TIME%"
+                      sent: "%sent_bytes%"
+                    type: file
+                    conf:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: cannot be defined together with format
+                - field: logging.backends[0].jsonFormat
+                  message: 'field "sent": format string is not valid: expected a command operator to start at position 1, instead got: "%sent_bytes%"'`,
 			}),
 			Entry("invalid access log format", testCase{
 				mesh: `
@@ -357,6 +442,45 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.backends[0].config.apiVersion
                   message: 'has invalid value. Allowed values: httpJsonV1, httpJson, httpProto'`,
+			}),
+			Entry("tracing with opentelemetry without service and address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: otel
+                    type: opentelemetry
+                    conf: {}`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].config
+                  message: either service or address has to be defined`,
+			}),
+			Entry("tracing with opentelemetry with both service and address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: otel
+                    type: opentelemetry
+                    conf:
+                      service: otel-collector
+                      address: otel-collector.local:4317`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].config
+                  message: service and address cannot be defined at the same time`,
+			}),
+			Entry("tracing with opentelemetry with invalid address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: otel
+                    type: opentelemetry
+                    conf:
+                      address: otel-collector.local`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].config.address
+                  message: has to be in format of HOST:PORT`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -385,6 +509,66 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: metrics.backends[1].name
                   message: '"backend-1" name is already used for another backend'`,
+			}),
+			Entry("invalid application metrics endpoints", testCase{
+				mesh: `
+                metrics:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: prometheus
+                    conf:
+                      aggregate:
+                      - port: 8080
+                      - name: app
+                        port: 0
+                      - name: app
+                        port: 8081
+                        path: metrics
+                      - name: disabled
+                        enabled: false
+                      - name: remote
+                        address: "not an address"
+                        port: 8082`,
+				expected: `
+                violations:
+                - field: metrics.backends[0].conf.aggregate[0].name
+                  message: cannot be empty
+                - field: metrics.backends[0].conf.aggregate[1].port
+                  message: port must be in the range [1, 65535]
+                - field: metrics.backends[0].conf.aggregate[2].name
+                  message: '"app" name is already used for another application'
+                - field: metrics.backends[0].conf.aggregate[2].path
+                  message: has to start with /
+                - field: metrics.backends[0].conf.aggregate[4].address
+                  message: has to be valid IP address or domain name`,
+			}),
+			Entry("invalid opentelemetry metrics backends", testCase{
+				mesh: `
+                metrics:
+                  backends:
+                  - name: backend-1
+                    type: opentelemetry
+                  - name: backend-2
+                    type: opentelemetry
+                    conf:
+                      endpoint: otel-collector
+                      interval: 0s
+                      filter: "cluster_(.*"
+                      tls:
+                        caCert: "not a certificate"`,
+				expected: `
+                violations:
+                - field: metrics.backends[0].conf.endpoint
+                  message: cannot be empty
+                - field: metrics.backends[1].conf.endpoint
+                  message: has to be in format of HOST:PORT
+                - field: metrics.backends[1].conf.interval
+                  message: must have a positive value
+                - field: metrics.backends[1].conf.filter
+                  message: 'has to be a valid regex: error parsing regexp: missing closing ): ` + "`cluster_(.*`'" + `
+                - field: metrics.backends[1].conf.tls.caCert
+                  message: has to be a PEM encoded certificate`,
 			}),
 			Entry("enabledBackend of unknown name", testCase{
 				mesh: `
@@ -415,11 +599,11 @@ var _ = Describe("Mesh", func() {
 `,
 				expected: `violations:
                 - field: logging.backends[0].type
-                  message: 'unknown backend type. Available backends: "tcp", "file"'
+                  message: 'unknown backend type. Available backends: "tcp", "file", "otel"'
                 - field: tracing.backends[0].type
-                  message: 'unknown backend type. Available backends: "zipkin", "datadog"'
+                  message: 'unknown backend type. Available backends: "zipkin", "datadog", "opentelemetry"'
                 - field: metrics.backends[0].type
-                  message: 'unknown backend type. Available backends: "prometheus"'`,
+                  message: 'unknown backend type. Available backends: "prometheus", "opentelemetry"'`,
 			}),
 			Entry("constraints dataplaneProxy with invalid tags", testCase{
 				mesh: `
@@ -521,8 +705,6 @@ var _ = Describe("Mesh", func() {
                   zoneEgress: true`,
 				expected: `
                 violations:
-                - field: mtls.backends
-                  message: cannot have more than 1 backends
                 - field: mtls.backends[1].name
                   message: '"backend-1" name is already used for another backend'`,
 			}),
@@ -0,0 +1,39 @@
+package mesh
+
+import (
+	"time"
+)
+
+// SLOLatencyThresholds are the buckets of Envoy histograms. Latency SLO counts
+// good requests using the bucket counters, so its threshold has to be one of them.
+var SLOLatencyThresholds = []time.Duration{
+	1 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	60 * time.Second,
+}
+
+// ErrorBudget returns the ratio of requests that are allowed to be bad
+// within the window, e.g. 0.001 for the 99.9 target.
+func (s *SLOResource) ErrorBudget() float64 {
+	return 1 - s.Spec.GetConf().GetTarget()/100
+}
+
+func isSLOLatencyThreshold(threshold time.Duration) bool {
+	for _, t := range SLOLatencyThresholds {
+		if t == threshold {
+			return true
+		}
+	}
+	return false
+}
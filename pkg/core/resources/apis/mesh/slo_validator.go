@@ -0,0 +1,61 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (s *SLOResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(s.validateService())
+	err.Add(s.validateConf())
+	return err.OrNil()
+}
+
+func (s *SLOResource) validateService() (err validators.ValidationError) {
+	path := validators.RootedAt("service")
+	switch service := s.Spec.GetService(); {
+	case service == "":
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+	case service == mesh_proto.MatchAllTag:
+		err.AddViolationAt(path, "has to be a name of a single service")
+	case !tagValueCharacterSet.MatchString(service):
+		err.AddViolationAt(path, "must consist of alphanumeric characters, dots, dashes and underscores")
+	}
+	return
+}
+
+func (s *SLOResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := s.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, HasToBeDefinedViolation)
+		return
+	}
+
+	if conf.GetTarget() <= 0 || conf.GetTarget() >= 100 {
+		err.AddViolationAt(root.Field("target"), "has to be in (0.0, 100.0) range")
+	}
+	err.Add(ValidateDuration(root.Field("window"), conf.GetWindow()))
+
+	switch conf.GetObjective().(type) {
+	case *mesh_proto.SLO_Conf_Availability_:
+	case *mesh_proto.SLO_Conf_Latency_:
+		err.Add(s.validateLatency(root.Field("latency"), conf.GetLatency()))
+	default:
+		err.AddViolationAt(root, "either availability or latency has to be defined")
+	}
+	return
+}
+
+func (s *SLOResource) validateLatency(path validators.PathBuilder, latency *mesh_proto.SLO_Conf_Latency) (err validators.ValidationError) {
+	threshold := latency.GetThreshold()
+	if threshold == nil {
+		err.AddViolationAt(path.Field("threshold"), HasToBeDefinedViolation)
+		return
+	}
+	if !isSLOLatencyThreshold(threshold.AsDuration()) {
+		err.AddViolationAt(path.Field("threshold"), "has to be one of the buckets of Envoy histograms: 1ms, 5ms, 10ms, 25ms, 50ms, 100ms, 250ms, 500ms, 1s, 2.5s, 5s, 10s, 30s, 60s")
+	}
+	return
+}
@@ -0,0 +1,132 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("SLO", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(sloYAML string) {
+				// setup
+				slo := NewSLOResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(sloYAML), slo.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := slo.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("availability", `
+                service: backend
+                conf:
+                  target: 99.9
+                  window: 720h
+                  availability: {}`,
+			),
+			Entry("latency", `
+                service: backend_kuma-demo_svc_8080
+                conf:
+                  target: 99
+                  window: 168h
+                  latency:
+                    threshold: 250ms`,
+			),
+		)
+
+		type testCase struct {
+			slo      string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				slo := NewSLOResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.slo), slo.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := slo.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				slo: ``,
+				expected: `
+                violations:
+                - field: service
+                  message: has to be defined
+                - field: conf
+                  message: has to be defined
+`,
+			}),
+			Entry("empty conf", testCase{
+				slo: `
+                service: "*"
+                conf: {}`,
+				expected: `
+                violations:
+                - field: service
+                  message: has to be a name of a single service
+                - field: conf.target
+                  message: has to be in (0.0, 100.0) range
+                - field: conf.window
+                  message: must have a positive value
+                - field: conf
+                  message: either availability or latency has to be defined
+`,
+			}),
+			Entry("invalid values", testCase{
+				slo: `
+                service: "backend?"
+                conf:
+                  target: 100
+                  window: 0s
+                  latency:
+                    threshold: 200ms`,
+				expected: `
+                violations:
+                - field: service
+                  message: must consist of alphanumeric characters, dots, dashes and underscores
+                - field: conf.target
+                  message: has to be in (0.0, 100.0) range
+                - field: conf.window
+                  message: must have a positive value
+                - field: conf.latency.threshold
+                  message: 'has to be one of the buckets of Envoy histograms: 1ms, 5ms, 10ms, 25ms, 50ms, 100ms, 250ms, 500ms, 1s, 2.5s, 5s, 10s, 30s, 60s'
+`,
+			}),
+			Entry("latency without threshold", testCase{
+				slo: `
+                service: backend
+                conf:
+                  target: 99.5
+                  window: 24h
+                  latency: {}`,
+				expected: `
+                violations:
+                - field: conf.latency.threshold
+                  message: has to be defined
+`,
+			}),
+		)
+	})
+})
@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetFilter returns the filter of requests to log, nil if all requests are logged.
+func (t *TrafficLogResource) GetFilter() *mesh_proto.TrafficLog_Conf_Filter {
+	if t == nil {
+		return nil
+	}
+	return t.Spec.GetConf().GetFilter()
+}
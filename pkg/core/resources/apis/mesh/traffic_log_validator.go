@@ -1,13 +1,28 @@
 package mesh
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
+// responseFlags are the response flags accepted by Envoy's response flag access log filter.
+var responseFlags = map[string]bool{
+	"LH": true, "UH": true, "UT": true, "LR": true, "UR": true, "UF": true,
+	"UC": true, "UO": true, "NR": true, "DI": true, "FI": true, "RL": true,
+	"UAEX": true, "RLSE": true, "DC": true, "URX": true, "SI": true, "IH": true,
+	"DPE": true, "UMSDR": true, "RFCF": true, "NFCF": true, "DT": true, "UPE": true,
+	"NC": true, "OM": true,
+}
+
 func (d *TrafficLogResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
+	err.Add(d.validateFilter())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
 	return err.OrNil()
 }
@@ -25,3 +40,60 @@ func (d *TrafficLogResource) validateSources() validators.ValidationError {
 func (d *TrafficLogResource) validateDestinations() (err validators.ValidationError) {
 	return ValidateSelectors(validators.RootedAt("destinations"), d.Spec.Destinations, OnlyServiceTagAllowed)
 }
+
+func (d *TrafficLogResource) validateFilter() (err validators.ValidationError) {
+	filter := d.Spec.GetConf().GetFilter()
+	if filter == nil {
+		return
+	}
+	path := validators.RootedAt("conf").Field("filter")
+
+	if len(filter.StatusCodes) == 0 && filter.MinDuration == nil && len(filter.ResponseFlags) == 0 &&
+		len(filter.Headers) == 0 && filter.Sampling == nil {
+		err.AddViolationAt(path, "must have at least one condition or sampling defined")
+	}
+	for i, statusCodes := range filter.StatusCodes {
+		err.Add(validateStatusCodeRange(path.Field("statusCodes").Index(i), statusCodes))
+	}
+	if filter.MinDuration != nil && filter.MinDuration.AsDuration() <= 0 {
+		err.AddViolationAt(path.Field("minDuration"), HasToBeGreaterThan0Violation)
+	}
+	for i, flag := range filter.ResponseFlags {
+		if !responseFlags[flag] {
+			err.AddViolationAt(path.Field("responseFlags").Index(i), fmt.Sprintf("unknown response flag, available flags: %s", availableResponseFlags()))
+		}
+	}
+	for i, header := range filter.Headers {
+		if header == "" {
+			err.AddViolationAt(path.Field("headers").Index(i), "cannot be empty")
+		}
+	}
+	if filter.Sampling != nil {
+		if filter.Sampling.GetValue() < 0.0 || filter.Sampling.GetValue() > 100.0 {
+			err.AddViolationAt(path.Field("sampling"), "has to be in [0.0 - 100.0] range")
+		}
+	}
+	return
+}
+
+func validateStatusCodeRange(path validators.PathBuilder, statusCodes *mesh_proto.TrafficLog_Conf_Filter_StatusCodeRange) (err validators.ValidationError) {
+	if statusCodes.Min < 100 || statusCodes.Min > 599 {
+		err.AddViolationAt(path.Field("min"), "has to be in [100 - 599] range")
+	}
+	if statusCodes.Max < 100 || statusCodes.Max > 599 {
+		err.AddViolationAt(path.Field("max"), "has to be in [100 - 599] range")
+	}
+	if statusCodes.Min > statusCodes.Max {
+		err.AddViolationAt(path, "min cannot be greater than max")
+	}
+	return
+}
+
+func availableResponseFlags() string {
+	var flags []string
+	for flag := range responseFlags {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	return strings.Join(flags, ", ")
+}
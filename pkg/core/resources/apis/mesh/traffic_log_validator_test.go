@@ -11,6 +11,45 @@ import (
 
 var _ = Describe("TrafficLog", func() {
 	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(trafficLogYAML string) {
+				// setup
+				trafficLog := NewTrafficLogResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(trafficLogYAML), trafficLog.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := trafficLog.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  backend: file
+                  filter:
+                    statusCodes:
+                    - min: 500
+                      max: 599
+                    minDuration: 1s
+                    responseFlags:
+                    - UH
+                    headers:
+                    - x-debug
+                    sampling: 1
+`,
+			),
+		)
+
 		type testCase struct {
 			trafficLog string
 			expected   string
@@ -126,6 +165,62 @@ var _ = Describe("TrafficLog", func() {
                   message: must consist of exactly one tag "kuma.io/service"
                 - field: destinations[1].match
                   message: mandatory tag "kuma.io/service" is missing
+`,
+			}),
+			Entry("filter without conditions", testCase{
+				trafficLog: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  filter: {}
+`,
+				expected: `
+                violations:
+                - field: conf.filter
+                  message: must have at least one condition or sampling defined
+`,
+			}),
+			Entry("filter with invalid conditions", testCase{
+				trafficLog: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  filter:
+                    statusCodes:
+                    - min: 500
+                      max: 600
+                    - min: 404
+                      max: 400
+                    minDuration: 0s
+                    responseFlags:
+                    - UH
+                    - XX
+                    headers:
+                    - ""
+                    sampling: 101
+`,
+				expected: `
+                violations:
+                - field: conf.filter.statusCodes[0].max
+                  message: has to be in [100 - 599] range
+                - field: conf.filter.statusCodes[1]
+                  message: min cannot be greater than max
+                - field: conf.filter.minDuration
+                  message: has to be greater than 0
+                - field: conf.filter.responseFlags[1]
+                  message: 'unknown response flag, available flags: DC, DI, DPE, DT, FI, IH, LH, LR, NC, NFCF, NR, OM, RFCF, RL, RLSE, SI, UAEX, UC, UF, UH, UMSDR, UO, UPE, UR, URX, UT'
+                - field: conf.filter.headers[0]
+                  message: cannot be empty
+                - field: conf.filter.sampling
+                  message: has to be in [0.0 - 100.0] range
 `,
 			}),
 		)
@@ -1476,6 +1476,107 @@ func init() {
 	registry.RegisterType(RetryResourceTypeDescriptor)
 }
 
+const (
+	SLOType model.ResourceType = "SLO"
+)
+
+var _ model.Resource = &SLOResource{}
+
+type SLOResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.SLO
+}
+
+func NewSLOResource() *SLOResource {
+	return &SLOResource{
+		Spec: &mesh_proto.SLO{},
+	}
+}
+
+func (t *SLOResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *SLOResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *SLOResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *SLOResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.SLO)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.SLO{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *SLOResource) Descriptor() model.ResourceTypeDescriptor {
+	return SLOResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &SLOResourceList{}
+
+type SLOResourceList struct {
+	Items      []*SLOResource
+	Pagination model.Pagination
+}
+
+func (l *SLOResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *SLOResourceList) GetItemType() model.ResourceType {
+	return SLOType
+}
+
+func (l *SLOResourceList) NewItem() model.Resource {
+	return NewSLOResource()
+}
+
+func (l *SLOResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*SLOResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*SLOResource)(nil), r)
+	}
+}
+
+func (l *SLOResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var SLOResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           SLOType,
+	Resource:       NewSLOResource(),
+	ResourceList:   &SLOResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "slos",
+	KumactlArg:     "slo",
+	KumactlListArg: "slos",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(SLOResourceTypeDescriptor)
+}
+
 const (
 	ServiceInsightType model.ResourceType = "ServiceInsight"
 )
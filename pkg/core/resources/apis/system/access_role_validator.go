@@ -0,0 +1,77 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// Verbs that can be granted by AccessRole.
+const (
+	VerbGet     = "get"
+	VerbList    = "list"
+	VerbCreate  = "create"
+	VerbUpdate  = "update"
+	VerbDelete  = "delete"
+	VerbInspect = "inspect"
+	// Wildcard matches every verb, type or mesh in a rule of AccessRole.
+	Wildcard = "*"
+)
+
+var accessVerbs = []string{VerbGet, VerbList, VerbCreate, VerbUpdate, VerbDelete, VerbInspect}
+
+func (t *AccessRoleResource) Validate() error {
+	var err validators.ValidationError
+	if len(t.Spec.GetRules()) == 0 {
+		err.AddViolation("rules", "must have at least one rule")
+	}
+	for i, rule := range t.Spec.GetRules() {
+		path := validators.RootedAt("rules").Index(i)
+		if len(rule.GetTypes()) == 0 {
+			err.AddViolationAt(path.Field("types"), "must have at least one type")
+		}
+		if len(rule.GetVerbs()) == 0 {
+			err.AddViolationAt(path.Field("verbs"), "must have at least one verb")
+		}
+		for j, verb := range rule.GetVerbs() {
+			if !isAccessVerb(verb) {
+				err.AddViolationAt(path.Field("verbs").Index(j), fmt.Sprintf("unknown verb. Available values: %s, %s", strings.Join(accessVerbs, ", "), Wildcard))
+			}
+		}
+		for j, mesh := range rule.GetMeshes() {
+			if mesh == "" {
+				err.AddViolationAt(path.Field("meshes").Index(j), "cannot be empty")
+			}
+		}
+	}
+	return err.OrNil()
+}
+
+func isAccessVerb(verb string) bool {
+	if verb == Wildcard {
+		return true
+	}
+	for _, v := range accessVerbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *AccessRoleBindingResource) Validate() error {
+	var err validators.ValidationError
+	if len(t.Spec.GetUsers()) == 0 && len(t.Spec.GetGroups()) == 0 {
+		err.AddViolation("", "must have at least one user or group")
+	}
+	if len(t.Spec.GetRoles()) == 0 {
+		err.AddViolation("roles", "must have at least one role")
+	}
+	for i, role := range t.Spec.GetRoles() {
+		if role == "" {
+			err.AddViolationAt(validators.RootedAt("roles").Index(i), "cannot be empty")
+		}
+	}
+	return err.OrNil()
+}
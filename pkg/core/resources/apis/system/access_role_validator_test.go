@@ -0,0 +1,132 @@
+package system_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("AccessRole", func() {
+
+	DescribeTable("should pass validation",
+		func(roleYAML string) {
+			// given
+			role := system.NewAccessRoleResource()
+
+			// when
+			err := util_proto.FromYAML([]byte(roleYAML), role.Spec)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = role.Validate()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		},
+		Entry("rule scoped to meshes", `
+            rules:
+            - types: ["TrafficPermission", "TrafficRoute"]
+              verbs: ["get", "list", "create", "update", "delete"]
+              meshes: ["team-a"]`,
+		),
+		Entry("rule with wildcards", `
+            rules:
+            - types: ["*"]
+              verbs: ["*"]`,
+		),
+	)
+
+	type testCase struct {
+		role     string
+		expected string
+	}
+	DescribeTable("should validate all fields and return as much individual errors as possible",
+		func(given testCase) {
+			// setup
+			role := system.NewAccessRoleResource()
+
+			// when
+			err := util_proto.FromYAML([]byte(given.role), role.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := role.Validate()
+			// and
+			actual, err := yaml.Marshal(verr)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("empty rules", testCase{
+			role: `{}`,
+			expected: `
+                violations:
+                - field: rules
+                  message: must have at least one rule`,
+		}),
+		Entry("invalid rule", testCase{
+			role: `
+            rules:
+            - verbs: ["get", "patch"]
+              meshes: [""]
+            - types: ["Mesh"]`,
+			expected: `
+                violations:
+                - field: rules[0].types
+                  message: must have at least one type
+                - field: rules[0].verbs[1]
+                  message: 'unknown verb. Available values: get, list, create, update, delete, inspect, *'
+                - field: rules[0].meshes[0]
+                  message: cannot be empty
+                - field: rules[1].verbs
+                  message: must have at least one verb`,
+		}),
+	)
+})
+
+var _ = Describe("AccessRoleBinding", func() {
+
+	It("should pass validation", func() {
+		// given
+		binding := system.NewAccessRoleBindingResource()
+		err := util_proto.FromYAML([]byte(`
+            groups: ["team-a"]
+            roles: ["team-a-owner"]`), binding.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = binding.Validate()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should validate subjects and roles", func() {
+		// given
+		binding := system.NewAccessRoleBindingResource()
+		err := util_proto.FromYAML([]byte(`
+            roles: [""]`), binding.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		verr := binding.Validate()
+		actual, err := yaml.Marshal(verr)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+            violations:
+            - field: ""
+              message: must have at least one user or group
+            - field: roles[0]
+              message: cannot be empty`))
+	})
+})
@@ -0,0 +1,226 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// FormatVersion is the version of the archive format produced by Export.
+// Bump it whenever the format changes in a way that older versions of Import cannot read.
+const FormatVersion = 1
+
+const pageSize = 100
+
+// Archive is a snapshot of the state of the Control Plane.
+type Archive struct {
+	// Version of the archive format.
+	Version int `json:"version"`
+	// KumaVersion is the version of the Control Plane from which the archive was exported.
+	KumaVersion string `json:"kumaVersion,omitempty"`
+	// ExportedAt is the time when the archive was exported.
+	ExportedAt time.Time `json:"exportedAt"`
+	// Items are resources in the REST API format ordered by their dependencies.
+	Items []json.RawMessage `json:"items"`
+}
+
+type ExportOptions struct {
+	// IncludeSecrets includes Secrets and GlobalSecrets in the archive.
+	IncludeSecrets bool
+}
+
+type ImportOptions struct {
+	// Overwrite updates resources that already exist. By default, they are skipped.
+	Overwrite bool
+}
+
+type ImportResult struct {
+	Created int
+	Updated int
+	// Skipped is the number of resources that already existed and were not overwritten.
+	Skipped int
+	// UnsupportedTypes are types from the archive that cannot be imported to this Control Plane.
+	UnsupportedTypes []string
+}
+
+// ExportedTypes selects resources that are part of the archive.
+// Read-only resources (like insights) are excluded, because they are recomputed by the Control Plane.
+func ExportedTypes(includeSecrets bool) model.TypeFilter {
+	return model.TypeFilterFn(func(descriptor model.ResourceTypeDescriptor) bool {
+		if descriptor.WsPath == "" || descriptor.ReadOnly {
+			return false
+		}
+		if isSecret(descriptor) {
+			return includeSecrets
+		}
+		return true
+	})
+}
+
+func isSecret(descriptor model.ResourceTypeDescriptor) bool {
+	return descriptor.Name == system.SecretType || descriptor.Name == system.GlobalSecretType
+}
+
+// rank defines the order in which resources are restored. Resources can only reference resources of a lower rank.
+func rank(descriptor model.ResourceTypeDescriptor) int {
+	switch {
+	case descriptor.Name == core_mesh.MeshType:
+		return 0
+	case descriptor.Scope == model.ScopeGlobal:
+		return 1
+	case descriptor.Name == system.SecretType:
+		return 2
+	case descriptor.Name == core_mesh.DataplaneType:
+		// Dataplanes go last so policies are in place once they are restored
+		return 4
+	default:
+		return 3
+	}
+}
+
+func sortDescriptors(descriptors []model.ResourceTypeDescriptor) {
+	sort.SliceStable(descriptors, func(i, j int) bool {
+		if rank(descriptors[i]) != rank(descriptors[j]) {
+			return rank(descriptors[i]) < rank(descriptors[j])
+		}
+		return descriptors[i].Name < descriptors[j].Name
+	})
+}
+
+// Export dumps all meshes and global resources from the store into an archive.
+func Export(ctx context.Context, rs store.ResourceStore, types registry.TypeRegistry, opts ExportOptions) (*Archive, error) {
+	meshes, err := listAll(ctx, rs, core_mesh.MeshResourceTypeDescriptor, model.NoMesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list meshes")
+	}
+
+	descriptors := types.ObjectDescriptors(ExportedTypes(opts.IncludeSecrets))
+	sortDescriptors(descriptors)
+
+	archive := &Archive{
+		Version:    FormatVersion,
+		ExportedAt: time.Now(),
+		Items:      []json.RawMessage{},
+	}
+	for _, desc := range descriptors {
+		var resources []model.Resource
+		if desc.Scope == model.ScopeGlobal {
+			resources, err = listAll(ctx, rs, desc, model.NoMesh)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not list %s", desc.Name)
+			}
+		} else {
+			for _, mesh := range meshes {
+				items, err := listAll(ctx, rs, desc, mesh.GetMeta().GetName())
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not list %s in mesh %q", desc.Name, mesh.GetMeta().GetName())
+				}
+				resources = append(resources, items...)
+			}
+		}
+		for _, res := range resources {
+			bytes, err := rest.From.Resource(res).MarshalJSON()
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not marshal %s %q", desc.Name, res.GetMeta().GetName())
+			}
+			archive.Items = append(archive.Items, bytes)
+		}
+	}
+	return archive, nil
+}
+
+func listAll(ctx context.Context, rs store.ResourceStore, desc model.ResourceTypeDescriptor, mesh string) ([]model.Resource, error) {
+	var resources []model.Resource
+	offset := ""
+	for {
+		list := desc.NewList()
+		if err := rs.List(ctx, list, store.ListByMesh(mesh), store.ListByPage(pageSize, offset)); err != nil {
+			return nil, err
+		}
+		resources = append(resources, list.GetItems()...)
+		offset = list.GetPagination().GetNextOffset()
+		if offset == "" {
+			return resources, nil
+		}
+	}
+}
+
+// Import restores resources from the archive into the store.
+// Resources of types that are unknown to the registry, for example because they were removed in this version, are skipped.
+// Fields that are unknown to this version are ignored.
+func Import(ctx context.Context, rs store.ResourceStore, types registry.TypeRegistry, archive *Archive, opts ImportOptions) (ImportResult, error) {
+	result := ImportResult{}
+	if archive.Version < 1 || archive.Version > FormatVersion {
+		return result, errors.Errorf("unsupported archive version %d. The highest supported version is %d", archive.Version, FormatVersion)
+	}
+
+	unsupported := map[string]bool{}
+	var resources []model.Resource
+	for i, item := range archive.Items {
+		meta := rest.ResourceMeta{}
+		if err := json.Unmarshal(item, &meta); err != nil {
+			return result, errors.Wrapf(err, "could not parse item %d", i)
+		}
+		desc, err := types.DescriptorFor(model.ResourceType(meta.Type))
+		if err != nil || !ExportedTypes(true).Apply(desc) {
+			if !unsupported[meta.Type] {
+				unsupported[meta.Type] = true
+				result.UnsupportedTypes = append(result.UnsupportedTypes, meta.Type)
+			}
+			continue
+		}
+		res := desc.NewObject()
+		if err := util_proto.FromJSON(item, res.GetSpec()); err != nil {
+			return result, errors.Wrapf(err, "could not parse %s %q", meta.Type, meta.Name)
+		}
+		if desc.Scope == model.ScopeGlobal {
+			meta.Mesh = model.NoMesh
+		}
+		res.SetMeta(&meta)
+		resources = append(resources, res)
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return rank(resources[i].Descriptor()) < rank(resources[j].Descriptor())
+	})
+
+	for _, res := range resources {
+		name, mesh := res.GetMeta().GetName(), res.GetMeta().GetMesh()
+		existing := res.Descriptor().NewObject()
+		err := rs.Get(ctx, existing, store.GetByKey(name, mesh))
+		switch {
+		case store.IsResourceNotFound(err):
+			created := res.Descriptor().NewObject()
+			if err := created.SetSpec(res.GetSpec()); err != nil {
+				return result, err
+			}
+			if err := rs.Create(ctx, created, store.CreateByKey(name, mesh)); err != nil {
+				return result, errors.Wrapf(err, "could not create %s %q", res.Descriptor().Name, name)
+			}
+			result.Created++
+		case err != nil:
+			return result, errors.Wrapf(err, "could not get %s %q", res.Descriptor().Name, name)
+		case opts.Overwrite:
+			if err := existing.SetSpec(res.GetSpec()); err != nil {
+				return result, err
+			}
+			if err := rs.Update(ctx, existing); err != nil {
+				return result, errors.Wrapf(err, "could not update %s %q", res.Descriptor().Name, name)
+			}
+			result.Updated++
+		default:
+			result.Skipped++
+		}
+	}
+	return result, nil
+}
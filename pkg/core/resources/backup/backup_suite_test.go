@@ -0,0 +1,11 @@
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestBackup(t *testing.T) {
+	test.RunSpecs(t, "Backup Suite")
+}
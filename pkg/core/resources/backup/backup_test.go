@@ -0,0 +1,224 @@
+package backup_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/backup"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Backup", func() {
+
+	var source store.ResourceStore
+	var target store.ResourceStore
+
+	trafficPermission := func(service string) *mesh.TrafficPermissionResource {
+		return &mesh.TrafficPermissionResource{
+			Spec: &mesh_proto.TrafficPermission{
+				Sources:      []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: service}}},
+				Destinations: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}},
+			},
+		}
+	}
+
+	types := func(archive *backup.Archive) []string {
+		var result []string
+		for _, item := range archive.Items {
+			meta := map[string]interface{}{}
+			Expect(json.Unmarshal(item, &meta)).To(Succeed())
+			result = append(result, meta["type"].(string)+"/"+meta["name"].(string))
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		source = store.NewPaginationStore(memory.NewStore())
+		target = store.NewPaginationStore(memory.NewStore())
+
+		ctx := context.Background()
+		Expect(source.Create(ctx, mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+		Expect(source.Create(ctx, &mesh.DataplaneResource{Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+					Port: 8080,
+					Tags: map[string]string{mesh_proto.ServiceTag: "web"},
+				}},
+			},
+		}}, store.CreateByKey("dp-1", "mesh-1"))).To(Succeed())
+		Expect(source.Create(ctx, trafficPermission("web"), store.CreateByKey("tp-1", "mesh-1"))).To(Succeed())
+		Expect(source.Create(ctx, &system.SecretResource{Spec: &system_proto.Secret{
+			Data: &wrapperspb.BytesValue{Value: []byte("secret")},
+		}}, store.CreateByKey("sec-1", "mesh-1"))).To(Succeed())
+		Expect(source.Create(ctx, &mesh.ZoneIngressResource{Spec: &mesh_proto.ZoneIngress{
+			Networking: &mesh_proto.ZoneIngress_Networking{
+				Address: "192.168.0.2",
+				Port:    10001,
+			},
+		}}, store.CreateByKey("zi-1", model.NoMesh))).To(Succeed())
+		Expect(source.Create(ctx, &mesh.DataplaneInsightResource{Spec: &mesh_proto.DataplaneInsight{}}, store.CreateByKey("dp-1", "mesh-1"))).To(Succeed())
+	})
+
+	Describe("Export()", func() {
+		It("should export resources ordered by dependencies", func() {
+			// when
+			archive, err := backup.Export(context.Background(), source, registry.Global(), backup.ExportOptions{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archive.Version).To(Equal(backup.FormatVersion))
+			Expect(types(archive)).To(Equal([]string{
+				"Mesh/mesh-1",
+				"ZoneIngress/zi-1",
+				"TrafficPermission/tp-1",
+				"Dataplane/dp-1",
+			}))
+		})
+
+		It("should include secrets when requested", func() {
+			// when
+			archive, err := backup.Export(context.Background(), source, registry.Global(), backup.ExportOptions{
+				IncludeSecrets: true,
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(types(archive)).To(Equal([]string{
+				"Mesh/mesh-1",
+				"ZoneIngress/zi-1",
+				"Secret/sec-1",
+				"TrafficPermission/tp-1",
+				"Dataplane/dp-1",
+			}))
+		})
+	})
+
+	Describe("Import()", func() {
+		var archive *backup.Archive
+
+		BeforeEach(func() {
+			var err error
+			archive, err = backup.Export(context.Background(), source, registry.Global(), backup.ExportOptions{
+				IncludeSecrets: true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should restore exported resources", func() {
+			// when
+			result, err := backup.Import(context.Background(), target, registry.Global(), archive, backup.ImportOptions{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(backup.ImportResult{Created: 5}))
+
+			// and
+			restored, err := backup.Export(context.Background(), target, registry.Global(), backup.ExportOptions{
+				IncludeSecrets: true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(types(restored)).To(Equal(types(archive)))
+			secret := system.NewSecretResource()
+			Expect(target.Get(context.Background(), secret, store.GetByKey("sec-1", "mesh-1"))).To(Succeed())
+			Expect(secret.Spec.Data.Value).To(Equal([]byte("secret")))
+		})
+
+		It("should skip existing resources", func() {
+			// given
+			Expect(target.Create(context.Background(), mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+			Expect(target.Create(context.Background(), trafficPermission("backend"), store.CreateByKey("tp-1", "mesh-1"))).To(Succeed())
+
+			// when
+			result, err := backup.Import(context.Background(), target, registry.Global(), archive, backup.ImportOptions{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(backup.ImportResult{Created: 3, Skipped: 2}))
+			tp := mesh.NewTrafficPermissionResource()
+			Expect(target.Get(context.Background(), tp, store.GetByKey("tp-1", "mesh-1"))).To(Succeed())
+			Expect(tp.Spec.Sources[0].Match[mesh_proto.ServiceTag]).To(Equal("backend"))
+		})
+
+		It("should overwrite existing resources", func() {
+			// given
+			Expect(target.Create(context.Background(), mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+			Expect(target.Create(context.Background(), trafficPermission("backend"), store.CreateByKey("tp-1", "mesh-1"))).To(Succeed())
+
+			// when
+			result, err := backup.Import(context.Background(), target, registry.Global(), archive, backup.ImportOptions{
+				Overwrite: true,
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(backup.ImportResult{Created: 3, Updated: 2}))
+			tp := mesh.NewTrafficPermissionResource()
+			Expect(target.Get(context.Background(), tp, store.GetByKey("tp-1", "mesh-1"))).To(Succeed())
+			Expect(tp.Spec.Sources[0].Match[mesh_proto.ServiceTag]).To(Equal("web"))
+		})
+
+		It("should skip types and fields unknown to this version", func() {
+			// given
+			archive := &backup.Archive{
+				Version: backup.FormatVersion,
+				Items: []json.RawMessage{
+					json.RawMessage(`{"type": "Mesh", "name": "mesh-1", "futureField": "value"}`),
+					json.RawMessage(`{"type": "FutureResource", "mesh": "mesh-1", "name": "fr-1"}`),
+					json.RawMessage(`{"type": "FutureResource", "mesh": "mesh-1", "name": "fr-2"}`),
+					json.RawMessage(`{"type": "DataplaneInsight", "mesh": "mesh-1", "name": "dp-1"}`),
+				},
+			}
+
+			// when
+			result, err := backup.Import(context.Background(), target, registry.Global(), archive, backup.ImportOptions{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(backup.ImportResult{
+				Created:          1,
+				UnsupportedTypes: []string{"FutureResource", "DataplaneInsight"},
+			}))
+		})
+
+		It("should restore resources in the order of dependencies", func() {
+			// given
+			archive := &backup.Archive{
+				Version: backup.FormatVersion,
+				Items: []json.RawMessage{
+					json.RawMessage(`{"type": "TrafficPermission", "mesh": "mesh-1", "name": "tp-1", "sources": [{"match": {"kuma.io/service": "*"}}], "destinations": [{"match": {"kuma.io/service": "*"}}]}`),
+					json.RawMessage(`{"type": "Mesh", "name": "mesh-1"}`),
+				},
+			}
+
+			// when
+			result, err := backup.Import(context.Background(), target, registry.Global(), archive, backup.ImportOptions{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(backup.ImportResult{Created: 2}))
+		})
+
+		It("should reject archive of unsupported version", func() {
+			// given
+			archive.Version = backup.FormatVersion + 1
+
+			// when
+			_, err := backup.Import(context.Background(), target, registry.Global(), archive, backup.ImportOptions{})
+
+			// then
+			Expect(err).To(MatchError("unsupported archive version 2. The highest supported version is 1"))
+		})
+	})
+})
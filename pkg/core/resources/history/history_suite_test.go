@@ -0,0 +1,11 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestHistory(t *testing.T) {
+	test.RunSpecs(t, "Resource History")
+}
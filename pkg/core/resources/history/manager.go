@@ -0,0 +1,104 @@
+package history
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("resource-history")
+
+// NewHistoryResourceManager decorates a ResourceManager so that every successful Create and Update
+// is recorded as a new revision of the resource together with the user who made the change.
+// Revisions are removed together with the resource, so they don't pile up for resources that no longer exist.
+// Failing to record or remove revisions does not fail the operation itself.
+func NewHistoryResourceManager(delegate manager.ResourceManager, revisions RevisionStore) manager.ResourceManager {
+	return &historyResourceManager{
+		ResourceManager: delegate,
+		revisions:       revisions,
+	}
+}
+
+type historyResourceManager struct {
+	manager.ResourceManager
+	revisions RevisionStore
+}
+
+var _ manager.ResourceManager = &historyResourceManager{}
+
+func (h *historyResourceManager) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := h.ResourceManager.Create(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	h.record(ctx, resource, model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}, Create)
+	return nil
+}
+
+func (h *historyResourceManager) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	if err := h.ResourceManager.Update(ctx, resource, fs...); err != nil {
+		return err
+	}
+	h.record(ctx, resource, model.MetaToResourceKey(resource.GetMeta()), Update)
+	return nil
+}
+
+func (h *historyResourceManager) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	if err := h.ResourceManager.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewDeleteOptions(fs...)
+	key := model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}
+	if err := h.revisions.Delete(ctx, resource.Descriptor().Name, key); err != nil {
+		log.Error(err, "could not remove revisions of the resource", "type", resource.Descriptor().Name, "key", key)
+	}
+	return nil
+}
+
+func (h *historyResourceManager) DeleteAll(ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
+	return manager.DeleteAllResources(h, ctx, list, fs...)
+}
+
+func (h *historyResourceManager) record(ctx context.Context, resource model.Resource, key model.ResourceKey, operation Operation) {
+	spec, err := util_proto.ToJSON(resource.GetSpec())
+	if err != nil {
+		log.Error(err, "could not marshal spec of the resource", "type", resource.Descriptor().Name, "key", key)
+		return
+	}
+	u := user.FromCtx(ctx)
+	revision := Revision{
+		Operation: operation,
+		User:      u.Name,
+		Groups:    u.Groups,
+		Time:      core.Now(),
+		Spec:      spec,
+	}
+	if err := h.revisions.Append(ctx, resource.Descriptor().Name, key, revision); err != nil {
+		log.Error(err, "could not record a revision of the resource", "type", resource.Descriptor().Name, "key", key)
+	}
+}
+
+// untrackedTypes are created and updated by the system rather than by users,
+// or carry data that must not be copied outside of the secret store.
+var untrackedTypes = map[model.ResourceType]bool{
+	core_mesh.DataplaneType:   true,
+	core_mesh.ZoneIngressType: true,
+	core_mesh.ZoneEgressType:  true,
+	system.ZoneType:           true,
+	system.SecretType:         true,
+	system.GlobalSecretType:   true,
+}
+
+// TrackedTypes selects resources for which revisions are recorded.
+func TrackedTypes() model.TypeFilter {
+	return model.TypeFilterFn(func(descriptor model.ResourceTypeDescriptor) bool {
+		return descriptor.KumactlArg != "" && !descriptor.ReadOnly && !untrackedTypes[descriptor.Name]
+	})
+}
@@ -0,0 +1,160 @@
+package history_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("History Resource Manager", func() {
+
+	var resourceStore store.ResourceStore
+	var resManager manager.ResourceManager
+	var revisions history.RevisionStore
+	var ctx context.Context
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := model.ResourceKey{Mesh: model.DefaultMesh, Name: "tp-1"}
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+		resourceStore = memory.NewStore()
+		revisions = history.NewConfigRevisionStore(config_manager.NewConfigManager(resourceStore), 2)
+		resManager = history.NewHistoryResourceManager(manager.NewResourceManager(resourceStore), revisions)
+		ctx = user.Ctx(context.Background(), user.User{
+			Name:   "john.doe@example.com",
+			Groups: []string{"team-a"},
+		})
+
+		err := resManager.Create(ctx, core_mesh.NewMeshResource(), store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	trafficPermission := func(service string) *core_mesh.TrafficPermissionResource {
+		return &core_mesh.TrafficPermissionResource{
+			Spec: &mesh_proto.TrafficPermission{
+				Sources: []*mesh_proto.Selector{{
+					Match: map[string]string{mesh_proto.ServiceTag: service},
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: map[string]string{mesh_proto.ServiceTag: "*"},
+				}},
+			},
+		}
+	}
+
+	It("should record create, update and delete", func() {
+		// when
+		tp := trafficPermission("web")
+		Expect(resManager.Create(ctx, tp, store.CreateByKey(key.Name, key.Mesh))).To(Succeed())
+
+		// and
+		tp.Spec.Sources[0].Match[mesh_proto.ServiceTag] = "backend"
+		Expect(resManager.Update(ctx, tp)).To(Succeed())
+
+		// then
+		list, err := revisions.List(context.Background(), core_mesh.TrafficPermissionType, key)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0].Revision).To(Equal(1))
+		Expect(list.Items[0].Operation).To(Equal(history.Create))
+		Expect(list.Items[0].User).To(Equal("john.doe@example.com"))
+		Expect(list.Items[0].Groups).To(Equal([]string{"team-a"}))
+		Expect(list.Items[0].Time.Equal(now)).To(BeTrue())
+		Expect(string(list.Items[0].Spec)).To(ContainSubstring(`"web"`))
+		Expect(list.Items[1].Revision).To(Equal(2))
+		Expect(list.Items[1].Operation).To(Equal(history.Update))
+		Expect(string(list.Items[1].Spec)).To(ContainSubstring(`"backend"`))
+
+		// when
+		tp.Spec.Sources[0].Match[mesh_proto.ServiceTag] = "frontend"
+		Expect(resManager.Update(ctx, tp)).To(Succeed())
+
+		// then only the last revisions are kept
+		list, err = revisions.List(context.Background(), core_mesh.TrafficPermissionType, key)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0].Revision).To(Equal(2))
+		Expect(list.Items[1].Revision).To(Equal(3))
+	})
+
+	It("should remove revisions of deleted resource", func() {
+		// given
+		Expect(resManager.Create(ctx, trafficPermission("web"), store.CreateByKey(key.Name, key.Mesh))).To(Succeed())
+
+		// when
+		Expect(resManager.Delete(ctx, core_mesh.NewTrafficPermissionResource(), store.DeleteByKey(key.Name, key.Mesh))).To(Succeed())
+
+		// then
+		list, err := revisions.List(context.Background(), core_mesh.TrafficPermissionType, key)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should not lose revisions appended concurrently", func() {
+		// given
+		Expect(resManager.Create(ctx, trafficPermission("web"), store.CreateByKey(key.Name, key.Mesh))).To(Succeed())
+		revisions = history.NewConfigRevisionStore(config_manager.NewConfigManager(resourceStore), 10)
+
+		// when
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(revisions.Append(ctx, core_mesh.TrafficPermissionType, key, history.Revision{Operation: history.Update})).To(Succeed())
+			}()
+		}
+		wg.Wait()
+
+		// then
+		list, err := revisions.List(context.Background(), core_mesh.TrafficPermissionType, key)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items).To(HaveLen(6))
+		Expect(list.Items[5].Revision).To(Equal(6))
+	})
+
+	It("should not record failed operations", func() {
+		// when
+		err := resManager.Create(ctx, trafficPermission("web"), store.CreateByKey(key.Name, "not-existing-mesh"))
+
+		// then
+		Expect(err).To(HaveOccurred())
+		list, err := revisions.List(context.Background(), core_mesh.TrafficPermissionType, model.ResourceKey{Mesh: "not-existing-mesh", Name: key.Name})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should not track system resources", func() {
+		// when
+		types := registry.Global().ObjectTypes(history.TrackedTypes())
+
+		// then
+		Expect(types).To(ContainElement(core_mesh.TrafficPermissionType))
+		Expect(types).ToNot(ContainElement(core_mesh.DataplaneType))
+		Expect(types).ToNot(ContainElement(system.SecretType))
+		Expect(types).ToNot(ContainElement(system.ConfigType))
+	})
+})
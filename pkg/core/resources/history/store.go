@@ -0,0 +1,164 @@
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sethvargo/go-retry"
+
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	config_model "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+type Operation string
+
+const (
+	Create Operation = "CREATE"
+	Update Operation = "UPDATE"
+)
+
+// Revision is a single recorded change of a resource.
+// Spec is a JSON representation of the resource spec after the change.
+type Revision struct {
+	Revision  int             `json:"revision"`
+	Operation Operation       `json:"operation"`
+	User      string          `json:"user"`
+	Groups    []string        `json:"groups,omitempty"`
+	Time      time.Time       `json:"time"`
+	Spec      json.RawMessage `json:"spec,omitempty"`
+}
+
+type RevisionList struct {
+	Type  model.ResourceType `json:"type"`
+	Mesh  string             `json:"mesh,omitempty"`
+	Name  string             `json:"name"`
+	Items []Revision         `json:"items"`
+}
+
+type RevisionStore interface {
+	Append(ctx context.Context, resType model.ResourceType, key model.ResourceKey, revision Revision) error
+	// List returns the revisions of a resource from the oldest to the newest one.
+	List(ctx context.Context, resType model.ResourceType, key model.ResourceKey) (*RevisionList, error)
+	// Delete removes all revisions of a resource.
+	Delete(ctx context.Context, resType model.ResourceType, key model.ResourceKey) error
+}
+
+const configKeyPrefix = "kuma-revisions-"
+
+// ConfigKey returns the name of the Config that holds revisions of the given resource.
+// Names of resources can be long or contain characters that are not allowed in every store (ex. "_" on Kubernetes),
+// therefore the key is derived from a hash.
+func ConfigKey(resType model.ResourceType, key model.ResourceKey) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", resType, key.Mesh, key.Name)))
+	return fmt.Sprintf("%s%x", configKeyPrefix, hash[:16])
+}
+
+// NewConfigRevisionStore creates RevisionStore on top of the ConfigManager,
+// so that revisions are kept in every store that the Control Plane supports.
+func NewConfigRevisionStore(configManager config_manager.ConfigManager, limit int) RevisionStore {
+	return &configRevisionStore{
+		configManager: configManager,
+		limit:         limit,
+	}
+}
+
+type configRevisionStore struct {
+	configManager config_manager.ConfigManager
+	limit         int
+}
+
+var _ RevisionStore = &configRevisionStore{}
+
+// appendConflictRetries limits how many times Append is retried when the revisions were concurrently changed
+const appendConflictRetries = 5
+
+func (c *configRevisionStore) Append(ctx context.Context, resType model.ResourceType, key model.ResourceKey, revision Revision) error {
+	backoff := retry.WithMaxRetries(appendConflictRetries, retry.NewExponential(10*time.Millisecond))
+	return retry.Do(ctx, backoff, func(ctx context.Context) error {
+		err := c.append(ctx, resType, key, revision)
+		// revisions of the same resource can be appended concurrently by many instances of the Control Plane
+		if store.IsResourceConflict(err) || store.IsResourceAlreadyExists(err) {
+			return retry.RetryableError(err)
+		}
+		return err
+	})
+}
+
+func (c *configRevisionStore) append(ctx context.Context, resType model.ResourceType, key model.ResourceKey, revision Revision) error {
+	create := false
+	config := config_model.NewConfigResource()
+	if err := c.configManager.Get(ctx, config, store.GetByKey(ConfigKey(resType, key), model.NoMesh)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return err
+		}
+		create = true
+	}
+
+	list := &RevisionList{
+		Type: resType,
+		Mesh: key.Mesh,
+		Name: key.Name,
+	}
+	if config.Spec.GetConfig() != "" {
+		if err := json.Unmarshal([]byte(config.Spec.GetConfig()), list); err != nil {
+			return errors.Wrap(err, "could not unmarshal revisions")
+		}
+	}
+
+	revision.Revision = 1
+	if len(list.Items) > 0 {
+		revision.Revision = list.Items[len(list.Items)-1].Revision + 1
+	}
+	list.Items = append(list.Items, revision)
+	if len(list.Items) > c.limit {
+		list.Items = list.Items[len(list.Items)-c.limit:]
+	}
+
+	bytes, err := json.Marshal(list)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal revisions")
+	}
+	config.Spec.Config = string(bytes)
+	if create {
+		return c.configManager.Create(ctx, config, store.CreateByKey(ConfigKey(resType, key), model.NoMesh))
+	}
+	return c.configManager.Update(ctx, config)
+}
+
+func (c *configRevisionStore) Delete(ctx context.Context, resType model.ResourceType, key model.ResourceKey) error {
+	config := config_model.NewConfigResource()
+	err := c.configManager.Delete(ctx, config, store.DeleteByKey(ConfigKey(resType, key), model.NoMesh))
+	if err != nil && !store.IsResourceNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *configRevisionStore) List(ctx context.Context, resType model.ResourceType, key model.ResourceKey) (*RevisionList, error) {
+	list := &RevisionList{
+		Type:  resType,
+		Mesh:  key.Mesh,
+		Name:  key.Name,
+		Items: []Revision{},
+	}
+	config := config_model.NewConfigResource()
+	if err := c.configManager.Get(ctx, config, store.GetByKey(ConfigKey(resType, key), model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return list, nil
+		}
+		return nil, err
+	}
+	if config.Spec.GetConfig() == "" {
+		return list, nil
+	}
+	if err := json.Unmarshal([]byte(config.Spec.GetConfig()), list); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal revisions")
+	}
+	return list, nil
+}
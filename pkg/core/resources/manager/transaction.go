@@ -0,0 +1,107 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var transactionLog = core.Log.WithName("resource-manager").WithName("transaction")
+
+// InTransaction executes fn so that changes made with the manager and the context passed to fn are either
+// all applied or none of them is.
+// If the store supports transactions, fn is executed in a single transaction of the store.
+// Otherwise, changes applied before fn failed are reverted on a best-effort basis.
+func InTransaction(
+	ctx context.Context,
+	manager ResourceManager,
+	transactions store.Transactions,
+	fn func(ctx context.Context, manager ResourceManager) error,
+) error {
+	if _, noTxs := transactions.(store.NoTransactions); transactions != nil && !noTxs {
+		return transactions.Transaction(ctx, func(ctx context.Context) error {
+			return fn(ctx, manager)
+		})
+	}
+	reverting := &revertingManager{ResourceManager: manager}
+	if err := fn(ctx, reverting); err != nil {
+		reverting.revert(ctx)
+		return err
+	}
+	return nil
+}
+
+// revertingManager records how to revert every successful change so it can be undone when a later one fails.
+type revertingManager struct {
+	ResourceManager
+	reverts []func(ctx context.Context) error
+}
+
+var _ ResourceManager = &revertingManager{}
+
+func (r *revertingManager) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := r.ResourceManager.Create(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	r.reverts = append(r.reverts, func(ctx context.Context) error {
+		return r.ResourceManager.Delete(ctx, resource.Descriptor().NewObject(), store.DeleteByKey(opts.Name, opts.Mesh))
+	})
+	return nil
+}
+
+func (r *revertingManager) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	key := model.MetaToResourceKey(resource.GetMeta())
+	previous := resource.Descriptor().NewObject()
+	if err := r.ResourceManager.Get(ctx, previous, store.GetBy(key)); err != nil {
+		return err
+	}
+	if err := r.ResourceManager.Update(ctx, resource, fs...); err != nil {
+		return err
+	}
+	r.reverts = append(r.reverts, func(ctx context.Context) error {
+		current := resource.Descriptor().NewObject()
+		if err := r.ResourceManager.Get(ctx, current, store.GetBy(key)); err != nil {
+			return err
+		}
+		if err := current.SetSpec(previous.GetSpec()); err != nil {
+			return err
+		}
+		return r.ResourceManager.Update(ctx, current)
+	})
+	return nil
+}
+
+func (r *revertingManager) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+	previous := resource.Descriptor().NewObject()
+	if err := r.ResourceManager.Get(ctx, previous, store.GetByKey(opts.Name, opts.Mesh)); err != nil {
+		return err
+	}
+	if err := r.ResourceManager.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	r.reverts = append(r.reverts, func(ctx context.Context) error {
+		restored := resource.Descriptor().NewObject()
+		if err := restored.SetSpec(previous.GetSpec()); err != nil {
+			return err
+		}
+		return r.ResourceManager.Create(ctx, restored, store.CreateByKey(opts.Name, opts.Mesh))
+	})
+	return nil
+}
+
+func (r *revertingManager) DeleteAll(ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
+	return DeleteAllResources(r, ctx, list, fs...)
+}
+
+// revert undoes changes in the reverse order. It continues on errors so as much as possible is reverted.
+func (r *revertingManager) revert(ctx context.Context) {
+	for i := len(r.reverts) - 1; i >= 0; i-- {
+		if err := r.reverts[i](ctx); err != nil {
+			transactionLog.Error(err, "could not revert a change")
+		}
+	}
+}
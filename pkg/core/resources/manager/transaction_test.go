@@ -0,0 +1,100 @@
+package manager_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
+	"github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+type recordingTransactions struct {
+	called bool
+}
+
+func (r *recordingTransactions) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	r.called = true
+	return fn(ctx)
+}
+
+var _ = Describe("InTransaction", func() {
+
+	var resManager manager.ResourceManager
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		err := resManager.Create(context.Background(), &mesh.MeshResource{Spec: &mesh_proto.Mesh{}}, store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), trafficRoute("/existing"), store.CreateByKey("existing", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), trafficRoute("/to-delete"), store.CreateByKey("to-delete", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should use transactions of the store when they are supported", func() {
+		// given
+		txs := &recordingTransactions{}
+
+		// when
+		err := manager.InTransaction(context.Background(), resManager, txs, func(ctx context.Context, rm manager.ResourceManager) error {
+			return rm.Create(ctx, trafficRoute("/new"), store.CreateByKey("new", "mesh-1"))
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(txs.called).To(BeTrue())
+	})
+
+	It("should revert applied changes when store does not support transactions", func() {
+		// when
+		err := manager.InTransaction(context.Background(), resManager, store.NoTransactions{}, func(ctx context.Context, rm manager.ResourceManager) error {
+			if err := rm.Create(ctx, trafficRoute("/new"), store.CreateByKey("new", "mesh-1")); err != nil {
+				return err
+			}
+			existing := sample.NewTrafficRouteResource()
+			if err := rm.Get(ctx, existing, store.GetByKey("existing", "mesh-1")); err != nil {
+				return err
+			}
+			existing.Spec.Path = "/updated"
+			if err := rm.Update(ctx, existing); err != nil {
+				return err
+			}
+			if err := rm.Delete(ctx, sample.NewTrafficRouteResource(), store.DeleteByKey("to-delete", "mesh-1")); err != nil {
+				return err
+			}
+			return rm.Create(ctx, trafficRoute("/other"), store.CreateByKey("other", "not-existing-mesh"))
+		})
+
+		// then
+		Expect(manager.IsMeshNotFound(err)).To(BeTrue())
+
+		// and
+		list := &sample.TrafficRouteResourceList{}
+		Expect(resManager.List(context.Background(), list, store.ListByMesh("mesh-1"))).To(Succeed())
+		Expect(list.Items).To(HaveLen(2))
+		paths := map[string]string{}
+		for _, item := range list.Items {
+			paths[item.GetMeta().GetName()] = item.Spec.Path
+		}
+		Expect(paths).To(Equal(map[string]string{
+			"existing":  "/existing",
+			"to-delete": "/to-delete",
+		}))
+	})
+})
+
+func trafficRoute(path string) *sample.TrafficRouteResource {
+	return &sample.TrafficRouteResource{
+		Spec: &v1alpha1.TrafficRoute{
+			Path: path,
+		},
+	}
+}
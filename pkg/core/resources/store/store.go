@@ -124,6 +124,10 @@ func ErrorResourceAlreadyExists(rt model.ResourceType, name, mesh string) error
 	return fmt.Errorf("Resource already exists: type=%q name=%q mesh=%q", rt, name, mesh)
 }
 
+func IsResourceAlreadyExists(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "Resource already exists")
+}
+
 func ErrorResourceConflict(rt model.ResourceType, name, mesh string) error {
 	return fmt.Errorf("Resource conflict: type=%q name=%q mesh=%q", rt, name, mesh)
 }
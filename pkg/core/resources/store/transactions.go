@@ -0,0 +1,22 @@
+package store
+
+import (
+	"context"
+)
+
+// Transactions is implemented by stores that can execute several operations atomically.
+type Transactions interface {
+	// Transaction executes fn in a transaction. Operations of the store executed with the context passed to fn
+	// are committed if fn returns nil and rolled back otherwise.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// NoTransactions is used for stores that do not support transactions.
+// It executes fn directly, therefore operations that succeeded before fn failed are not rolled back.
+type NoTransactions struct{}
+
+var _ Transactions = NoTransactions{}
+
+func (n NoTransactions) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -12,6 +12,7 @@ import (
 	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
@@ -53,6 +54,8 @@ type BuilderContext interface {
 	KDSContext() *kds_context.Context
 	APIServerAuthenticator() authn.Authenticator
 	Access() Access
+	AuditLogger() audit.Logger
+	Transactions() core_store.Transactions
 }
 
 var _ BuilderContext = &Builder{}
@@ -84,6 +87,8 @@ type Builder struct {
 	rv             ResourceValidators
 	au             authn.Authenticator
 	acc            Access
+	al             audit.Logger
+	txs            core_store.Transactions
 	appCtx         context.Context
 	extraReportsFn ExtraReportsFn
 	*runtimeInfo
@@ -237,6 +242,16 @@ func (b *Builder) WithAccess(acc Access) *Builder {
 	return b
 }
 
+func (b *Builder) WithAuditLogger(al audit.Logger) *Builder {
+	b.al = al
+	return b
+}
+
+func (b *Builder) WithTransactions(txs core_store.Transactions) *Builder {
+	b.txs = txs
+	return b
+}
+
 func (b *Builder) WithExtraReportsFn(fn ExtraReportsFn) *Builder {
 	b.extraReportsFn = fn
 	return b
@@ -303,6 +318,12 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.acc == (Access{}) {
 		return nil, errors.Errorf("Access has not been configured")
 	}
+	if b.al == nil {
+		return nil, errors.Errorf("AuditLogger has not been configured")
+	}
+	if b.txs == nil {
+		return nil, errors.Errorf("Transactions has not been configured")
+	}
 	return &runtime{
 		RuntimeInfo: b.runtimeInfo,
 		RuntimeContext: &runtimeContext{
@@ -329,6 +350,8 @@ func (b *Builder) Build() (Runtime, error) {
 			rv:             b.rv,
 			au:             b.au,
 			acc:            b.acc,
+			al:             b.al,
+			txs:            b.txs,
 			appCtx:         b.appCtx,
 			extraReportsFn: b.extraReportsFn,
 		},
@@ -408,6 +431,12 @@ func (b *Builder) APIServerAuthenticator() authn.Authenticator {
 func (b *Builder) Access() Access {
 	return b.acc
 }
+func (b *Builder) AuditLogger() audit.Logger {
+	return b.al
+}
+func (b *Builder) Transactions() core_store.Transactions {
+	return b.txs
+}
 func (b *Builder) AppCtx() context.Context {
 	return b.appCtx
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/kumahq/kuma/pkg/api-server/authn"
 	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/core/ca"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
@@ -71,6 +72,8 @@ type RuntimeContext interface {
 	APIServerAuthenticator() authn.Authenticator
 	ResourceValidators() ResourceValidators
 	Access() Access
+	AuditLogger() audit.Logger
+	Transactions() core_store.Transactions
 	// AppContext returns a context.Context which tracks the lifetime of the apps, it gets cancelled when the app is starting to shutdown.
 	AppContext() context.Context
 	ExtraReportsFn() ExtraReportsFn
@@ -155,6 +158,8 @@ type runtimeContext struct {
 	rv             ResourceValidators
 	au             authn.Authenticator
 	acc            Access
+	al             audit.Logger
+	txs            core_store.Transactions
 	appCtx         context.Context
 	extraReportsFn ExtraReportsFn
 }
@@ -254,6 +259,14 @@ func (rc *runtimeContext) Access() Access {
 	return rc.acc
 }
 
+func (rc *runtimeContext) AuditLogger() audit.Logger {
+	return rc.al
+}
+
+func (rc *runtimeContext) Transactions() core_store.Transactions {
+	return rc.txs
+}
+
 func (rc *runtimeContext) AppContext() context.Context {
 	return rc.appCtx
 }
@@ -0,0 +1,49 @@
+package cipher
+
+import (
+	"encoding/base64"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+)
+
+// FromConfig returns a Cipher for Secrets and GlobalSecrets. Secrets are not encrypted unless encryption is enabled.
+func FromConfig(cfg store_config.SecretsEncryptionConfig) (Cipher, error) {
+	if !cfg.Enabled() {
+		return None(), nil
+	}
+	keys, err := LoadKeys(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewEnvelope(keys, cfg.ActiveKeyId)
+}
+
+// LoadKeys loads key encryption keys from the keys file and the configuration.
+func LoadKeys(cfg store_config.SecretsEncryptionConfig) (map[string][]byte, error) {
+	encodedKeys := map[string]string{}
+	if cfg.KeysFile != "" {
+		content, err := os.ReadFile(cfg.KeysFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the keys file")
+		}
+		if err := yaml.Unmarshal(content, &encodedKeys); err != nil {
+			return nil, errors.Wrap(err, "could not parse the keys file")
+		}
+	}
+	for id, key := range cfg.Keys {
+		encodedKeys[id] = key
+	}
+	keys := map[string][]byte{}
+	for id, encodedKey := range encodedKeys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q is not valid base64", id)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
@@ -0,0 +1,135 @@
+package cipher
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// envelopePrefix marks data encrypted by the envelope cipher. Data without the prefix is treated as plaintext,
+// so secrets stored before encryption was enabled can still be read.
+var envelopePrefix = []byte("kuma:enc:v1:")
+
+const dataKeySize = 32
+
+type envelope struct {
+	// KeyID is an ID of the key encryption key that encrypted the data key.
+	KeyID string `json:"kid"`
+	// Key is a data key encrypted with the key encryption key.
+	Key []byte `json:"key"`
+	// Data is a value encrypted with the data key.
+	Data []byte `json:"data"`
+}
+
+// NewEnvelope returns a Cipher that encrypts every value with a fresh AES-256-GCM data key
+// and stores the data key encrypted with the active key encryption key (KEK) next to the value.
+// All keys are used for decryption, therefore KEK can be rotated by adding a new key and making it active.
+func NewEnvelope(keys map[string][]byte, activeKeyID string) (Cipher, error) {
+	keks := map[string]cipher.AEAD{}
+	for id, key := range keys {
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key %q", id)
+		}
+		keks[id] = aead
+	}
+	if _, ok := keks[activeKeyID]; !ok {
+		return nil, errors.Errorf("active key %q is not defined", activeKeyID)
+	}
+	return &envelopeCipher{
+		keks:        keks,
+		activeKeyID: activeKeyID,
+	}, nil
+}
+
+var _ Cipher = &envelopeCipher{}
+
+type envelopeCipher struct {
+	keks        map[string]cipher.AEAD
+	activeKeyID string
+}
+
+func (e *envelopeCipher) Encrypt(data []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.Wrap(err, "could not generate a data key")
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := seal(dek, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	// key ID is authenticated so the data key cannot be attributed to a different KEK
+	encryptedKey, err := seal(e.keks[e.activeKeyID], dataKey, []byte(e.activeKeyID))
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := json.Marshal(envelope{
+		KeyID: e.activeKeyID,
+		Key:   encryptedKey,
+		Data:  encryptedData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, envelopePrefix...), bytes...), nil
+}
+
+func (e *envelopeCipher) Decrypt(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, envelopePrefix) {
+		return data, nil
+	}
+	env := envelope{}
+	if err := json.Unmarshal(data[len(envelopePrefix):], &env); err != nil {
+		return nil, errors.Wrap(err, "could not parse encrypted value")
+	}
+	kek, ok := e.keks[env.KeyID]
+	if !ok {
+		return nil, errors.Errorf("value is encrypted with the unknown key %q", env.KeyID)
+	}
+	dataKey, err := open(kek, env.Key, []byte(env.KeyID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt the data key with the key %q", env.KeyID)
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	value, err := open(dek, env.Data, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt the value")
+	}
+	return value, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate a nonce")
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, additionalData)
+}
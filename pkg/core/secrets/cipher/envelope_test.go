@@ -0,0 +1,159 @@
+package cipher_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+var _ = Describe("Envelope cipher", func() {
+
+	key1 := []byte("0123456789abcdef0123456789abcdef")
+	key2 := []byte("fedcba9876543210fedcba9876543210")
+
+	It("should encrypt and decrypt data", func() {
+		// given
+		c, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		encrypted, err := c.Encrypt([]byte("secret data"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(encrypted)).To(HavePrefix("kuma:enc:v1:"))
+		Expect(string(encrypted)).ToNot(ContainSubstring("secret data"))
+
+		// when
+		decrypted, err := c.Decrypt(encrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("secret data")))
+	})
+
+	It("should decrypt data encrypted with the previous key after rotation", func() {
+		// given
+		before, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		after, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1, "key-2": key2}, "key-2")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := before.Encrypt([]byte("secret data"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		decrypted, err := after.Decrypt(encrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("secret data")))
+	})
+
+	It("should pass through data that is not encrypted", func() {
+		// given
+		c, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		decrypted, err := c.Decrypt([]byte("plaintext"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("plaintext")))
+	})
+
+	It("should fail when data is encrypted with unknown key", func() {
+		// given
+		before, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		after, err := cipher.NewEnvelope(map[string][]byte{"key-2": key2}, "key-2")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := before.Encrypt([]byte("secret data"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = after.Decrypt(encrypted)
+
+		// then
+		Expect(err).To(MatchError(`value is encrypted with the unknown key "key-1"`))
+	})
+
+	It("should fail when data was tampered with", func() {
+		// given
+		c, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1, "key-2": key2}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := c.Encrypt([]byte("secret data"))
+		Expect(err).ToNot(HaveOccurred())
+
+		env := map[string]interface{}{}
+		Expect(json.Unmarshal(bytes.TrimPrefix(encrypted, []byte("kuma:enc:v1:")), &env)).To(Succeed())
+		env["kid"] = "key-2"
+		tampered, err := json.Marshal(env)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = c.Decrypt(append([]byte("kuma:enc:v1:"), tampered...))
+
+		// then
+		Expect(err).To(MatchError(`could not decrypt the data key with the key "key-2": cipher: message authentication failed`))
+	})
+
+	It("should reject invalid keys", func() {
+		// when
+		_, err := cipher.NewEnvelope(map[string][]byte{"key-1": []byte("too-short")}, "key-1")
+
+		// then
+		Expect(err).To(MatchError(`invalid key "key-1": crypto/aes: invalid key size 9`))
+
+		// when
+		_, err = cipher.NewEnvelope(map[string][]byte{"key-1": key1}, "key-2")
+
+		// then
+		Expect(err).To(MatchError(`active key "key-2" is not defined`))
+	})
+
+	Describe("FromConfig()", func() {
+		It("should not encrypt data when encryption is disabled", func() {
+			// when
+			c, err := cipher.FromConfig(store_config.DefaultSecretsStoreConfig().Encryption)
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := c.Encrypt([]byte("secret data"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encrypted).To(Equal([]byte("secret data")))
+		})
+
+		It("should load keys from the file and the config", func() {
+			// given
+			keysFile := filepath.Join(GinkgoT().TempDir(), "keys.yaml")
+			Expect(os.WriteFile(keysFile, []byte("key-1: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=\n"), 0600)).To(Succeed())
+			before, err := cipher.NewEnvelope(map[string][]byte{"key-1": key1}, "key-1")
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := before.Encrypt([]byte("secret data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			c, err := cipher.FromConfig(store_config.SecretsEncryptionConfig{
+				ActiveKeyId: "key-2",
+				KeysFile:    keysFile,
+				Keys: map[string]string{
+					"key-2": "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA=",
+				},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			decrypted, err := c.Decrypt(encrypted)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("secret data")))
+		})
+	})
+})
@@ -1,5 +0,0 @@
-package cipher
-
-func TODO() Cipher {
-	return None()
-}
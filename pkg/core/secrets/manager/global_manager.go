@@ -8,14 +8,12 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
-	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 )
 
-func NewGlobalSecretManager(secretStore secret_store.SecretStore, cipher secret_cipher.Cipher) manager.ResourceManager {
+func NewGlobalSecretManager(secretStore secret_store.SecretStore) manager.ResourceManager {
 	return &globalSecretManager{
 		secretStore: secretStore,
-		cipher:      cipher,
 	}
 }
 
@@ -23,7 +21,6 @@ var _ manager.ResourceManager = &globalSecretManager{}
 
 type globalSecretManager struct {
 	secretStore secret_store.SecretStore
-	cipher      secret_cipher.Cipher
 }
 
 func (s *globalSecretManager) Get(ctx context.Context, resource model.Resource, fs ...core_store.GetOptionsFunc) error {
@@ -31,10 +28,7 @@ func (s *globalSecretManager) Get(ctx context.Context, resource model.Resource,
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.secretStore.Get(ctx, secret, fs...); err != nil {
-		return err
-	}
-	return s.decrypt(secret)
+	return s.secretStore.Get(ctx, secret, fs...)
 }
 
 func (s *globalSecretManager) List(ctx context.Context, resources model.ResourceList, fs ...core_store.ListOptionsFunc) error {
@@ -42,15 +36,7 @@ func (s *globalSecretManager) List(ctx context.Context, resources model.Resource
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.secretStore.List(ctx, secrets, fs...); err != nil {
-		return err
-	}
-	for _, secret := range secrets.Items {
-		if err := s.decrypt(secret); err != nil {
-			return err
-		}
-	}
-	return nil
+	return s.secretStore.List(ctx, secrets, fs...)
 }
 
 func (s *globalSecretManager) Create(ctx context.Context, resource model.Resource, fs ...core_store.CreateOptionsFunc) error {
@@ -58,13 +44,7 @@ func (s *globalSecretManager) Create(ctx context.Context, resource model.Resourc
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.encrypt(secret); err != nil {
-		return err
-	}
-	if err := s.secretStore.Create(ctx, secret, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
-		return err
-	}
-	return s.decrypt(secret)
+	return s.secretStore.Create(ctx, secret, append(fs, core_store.CreatedAt(time.Now()))...)
 }
 
 func (s *globalSecretManager) Update(ctx context.Context, resource model.Resource, fs ...core_store.UpdateOptionsFunc) error {
@@ -72,13 +52,7 @@ func (s *globalSecretManager) Update(ctx context.Context, resource model.Resourc
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.encrypt(secret); err != nil {
-		return err
-	}
-	if err := s.secretStore.Update(ctx, secret, append(fs, core_store.ModifiedAt(time.Now()))...); err != nil {
-		return err
-	}
-	return s.decrypt(secret)
+	return s.secretStore.Update(ctx, secret, append(fs, core_store.ModifiedAt(time.Now()))...)
 }
 
 func (s *globalSecretManager) Delete(ctx context.Context, resource model.Resource, fs ...core_store.DeleteOptionsFunc) error {
@@ -101,25 +75,3 @@ func (s *globalSecretManager) DeleteAll(ctx context.Context, secrets model.Resou
 	}
 	return nil
 }
-
-func (s *globalSecretManager) encrypt(secret *secret_model.GlobalSecretResource) error {
-	if len(secret.Spec.GetData().GetValue()) > 0 {
-		value, err := s.cipher.Encrypt(secret.Spec.Data.Value)
-		if err != nil {
-			return err
-		}
-		secret.Spec.Data.Value = value
-	}
-	return nil
-}
-
-func (s *globalSecretManager) decrypt(secret *secret_model.GlobalSecretResource) error {
-	if len(secret.Spec.GetData().GetValue()) > 0 {
-		value, err := s.cipher.Decrypt(secret.Spec.Data.Value)
-		if err != nil {
-			return err
-		}
-		secret.Spec.Data.Value = value
-	}
-	return nil
-}
@@ -10,14 +10,12 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
-	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 )
 
-func NewSecretManager(secretStore secret_store.SecretStore, cipher secret_cipher.Cipher, validator SecretValidator) manager.ResourceManager {
+func NewSecretManager(secretStore secret_store.SecretStore, validator SecretValidator) manager.ResourceManager {
 	return &secretManager{
 		secretStore: secretStore,
-		cipher:      cipher,
 		validator:   validator,
 	}
 }
@@ -26,7 +24,6 @@ var _ manager.ResourceManager = &secretManager{}
 
 type secretManager struct {
 	secretStore secret_store.SecretStore
-	cipher      secret_cipher.Cipher
 	validator   SecretValidator
 }
 
@@ -35,10 +32,7 @@ func (s *secretManager) Get(ctx context.Context, resource model.Resource, fs ...
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.secretStore.Get(ctx, secret, fs...); err != nil {
-		return err
-	}
-	return s.decrypt(secret)
+	return s.secretStore.Get(ctx, secret, fs...)
 }
 
 func (s *secretManager) List(ctx context.Context, resources model.ResourceList, fs ...core_store.ListOptionsFunc) error {
@@ -46,15 +40,7 @@ func (s *secretManager) List(ctx context.Context, resources model.ResourceList,
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.secretStore.List(ctx, secrets, fs...); err != nil {
-		return err
-	}
-	for _, secret := range secrets.Items {
-		if err := s.decrypt(secret); err != nil {
-			return err
-		}
-	}
-	return nil
+	return s.secretStore.List(ctx, secrets, fs...)
 }
 
 func (s *secretManager) Create(ctx context.Context, resource model.Resource, fs ...core_store.CreateOptionsFunc) error {
@@ -62,13 +48,11 @@ func (s *secretManager) Create(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.encrypt(secret); err != nil {
-		return err
-	}
-	if err := s.secretStore.Create(ctx, secret, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
+	opts := core_store.NewCreateOptions(fs...)
+	if err := s.validateUpsert(ctx, opts.Name, opts.Mesh, secret); err != nil {
 		return err
 	}
-	return s.decrypt(secret)
+	return s.secretStore.Create(ctx, secret, append(fs, core_store.CreatedAt(time.Now()))...)
 }
 
 func (s *secretManager) Update(ctx context.Context, resource model.Resource, fs ...core_store.UpdateOptionsFunc) error {
@@ -76,13 +60,10 @@ func (s *secretManager) Update(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
-	if err := s.encrypt(secret); err != nil {
+	if err := s.validateUpsert(ctx, secret.GetMeta().GetName(), secret.GetMeta().GetMesh(), secret); err != nil {
 		return err
 	}
-	if err := s.secretStore.Update(ctx, secret, append(fs, core_store.ModifiedAt(time.Now()))...); err != nil {
-		return err
-	}
-	return s.decrypt(secret)
+	return s.secretStore.Update(ctx, secret, append(fs, core_store.ModifiedAt(time.Now()))...)
 }
 
 func (s *secretManager) Delete(ctx context.Context, resource model.Resource, fs ...core_store.DeleteOptionsFunc) error {
@@ -111,28 +92,14 @@ func (s *secretManager) DeleteAll(ctx context.Context, secrets model.ResourceLis
 	return nil
 }
 
-func newInvalidTypeError() error {
-	return errors.New("resource has a wrong type")
-}
-
-func (s *secretManager) encrypt(secret *secret_model.SecretResource) error {
-	if len(secret.Spec.GetData().GetValue()) > 0 {
-		value, err := s.cipher.Encrypt(secret.Spec.Data.Value)
-		if err != nil {
-			return err
-		}
-		secret.Spec.Data.Value = value
+func (s *secretManager) validateUpsert(ctx context.Context, name string, mesh string, secret *secret_model.SecretResource) error {
+	validator, ok := s.validator.(UpsertValidator)
+	if !ok {
+		return nil
 	}
-	return nil
+	return validator.ValidateUpsert(ctx, name, mesh, secret.Spec.GetData().GetValue())
 }
 
-func (s *secretManager) decrypt(secret *secret_model.SecretResource) error {
-	if len(secret.Spec.GetData().GetValue()) > 0 {
-		value, err := s.cipher.Decrypt(secret.Spec.Data.Value)
-		if err != nil {
-			return err
-		}
-		secret.Spec.Data.Value = value
-	}
-	return nil
+func newInvalidTypeError() error {
+	return errors.New("resource has a wrong type")
 }
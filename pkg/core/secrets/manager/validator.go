@@ -21,6 +21,11 @@ type SecretValidator interface {
 	ValidateDelete(ctx context.Context, secretName string, secretMesh string) error
 }
 
+// UpsertValidator is implemented by validators that check data of Secrets before they are created or updated.
+type UpsertValidator interface {
+	ValidateUpsert(ctx context.Context, secretName string, secretMesh string, data []byte) error
+}
+
 type ValidateDelete func(ctx context.Context, secretName string, secretMesh string) error
 
 func (f ValidateDelete) ValidateDelete(ctx context.Context, secretName string, secretMesh string) error {
@@ -62,6 +67,31 @@ func (s *secretValidator) ValidateDelete(ctx context.Context, name string, mesh
 	return verr.OrNil()
 }
 
+var _ UpsertValidator = &secretValidator{}
+
+// ValidateUpsert lets mTLS backends of the mesh validate Secrets that users upload for them.
+func (s *secretValidator) ValidateUpsert(ctx context.Context, name string, mesh string, data []byte) error {
+	meshRes := core_mesh.NewMeshResource()
+	if err := s.store.Get(ctx, meshRes, core_store.GetByKey(mesh, model.NoMesh)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	var verr validators.ValidationError
+	for _, backend := range meshRes.Spec.GetMtls().GetBackends() {
+		validator, ok := s.caManagers[backend.Type].(ca.SecretValidator)
+		if !ok {
+			continue
+		}
+		if err := validator.ValidateSecret(ctx, mesh, backend, name, data); err != nil {
+			verr.AddViolation("data", fmt.Sprintf("The secret %q is not valid for mTLS backend %q in Mesh %q: %s", name, backend.Name, mesh, err.Error()))
+		}
+	}
+	return verr.OrNil()
+}
+
 func (s *secretValidator) secretUsedByMTLSBackend(name string, mesh string, backend *mesh_proto.CertificateAuthorityBackend) (bool, error) {
 	caManager := s.caManagers[backend.Type]
 	if caManager == nil { // this should be caught earlier by validator
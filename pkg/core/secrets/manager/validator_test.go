@@ -16,10 +16,10 @@ import (
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
-	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secrets_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secrets_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	ca_builtin "github.com/kumahq/kuma/pkg/plugins/ca/builtin"
+	builtin_config "github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
 	ca_provided "github.com/kumahq/kuma/pkg/plugins/ca/provided"
 	provided_config "github.com/kumahq/kuma/pkg/plugins/ca/provided/config"
 	resources_memory "github.com/kumahq/kuma/pkg/plugins/resources/memory"
@@ -38,7 +38,7 @@ var _ = Describe("Secret Validator", func() {
 		caManagers = core_ca.Managers{}
 		secrets_manager.NewSecretValidator(caManagers, memoryStore)
 		validator = secrets_manager.NewSecretValidator(caManagers, memoryStore)
-		secManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(memoryStore), cipher.None(), validator)
+		secManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(memoryStore), validator)
 
 		caManagers["builtin"] = ca_builtin.NewBuiltinCaManager(secManager)
 		caManagers["provided"] = ca_provided.NewProvidedCaManager(core_datasource.NewDataSourceLoader(secManager))
@@ -138,4 +138,44 @@ var _ = Describe("Secret Validator", func() {
 		// then
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("should validate uploaded secrets with mTLS backends", func() {
+		// given
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec = &mesh_proto.Mesh{
+			Mtls: &mesh_proto.Mesh_Mtls{
+				EnabledBackend: "ca-1",
+				Backends: []*mesh_proto.CertificateAuthorityBackend{
+					{
+						Name: "ca-1",
+						Type: "builtin",
+						Conf: proto.MustToStruct(&builtin_config.BuiltinCertificateAuthorityConfig{
+							Intermediate: &builtin_config.BuiltinCertificateAuthorityConfig_Intermediate{
+								Type: &builtin_config.BuiltinCertificateAuthorityConfig_Intermediate_Csr_{
+									Csr: &builtin_config.BuiltinCertificateAuthorityConfig_Intermediate_Csr{},
+								},
+							},
+						}),
+					},
+				},
+			},
+		}
+		err := resManager.Create(context.Background(), mesh, core_store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = core_managers.EnsureCAs(context.Background(), caManagers, mesh, model.DefaultMesh)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = validator.(secrets_manager.UpsertValidator).ValidateUpsert(context.Background(), "default.ca-builtin-cert-ca-1", "default", []byte("not a certificate"))
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix(`data: The secret "default.ca-builtin-cert-ca-1" is not valid for mTLS backend "ca-1" in Mesh "default": the certificate does not match the CSR`))
+
+		// when
+		err = validator.(secrets_manager.UpsertValidator).ValidateUpsert(context.Background(), "some-other-secret", "default", []byte("not a certificate"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
 })
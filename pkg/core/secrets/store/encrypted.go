@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+// NewEncryptedSecretStore returns a SecretStore that encrypts data of Secrets and GlobalSecrets before they are persisted
+// and decrypts it when they are read.
+// Encryption is done on the store level, so every component that reads secrets, including KDS, sees them decrypted.
+func NewEncryptedSecretStore(secretStore SecretStore, cipher secret_cipher.Cipher) SecretStore {
+	return &encryptedSecretStore{
+		SecretStore: secretStore,
+		cipher:      cipher,
+	}
+}
+
+type encryptedSecretStore struct {
+	SecretStore
+	cipher secret_cipher.Cipher
+}
+
+var _ SecretStore = &encryptedSecretStore{}
+
+func (e *encryptedSecretStore) Create(ctx context.Context, resource model.Resource, fs ...core_store.CreateOptionsFunc) error {
+	restore, err := e.encrypt(resource)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return e.SecretStore.Create(ctx, resource, fs...)
+}
+
+func (e *encryptedSecretStore) Update(ctx context.Context, resource model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	restore, err := e.encrypt(resource)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return e.SecretStore.Update(ctx, resource, fs...)
+}
+
+func (e *encryptedSecretStore) Get(ctx context.Context, resource model.Resource, fs ...core_store.GetOptionsFunc) error {
+	if err := e.SecretStore.Get(ctx, resource, fs...); err != nil {
+		return err
+	}
+	return e.decrypt(resource)
+}
+
+func (e *encryptedSecretStore) List(ctx context.Context, resources model.ResourceList, fs ...core_store.ListOptionsFunc) error {
+	if err := e.SecretStore.List(ctx, resources, fs...); err != nil {
+		return err
+	}
+	for _, resource := range resources.GetItems() {
+		if err := e.decrypt(resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encrypt replaces data of the secret with encrypted data. Returned function restores the original data.
+func (e *encryptedSecretStore) encrypt(resource model.Resource) (func(), error) {
+	spec, ok := resource.GetSpec().(*system_proto.Secret)
+	if !ok || len(spec.GetData().GetValue()) == 0 {
+		return func() {}, nil
+	}
+	value := spec.Data.Value
+	encrypted, err := e.cipher.Encrypt(value)
+	if err != nil {
+		return nil, err
+	}
+	spec.Data.Value = encrypted
+	return func() {
+		spec.Data.Value = value
+	}, nil
+}
+
+func (e *encryptedSecretStore) decrypt(resource model.Resource) error {
+	spec, ok := resource.GetSpec().(*system_proto.Secret)
+	if !ok || len(spec.GetData().GetValue()) == 0 {
+		return nil
+	}
+	value, err := e.cipher.Decrypt(spec.Data.Value)
+	if err != nil {
+		return err
+	}
+	spec.Data.Value = value
+	return nil
+}
@@ -0,0 +1,121 @@
+package store_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Encrypted Secret Store", func() {
+
+	var rawStore core_store.ResourceStore
+	var secretStore secret_store.SecretStore
+	var envelope cipher.Cipher
+
+	BeforeEach(func() {
+		var err error
+		envelope, err = cipher.NewEnvelope(map[string][]byte{
+			"key-1": []byte("0123456789abcdef0123456789abcdef"),
+		}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		rawStore = memory.NewStore()
+		secretStore = secret_store.NewEncryptedSecretStore(secret_store.NewSecretStore(rawStore), envelope)
+	})
+
+	newSecret := func(value string) *system.SecretResource {
+		return &system.SecretResource{
+			Spec: &system_proto.Secret{
+				Data: &wrapperspb.BytesValue{Value: []byte(value)},
+			},
+		}
+	}
+
+	It("should persist only encrypted data", func() {
+		// given
+		secret := newSecret("secret data")
+
+		// when
+		err := secretStore.Create(context.Background(), secret, core_store.CreateByKey("sec-1", "default"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Spec.Data.Value).To(Equal([]byte("secret data")))
+
+		// and persisted value is encrypted
+		raw := system.NewSecretResource()
+		Expect(rawStore.Get(context.Background(), raw, core_store.GetByKey("sec-1", "default"))).To(Succeed())
+		Expect(string(raw.Spec.Data.Value)).To(HavePrefix("kuma:enc:v1:"))
+
+		// and value is decrypted on read
+		actual := system.NewSecretResource()
+		Expect(secretStore.Get(context.Background(), actual, core_store.GetByKey("sec-1", "default"))).To(Succeed())
+		Expect(actual.Spec.Data.Value).To(Equal([]byte("secret data")))
+
+		list := &system.SecretResourceList{}
+		Expect(secretStore.List(context.Background(), list)).To(Succeed())
+		Expect(list.Items[0].Spec.Data.Value).To(Equal([]byte("secret data")))
+	})
+
+	It("should read secrets stored before encryption was enabled", func() {
+		// given
+		Expect(rawStore.Create(context.Background(), newSecret("plaintext"), core_store.CreateByKey("sec-1", "default"))).To(Succeed())
+
+		// when
+		actual := system.NewSecretResource()
+		err := secretStore.Get(context.Background(), actual, core_store.GetByKey("sec-1", "default"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Spec.Data.Value).To(Equal([]byte("plaintext")))
+	})
+
+	Describe("ReEncrypt()", func() {
+		It("should encrypt all secrets with the active key", func() {
+			// given
+			Expect(rawStore.Create(context.Background(), newSecret("plaintext"), core_store.CreateByKey("sec-1", "default"))).To(Succeed())
+			Expect(secretStore.Create(context.Background(), newSecret("encrypted"), core_store.CreateByKey("sec-2", "default"))).To(Succeed())
+			globalSecret := system.NewGlobalSecretResource()
+			globalSecret.Spec.Data = &wrapperspb.BytesValue{Value: []byte("global")}
+			Expect(rawStore.Create(context.Background(), globalSecret, core_store.CreateByKey("gsec-1", model.NoMesh))).To(Succeed())
+
+			rotated, err := cipher.NewEnvelope(map[string][]byte{
+				"key-1": []byte("0123456789abcdef0123456789abcdef"),
+				"key-2": []byte("fedcba9876543210fedcba9876543210"),
+			}, "key-2")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			count, err := secret_store.ReEncrypt(context.Background(), secret_store.NewSecretStore(rawStore), rotated)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(3))
+
+			// and secrets can be read only with the new key
+			onlyNewKey, err := cipher.NewEnvelope(map[string][]byte{
+				"key-2": []byte("fedcba9876543210fedcba9876543210"),
+			}, "key-2")
+			Expect(err).ToNot(HaveOccurred())
+			store := secret_store.NewEncryptedSecretStore(secret_store.NewSecretStore(rawStore), onlyNewKey)
+			list := &system.SecretResourceList{}
+			Expect(store.List(context.Background(), list)).To(Succeed())
+			Expect(list.Items).To(HaveLen(2))
+			Expect(list.Items[0].Spec.Data.Value).To(Equal([]byte("plaintext")))
+			Expect(list.Items[1].Spec.Data.Value).To(Equal([]byte("encrypted")))
+
+			raw := system.NewGlobalSecretResource()
+			Expect(rawStore.Get(context.Background(), raw, core_store.GetByKey("gsec-1", model.NoMesh))).To(Succeed())
+			Expect(string(raw.Spec.Data.Value)).To(HavePrefix("kuma:enc:v1:"))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+// ReEncrypt encrypts every Secret and GlobalSecret again with the active key of the cipher.
+// It encrypts secrets stored before encryption was enabled and moves secrets to the new key after the key was rotated.
+// It returns the number of re-encrypted secrets.
+func ReEncrypt(ctx context.Context, secretStore SecretStore, cipher secret_cipher.Cipher) (int, error) {
+	encryptedStore := NewEncryptedSecretStore(secretStore, cipher)
+	count := 0
+	for _, list := range []model.ResourceList{&system.SecretResourceList{}, &system.GlobalSecretResourceList{}} {
+		if err := encryptedStore.List(ctx, list); err != nil {
+			return count, errors.Wrapf(err, "could not list %s", list.GetItemType())
+		}
+		for _, item := range list.GetItems() {
+			if err := encryptedStore.Update(ctx, item, core_store.ModifiedAt(time.Now())); err != nil {
+				return count, errors.Wrapf(err, "could not update %s %q", list.GetItemType(), item.GetMeta().GetName())
+			}
+			count++
+		}
+	}
+	return count, nil
+}
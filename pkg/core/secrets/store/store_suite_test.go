@@ -0,0 +1,11 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestSecretStore(t *testing.T) {
+	test.RunSpecs(t, "Secret Store Suite")
+}
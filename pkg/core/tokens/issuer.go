@@ -46,7 +46,11 @@ func (j *jwtTokenIssuer) Generate(ctx context.Context, claims Claims, validFor t
 		ExpiresAt: jwt.NewNumericDate(now.Add(validFor)),
 	})
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	method, err := signingMethodFor(signingKey)
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
 	token.Header[KeyIDHeader] = strconv.Itoa(serialNumber)
 	tokenString, err := token.SignedString(signingKey)
 	if err != nil {
@@ -17,7 +17,6 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
-	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/core/tokens"
@@ -84,7 +83,7 @@ var _ = Describe("Token issuer", func() {
 	Context("Global Scoped tokens", func() {
 		BeforeEach(func() {
 			store = memory.NewStore()
-			secretManager := secret_manager.NewGlobalSecretManager(secret_store.NewSecretStore(store), cipher.None())
+			secretManager := secret_manager.NewGlobalSecretManager(secret_store.NewSecretStore(store))
 			signingKeyManager = tokens.NewSigningKeyManager(secretManager, TestTokenSigningKeyPrefix)
 			issuer = tokens.NewTokenIssuer(signingKeyManager)
 			validator = tokens.NewValidator(
@@ -224,4 +223,67 @@ var _ = Describe("Token issuer", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("Signing key algorithms", func() {
+		var secretManager manager.ResourceManager
+
+		BeforeEach(func() {
+			store = memory.NewStore()
+			secretManager = secret_manager.NewGlobalSecretManager(secret_store.NewSecretStore(store))
+			issuer = tokens.NewTokenIssuer(tokens.NewSigningKeyManager(secretManager, TestTokenSigningKeyPrefix))
+		})
+
+		DescribeTable("should issue and validate tokens",
+			func(algorithm string, expectedAlg string) {
+				// given signing key of the algorithm
+				key, err := tokens.NewSigningKeyWithAlgorithm(algorithm)
+				Expect(err).ToNot(HaveOccurred())
+				secret := system.NewGlobalSecretResource()
+				secret.Spec.Data = &wrapperspb.BytesValue{Value: key}
+				Expect(secretManager.Create(ctx, secret, core_store.CreateBy(tokens.SigningKeyResourceKey(TestTokenSigningKeyPrefix, 1, core_model.NoMesh)))).To(Succeed())
+
+				// and only public key of it
+				publicKey, err := tokens.PublicKeyFromSigningKey(key)
+				Expect(err).ToNot(HaveOccurred())
+				publicSecret := system.NewGlobalSecretResource()
+				publicSecret.Spec.Data = &wrapperspb.BytesValue{Value: publicKey}
+				Expect(secretManager.Create(ctx, publicSecret, core_store.CreateBy(tokens.SigningKeyResourceKey("test-token-signing-public-key", 1, core_model.NoMesh)))).To(Succeed())
+
+				// when
+				token, err := issuer.Generate(ctx, &TestClaims{}, time.Minute)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				parsed, _, err := new(jwt.Parser).ParseUnverified(token, &TestClaims{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parsed.Header["alg"]).To(Equal(expectedAlg))
+
+				// when validated with the private key
+				validator = tokens.NewValidator(
+					tokens.NewSigningKeyAccessor(secretManager, TestTokenSigningKeyPrefix),
+					tokens.NewRevocations(secretManager, TokenRevocationsGlobalSecretKey),
+					store_config.MemoryStore,
+				)
+				err = validator.ParseWithValidation(ctx, token, &TestClaims{})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when validated with the public key
+				validator = tokens.NewValidator(
+					tokens.NewSigningKeyFromPublicKeyAccessor(secretManager, "test-token-signing-public-key"),
+					tokens.NewRevocations(secretManager, TokenRevocationsGlobalSecretKey),
+					store_config.MemoryStore,
+				)
+				err = validator.ParseWithValidation(ctx, token, &TestClaims{})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			},
+			Entry("RSA", "RSA", "RS256"),
+			Entry("ECDSA P-256", "ECDSA-P256", "ES256"),
+			Entry("ECDSA P-384", "ECDSA-P384", "ES384"),
+			Entry("Ed25519", "Ed25519", "EdDSA"),
+		)
+	})
 })
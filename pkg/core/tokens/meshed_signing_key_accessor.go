@@ -2,7 +2,7 @@ package tokens
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 
 	"github.com/pkg/errors"
 
@@ -30,16 +30,16 @@ func NewMeshedSigningKeyAccessor(resManager manager.ResourceManager, signingKeyP
 	}
 }
 
-func (s *meshedSigningKeyAccessor) GetPublicKey(ctx context.Context, serialNumber int) (*rsa.PublicKey, error) {
+func (s *meshedSigningKeyAccessor) GetPublicKey(ctx context.Context, serialNumber int) (crypto.PublicKey, error) {
 	keyBytes, err := s.getKeyBytes(ctx, serialNumber)
 	if err != nil {
 		return nil, err
 	}
-	key, err := keyBytesToRsaPrivateKey(keyBytes)
+	key, err := keyBytesToPrivateKey(keyBytes)
 	if err != nil {
 		return nil, err
 	}
-	return &key.PublicKey, nil
+	return key.Public(), nil
 }
 
 func (s *meshedSigningKeyAccessor) getKeyBytes(ctx context.Context, serialNumber int) ([]byte, error) {
@@ -2,7 +2,7 @@ package tokens
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
@@ -11,16 +11,23 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 )
 
 // NewMeshedSigningKeyManager builds SigningKeyManager that is bound to a Mesh.
 // Some tokens like Dataplane Token are bound to a mesh.
 // In this case, singing key is also stored as a Secret in the Mesh, not as GlobalSecret.
 func NewMeshedSigningKeyManager(manager manager.ResourceManager, signingKeyPrefix string, mesh string) SigningKeyManager {
+	return NewMeshedSigningKeyManagerWithAlgorithm(manager, signingKeyPrefix, mesh, util_tls.RSAKeyAlgorithm)
+}
+
+// NewMeshedSigningKeyManagerWithAlgorithm builds SigningKeyManager bound to a Mesh that creates signing keys of the given algorithm.
+func NewMeshedSigningKeyManagerWithAlgorithm(manager manager.ResourceManager, signingKeyPrefix string, mesh string, keyAlgorithm string) SigningKeyManager {
 	return &meshedSigningKeyManager{
 		manager:          manager,
 		signingKeyPrefix: signingKeyPrefix,
 		mesh:             mesh,
+		keyAlgorithm:     keyAlgorithm,
 	}
 }
 
@@ -28,11 +35,12 @@ type meshedSigningKeyManager struct {
 	manager          manager.ResourceManager
 	signingKeyPrefix string
 	mesh             string
+	keyAlgorithm     string
 }
 
 var _ SigningKeyManager = &meshedSigningKeyManager{}
 
-func (s *meshedSigningKeyManager) GetLatestSigningKey(ctx context.Context) (*rsa.PrivateKey, int, error) {
+func (s *meshedSigningKeyManager) GetLatestSigningKey(ctx context.Context) (crypto.Signer, int, error) {
 	resources := system.SecretResourceList{}
 	if err := s.manager.List(ctx, &resources, store.ListByMesh(s.mesh)); err != nil {
 		return nil, 0, errors.Wrap(err, "could not retrieve signing key from secret manager")
@@ -45,7 +53,7 @@ func (s *meshedSigningKeyManager) CreateDefaultSigningKey(ctx context.Context) e
 }
 
 func (s *meshedSigningKeyManager) CreateSigningKey(ctx context.Context, serialNumber int) error {
-	key, err := NewSigningKey()
+	key, err := NewSigningKeyWithAlgorithm(s.keyAlgorithm)
 	if err != nil {
 		return err
 	}
@@ -2,7 +2,7 @@ package tokens
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 
 	"github.com/pkg/errors"
 
@@ -27,13 +27,13 @@ func NewSigningKeyFromPublicKeyAccessor(resManager manager.ResourceManager, sign
 	}
 }
 
-func (s *signingKeyFromPublicKeyAccessor) GetPublicKey(ctx context.Context, serialNumber int) (*rsa.PublicKey, error) {
+func (s *signingKeyFromPublicKeyAccessor) GetPublicKey(ctx context.Context, serialNumber int) (crypto.PublicKey, error) {
 	keyBytes, err := s.getKeyBytes(ctx, serialNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	return keyBytesToRsaPublicKey(keyBytes)
+	return keyBytesToPublicKey(keyBytes)
 }
 
 func (s *signingKeyFromPublicKeyAccessor) getKeyBytes(ctx context.Context, serialNumber int) ([]byte, error) {
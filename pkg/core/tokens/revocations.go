@@ -1,8 +1,12 @@
 package tokens
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"strings"
+	"sync"
+	"time"
 
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
@@ -14,11 +18,80 @@ import (
 // Revocations keeps track of revoked tokens.
 // If only one token is compromised, it's more convenient to revoke it instead of rotate signing key and regenerate all tokens.
 // Revocation list is stored as Secret (in case of mesh scoped tokens) or GlobalSecret (global scoped tokens).
-// IDs of token are stored in secret in comma separated format: "id1,id2".
+// See RevocationList for the format of the list.
 type Revocations interface {
 	IsRevoked(ctx context.Context, id string) (bool, error)
 }
 
+// RevokedToken is an entry of the revocation list.
+type RevokedToken struct {
+	ID        string     `json:"id"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	// ExpiresAt is the expiration time of the token. The entry can be pruned after it, because the token is no longer valid anyway.
+	// It's empty for tokens that never expire and tokens revoked before the expiration was recorded.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// RevocationList is stored in the Secret as JSON array of RevokedToken.
+// For the backwards compatibility, IDs of tokens in comma separated format ("id1,id2") are also accepted.
+type RevocationList []RevokedToken
+
+func ParseRevocationList(data []byte) (RevocationList, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if data[0] != '[' {
+		var list RevocationList
+		for _, id := range strings.Split(string(data), ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				list = append(list, RevokedToken{ID: id})
+			}
+		}
+		return list, nil
+	}
+	var list RevocationList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (l RevocationList) Marshal() ([]byte, error) {
+	if l == nil {
+		l = RevocationList{}
+	}
+	return json.Marshal(l)
+}
+
+func (l RevocationList) Contains(id string) bool {
+	for _, token := range l {
+		if token.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune returns the list without tokens that expired before now.
+func (l RevocationList) Prune(now time.Time) RevocationList {
+	var result RevocationList
+	for _, token := range l {
+		if token.ExpiresAt == nil || token.ExpiresAt.After(now) {
+			result = append(result, token)
+		}
+	}
+	return result
+}
+
+// NewRevocationsResource returns a resource in which the revocation list of the given key is stored.
+func NewRevocationsResource(revocationKey core_model.ResourceKey) core_model.Resource {
+	if revocationKey.Mesh == "" {
+		return system.NewGlobalSecretResource()
+	}
+	return system.NewSecretResource()
+}
+
 func NewRevocations(manager manager.ReadOnlyResourceManager, revocationKey core_model.ResourceKey) Revocations {
 	return &secretRevocations{
 		manager:       manager,
@@ -29,38 +102,53 @@ func NewRevocations(manager manager.ReadOnlyResourceManager, revocationKey core_
 type secretRevocations struct {
 	manager       manager.ReadOnlyResourceManager
 	revocationKey core_model.ResourceKey
+
+	// ids are parsed from the version of the Secret, so the list is not parsed on every validation
+	sync.RWMutex
+	version string
+	ids     map[string]struct{}
 }
 
 func (s *secretRevocations) IsRevoked(ctx context.Context, id string) (bool, error) {
-	data, err := s.getSecretData(ctx)
+	ids, err := s.revokedIDs(ctx)
 	if err != nil {
 		return false, err
 	}
-	if len(data) == 0 {
-		return false, nil
-	}
-	rawIds := strings.TrimSuffix(string(data), "\n")
-	ids := strings.Split(rawIds, ",")
-	for _, revokedId := range ids {
-		if revokedId == id {
-			return true, nil
-		}
-	}
-	return false, nil
+	_, revoked := ids[id]
+	return revoked, nil
 }
 
-func (s *secretRevocations) getSecretData(ctx context.Context) ([]byte, error) {
-	var resource core_model.Resource
-	if s.revocationKey.Mesh == "" {
-		resource = system.NewGlobalSecretResource()
-	} else {
-		resource = system.NewSecretResource()
-	}
+func (s *secretRevocations) revokedIDs(ctx context.Context) (map[string]struct{}, error) {
+	resource := NewRevocationsResource(s.revocationKey)
 	if err := s.manager.Get(ctx, resource, core_store.GetBy(s.revocationKey)); err != nil {
 		if core_store.IsResourceNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
 	}
-	return resource.GetSpec().(*system_proto.Secret).GetData().GetValue(), nil
+	version := resource.GetMeta().GetVersion()
+
+	s.RLock()
+	if version != "" && version == s.version {
+		defer s.RUnlock()
+		return s.ids, nil
+	}
+	s.RUnlock()
+
+	list, err := ParseRevocationList(resource.GetSpec().(*system_proto.Secret).GetData().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	// tokens that already expired are rejected anyway, so they don't have to be kept in memory
+	list = list.Prune(time.Now())
+	ids := make(map[string]struct{}, len(list))
+	for _, token := range list {
+		ids[token.ID] = struct{}{}
+	}
+
+	s.Lock()
+	s.version = version
+	s.ids = ids
+	s.Unlock()
+	return ids, nil
 }
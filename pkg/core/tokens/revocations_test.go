@@ -0,0 +1,118 @@
+package tokens_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/tokens"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Revocations", func() {
+
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	Describe("ParseRevocationList", func() {
+		It("should parse the list in comma separated format", func() {
+			// when
+			list, err := tokens.ParseRevocationList([]byte("id1,id2\n"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(Equal(tokens.RevocationList{{ID: "id1"}, {ID: "id2"}}))
+		})
+
+		It("should parse the structured list", func() {
+			// given
+			data, err := tokens.RevocationList{{ID: "id1", RevokedAt: &now, ExpiresAt: &later}}.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			list, err := tokens.ParseRevocationList(data)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].ID).To(Equal("id1"))
+			Expect(list[0].ExpiresAt.Equal(later)).To(BeTrue())
+		})
+	})
+
+	It("should prune expired tokens", func() {
+		// given
+		list := tokens.RevocationList{
+			{ID: "legacy"},
+			{ID: "expired", ExpiresAt: &now},
+			{ID: "valid", ExpiresAt: &later},
+		}
+
+		// when
+		pruned := list.Prune(now)
+
+		// then
+		Expect(pruned).To(Equal(tokens.RevocationList{{ID: "legacy"}, {ID: "valid", ExpiresAt: &later}}))
+	})
+
+	It("should pick up changes of the revocation list", func() {
+		// given
+		resManager := manager.NewResourceManager(memory.NewStore())
+		revocations := tokens.NewRevocations(resManager, TokenRevocationsGlobalSecretKey)
+
+		// when there is no list
+		revoked, err := revocations.IsRevoked(context.Background(), "id1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+
+		// when the token is added to the list
+		secret := system.NewGlobalSecretResource()
+		secret.Spec = &system_proto.Secret{Data: util_proto.Bytes([]byte(`[{"id":"id1"}]`))}
+		Expect(resManager.Create(context.Background(), secret, core_store.CreateBy(TokenRevocationsGlobalSecretKey))).To(Succeed())
+		revoked, err = revocations.IsRevoked(context.Background(), "id1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+
+		// when the token is removed from the list
+		secret.Spec = &system_proto.Secret{Data: util_proto.Bytes([]byte(`[{"id":"id2"}]`))}
+		Expect(resManager.Update(context.Background(), secret)).To(Succeed())
+		revoked, err = revocations.IsRevoked(context.Background(), "id1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+	})
+
+	It("should not keep expired tokens", func() {
+		// given
+		resManager := manager.NewResourceManager(memory.NewStore())
+		revocations := tokens.NewRevocations(resManager, TokenRevocationsGlobalSecretKey)
+		expired := time.Now().Add(-time.Minute)
+		data, err := tokens.RevocationList{{ID: "expired", ExpiresAt: &expired}, {ID: "legacy"}}.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		secret := system.NewGlobalSecretResource()
+		secret.Spec = &system_proto.Secret{Data: util_proto.Bytes(data)}
+		Expect(resManager.Create(context.Background(), secret, core_store.CreateBy(TokenRevocationsGlobalSecretKey))).To(Succeed())
+
+		// when
+		expiredRevoked, err := revocations.IsRevoked(context.Background(), "expired")
+		Expect(err).ToNot(HaveOccurred())
+		legacyRevoked, err := revocations.IsRevoked(context.Background(), "legacy")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(expiredRevoked).To(BeFalse())
+		Expect(legacyRevoked).To(BeTrue())
+	})
+})
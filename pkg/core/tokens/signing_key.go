@@ -1,28 +1,113 @@
 package tokens
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
-	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 )
 
+const (
+	rsaPublicKeyBlockType = "RSA PUBLIC KEY"
+	publicKeyBlockType    = "PUBLIC KEY"
+)
+
+// NewSigningKey generates an RSA signing key.
 func NewSigningKey() ([]byte, error) {
-	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	return NewSigningKeyWithAlgorithm(util_tls.RSAKeyAlgorithm)
+}
+
+// NewSigningKeyWithAlgorithm generates a signing key of the given algorithm.
+// Tokens are signed with RS256 for RSA keys, ES256 and ES384 for ECDSA keys and EdDSA for Ed25519 keys.
+func NewSigningKeyWithAlgorithm(algorithm string) ([]byte, error) {
+	keyType, err := util_tls.NewKeyType(algorithm, 0)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keyType()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate %s key", algorithm)
+	}
+	return util_tls.PEMEncodeKey(key)
+}
+
+// PublicKeyFromSigningKey returns PEM encoded public part of the signing key.
+// RSA keys are encoded as PKCS #1 for compatibility with older versions of Kuma, other keys as PKIX.
+func PublicKeyFromSigningKey(keyBytes []byte) ([]byte, error) {
+	key, err := keyBytesToPrivateKey(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	var block *pem.Block
+	switch publicKey := key.Public().(type) {
+	case *rsa.PublicKey:
+		block = &pem.Block{Type: rsaPublicKeyBlockType, Bytes: x509.MarshalPKCS1PublicKey(publicKey)}
+	default:
+		der, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		block = &pem.Block{Type: publicKeyBlockType, Bytes: der}
+	}
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, block); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseSigningKey parses PEM encoded private key and returns the method that tokens are signed with.
+func ParseSigningKey(keyBytes []byte) (crypto.Signer, jwt.SigningMethod, error) {
+	key, err := keyBytesToPrivateKey(keyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	method, err := signingMethodFor(key)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to generate RSA key")
+		return nil, nil, err
+	}
+	return key, method, nil
+}
+
+// ParsePublicKey parses PEM encoded public key in PKCS #1 or PKIX format.
+func ParsePublicKey(keyBytes []byte) (crypto.PublicKey, error) {
+	return keyBytesToPublicKey(keyBytes)
+}
+
+func signingMethodFor(key crypto.Signer) (jwt.SigningMethod, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return jwt.SigningMethodES384, nil
+		}
+		return nil, errors.Errorf("unsupported curve %s of the signing key", k.Curve.Params().Name)
+	case ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, errors.Errorf("unsupported signing key type %T", key)
 	}
-	return util_rsa.FromPrivateKeyToPEMBytes(key)
 }
 
 func SigningKeyResourceKey(signingKeyPrefix string, serialNumber int, mesh string) model.ResourceKey {
@@ -56,38 +141,37 @@ func IsSigningKeyNotFound(err error) bool {
 	return errors.As(err, &target)
 }
 
-func keyBytesToRsaPrivateKey(keyBytes []byte) (*rsa.PrivateKey, error) {
-	if util_rsa.IsPrivateKeyPEMBytes(keyBytes) {
-		key, err := util_rsa.FromPEMBytesToPrivateKey(keyBytes)
-		if err != nil {
-			return nil, err
-		}
-		return key, nil
+func keyBytesToPrivateKey(keyBytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyBytes)
+	if block == nil {
+		// support non-PEM RSA key for legacy reasons
+		return x509.ParsePKCS1PrivateKey(keyBytes)
 	}
-
-	// support non-PEM RSA key for legacy reasons
-	key, err := x509.ParsePKCS1PrivateKey(keyBytes)
+	key, err := util_tls.ParsePrivateKey(block.Bytes)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(err, "invalid key encoding %q", block.Type)
 	}
-	return key, nil
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported signing key type %T", key)
+	}
+	return signer, nil
 }
 
-func keyBytesToRsaPublicKey(keyBytes []byte) (*rsa.PublicKey, error) {
-	if util_rsa.IsPublicKeyPEMBytes(keyBytes) {
-		key, err := util_rsa.FromPEMBytesToPublicKey(keyBytes)
-		if err != nil {
-			return nil, err
-		}
-		return key, nil
+func keyBytesToPublicKey(keyBytes []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(keyBytes)
+	if block == nil {
+		// support non-PEM RSA key for legacy reasons
+		return x509.ParsePKCS1PublicKey(keyBytes)
 	}
-
-	// support non-PEM RSA key for legacy reasons
-	key, err := x509.ParsePKCS1PublicKey(keyBytes)
-	if err != nil {
-		return nil, err
+	switch block.Type {
+	case rsaPublicKeyBlockType:
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case publicKeyBlockType:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, errors.Errorf("invalid key encoding %q", block.Type)
 	}
-	return key, nil
 }
 
 func signingKeySerialNumber(secretName string, signingKeyPrefix string) (int, error) {
@@ -2,7 +2,7 @@ package tokens
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 )
@@ -12,7 +12,7 @@ import (
 // In that case, we could provide only public key to the CP via static configuration.
 // So we can easily do this by providing separate implementation for this interface.
 type SigningKeyAccessor interface {
-	GetPublicKey(ctx context.Context, serialNumber int) (*rsa.PublicKey, error)
+	GetPublicKey(ctx context.Context, serialNumber int) (crypto.PublicKey, error)
 	// GetLegacyKey returns legacy key. In pre 1.4.x version of Kuma, we used symmetric HMAC256 method of signing DP keys.
 	// In that case, we have to retrieve private key even for verification.
 	GetLegacyKey(ctx context.Context, serialNumber int) ([]byte, error)
@@ -32,17 +32,17 @@ func NewSigningKeyAccessor(resManager manager.ResourceManager, signingKeyPrefix
 	}
 }
 
-func (s *signingKeyAccessor) GetPublicKey(ctx context.Context, serialNumber int) (*rsa.PublicKey, error) {
+func (s *signingKeyAccessor) GetPublicKey(ctx context.Context, serialNumber int) (crypto.PublicKey, error) {
 	keyBytes, err := getKeyBytes(ctx, s.resManager, s.signingKeyPrefix, serialNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	key, err := keyBytesToRsaPrivateKey(keyBytes)
+	key, err := keyBytesToPrivateKey(keyBytes)
 	if err != nil {
 		return nil, err
 	}
-	return &key.PublicKey, nil
+	return key.Public(), nil
 }
 
 func (s *signingKeyAccessor) GetLegacyKey(ctx context.Context, serialNumber int) ([]byte, error) {
@@ -2,7 +2,7 @@ package tokens
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -13,6 +13,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 )
 
 const (
@@ -25,26 +26,34 @@ const (
 // "user-token-signing-key" has a serial number of 0
 // The latest key is  a key with a higher serial number (number at the end of the name)
 type SigningKeyManager interface {
-	GetLatestSigningKey(context.Context) (*rsa.PrivateKey, int, error)
+	GetLatestSigningKey(context.Context) (crypto.Signer, int, error)
 	CreateDefaultSigningKey(context.Context) error
 	CreateSigningKey(ctx context.Context, serialNumber int) error
 }
 
+// NewSigningKeyManager builds SigningKeyManager that creates RSA signing keys.
 func NewSigningKeyManager(manager manager.ResourceManager, signingKeyPrefix string) SigningKeyManager {
+	return NewSigningKeyManagerWithAlgorithm(manager, signingKeyPrefix, util_tls.RSAKeyAlgorithm)
+}
+
+// NewSigningKeyManagerWithAlgorithm builds SigningKeyManager that creates signing keys of the given algorithm.
+func NewSigningKeyManagerWithAlgorithm(manager manager.ResourceManager, signingKeyPrefix string, keyAlgorithm string) SigningKeyManager {
 	return &signingKeyManager{
 		manager:          manager,
 		signingKeyPrefix: signingKeyPrefix,
+		keyAlgorithm:     keyAlgorithm,
 	}
 }
 
 type signingKeyManager struct {
 	manager          manager.ResourceManager
 	signingKeyPrefix string
+	keyAlgorithm     string
 }
 
 var _ SigningKeyManager = &signingKeyManager{}
 
-func (s *signingKeyManager) GetLatestSigningKey(ctx context.Context) (*rsa.PrivateKey, int, error) {
+func (s *signingKeyManager) GetLatestSigningKey(ctx context.Context) (crypto.Signer, int, error) {
 	resources := system.GlobalSecretResourceList{}
 	if err := s.manager.List(ctx, &resources); err != nil {
 		return nil, 0, errors.Wrap(err, "could not retrieve signing key from secret manager")
@@ -52,7 +61,7 @@ func (s *signingKeyManager) GetLatestSigningKey(ctx context.Context) (*rsa.Priva
 	return latestSigningKey(&resources, s.signingKeyPrefix, model.NoMesh)
 }
 
-func latestSigningKey(list model.ResourceList, prefix string, mesh string) (*rsa.PrivateKey, int, error) {
+func latestSigningKey(list model.ResourceList, prefix string, mesh string) (crypto.Signer, int, error) {
 	var signingKey model.Resource
 	highestSerialNumber := -1
 	for _, resource := range list.GetItems() {
@@ -74,7 +83,7 @@ func latestSigningKey(list model.ResourceList, prefix string, mesh string) (*rsa
 		}
 	}
 
-	key, err := keyBytesToRsaPrivateKey(signingKey.GetSpec().(*system_proto.Secret).GetData().GetValue())
+	key, err := keyBytesToPrivateKey(signingKey.GetSpec().(*system_proto.Secret).GetData().GetValue())
 	if err != nil {
 		return nil, 0, err
 	}
@@ -87,7 +96,7 @@ func (s *signingKeyManager) CreateDefaultSigningKey(ctx context.Context) error {
 }
 
 func (s *signingKeyManager) CreateSigningKey(ctx context.Context, serialNumber int) error {
-	key, err := NewSigningKey()
+	key, err := NewSigningKeyWithAlgorithm(s.keyAlgorithm)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package tokens
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/pkg/errors"
@@ -10,6 +11,14 @@ import (
 	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
 )
 
+var allowedAlgs = []string{
+	jwt.SigningMethodHS256.Name,
+	jwt.SigningMethodRS256.Name,
+	jwt.SigningMethodES256.Name,
+	jwt.SigningMethodES384.Name,
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
 type Validator interface {
 	// ParseWithValidation parses token and fills data in provided Claims.
 	ParseWithValidation(ctx context.Context, token Token, claims Claims) error
@@ -40,10 +49,11 @@ func (j *jwtTokenValidator) ParseWithValidation(ctx context.Context, rawToken To
 		switch token.Method.Alg() {
 		case jwt.SigningMethodHS256.Name:
 			return j.keyAccessor.GetLegacyKey(ctx, serialNumber)
-		case jwt.SigningMethodRS256.Name:
+		case jwt.SigningMethodRS256.Name, jwt.SigningMethodES256.Name, jwt.SigningMethodES384.Name, jwt.SigningMethodEdDSA.Alg():
+			// the signing method verifies that the type of the key matches the alg
 			return j.keyAccessor.GetPublicKey(ctx, serialNumber)
 		default:
-			return nil, errors.Errorf("unknown token alg. Allowed algs are %s and %s", jwt.SigningMethodHS256.Name, jwt.SigningMethodRS256.Name)
+			return nil, errors.Errorf("unknown token alg. Allowed algs are %s", strings.Join(allowedAlgs, ", "))
 		}
 	})
 	if err != nil {
@@ -2,6 +2,9 @@ package user
 
 import "strings"
 
+// SystemPrefix is a prefix of users and groups defined by Kuma.
+const SystemPrefix = "mesh-system:"
+
 const AuthenticatedGroup = "mesh-system:authenticated"
 
 type User struct {
@@ -29,3 +32,8 @@ var Anonymous = User{
 	Name:   "mesh-system:anonymous",
 	Groups: []string{"mesh-system:unauthenticated"},
 }
+
+// ControlPlane is a static user of operations that the Control Plane does on its own (ex. reverting log levels of proxies).
+var ControlPlane = User{
+	Name: "mesh-system:control-plane",
+}
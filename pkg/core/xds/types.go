@@ -178,6 +178,8 @@ type Routing struct {
 
 type CaSecret struct {
 	PemCerts [][]byte
+	// PemCrls are revocation lists of CAs. They are empty unless some of the certificates were revoked.
+	PemCrls [][]byte
 }
 
 type IdentitySecret struct {
@@ -26,14 +26,15 @@ var log = core.Log.WithName("defaults")
 func Setup(runtime runtime.Runtime) error {
 	defaultsComponent := NewDefaultsComponent(runtime.Config().Defaults, runtime.Config().Mode, runtime.Config().Environment, runtime.ResourceManager(), runtime.ResourceStore())
 
-	zoneIngressSigningKeyManager := tokens.NewSigningKeyManager(runtime.ResourceManager(), zoneingress.ZoneIngressSigningKeyPrefix)
+	signingKeyAlgorithm := runtime.Config().Defaults.SigningKeyAlgorithm
+	zoneIngressSigningKeyManager := tokens.NewSigningKeyManagerWithAlgorithm(runtime.ResourceManager(), zoneingress.ZoneIngressSigningKeyPrefix, signingKeyAlgorithm)
 	if err := runtime.Add(tokens.NewDefaultSigningKeyComponent(
 		zoneIngressSigningKeyManager,
 		log.WithValues("secretPrefix", zoneingress.ZoneIngressSigningKeyPrefix))); err != nil {
 		return err
 	}
 
-	zoneSigningKeyManager := tokens.NewSigningKeyManager(runtime.ResourceManager(), zone.SigningKeyPrefix)
+	zoneSigningKeyManager := tokens.NewSigningKeyManagerWithAlgorithm(runtime.ResourceManager(), zone.SigningKeyPrefix, signingKeyAlgorithm)
 	if err := runtime.Add(tokens.NewDefaultSigningKeyComponent(
 		zoneSigningKeyManager,
 		log.WithValues("secretPrefix", zoneingress.ZoneIngressSigningKeyPrefix),
@@ -24,7 +24,7 @@ var log = core.Log.WithName("defaults").WithName("mesh")
 // 2 invocation can check that TrafficPermission is absent, but it was just created, so it tries to created it which results in error
 var ensureMux = sync.Mutex{}
 
-func EnsureDefaultMeshResources(ctx context.Context, resManager manager.ResourceManager, meshName string) error {
+func EnsureDefaultMeshResources(ctx context.Context, resManager manager.ResourceManager, meshName string, signingKeyAlgorithm string) error {
 	ensureMux.Lock()
 	defer ensureMux.Unlock()
 
@@ -57,7 +57,7 @@ func EnsureDefaultMeshResources(ctx context.Context, resManager manager.Resource
 		log.Info(msg, "mesh", meshName, "name", key.Name)
 	}
 
-	created, err := ensureDataplaneTokenSigningKey(ctx, resManager, meshName)
+	created, err := ensureDataplaneTokenSigningKey(ctx, resManager, meshName, signingKeyAlgorithm)
 	if err != nil {
 		return errors.Wrap(err, "could not create default Dataplane Token Signing Key")
 	}
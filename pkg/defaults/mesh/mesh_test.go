@@ -2,6 +2,7 @@ package mesh_test
 
 import (
 	"context"
+	"crypto/ecdsa"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -14,6 +15,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/tokens"
 	"github.com/kumahq/kuma/pkg/defaults/mesh"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
@@ -31,7 +33,7 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 
 	It("should create default resources", func() {
 		// when
-		err := mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh)
+		err := mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh, util_tls.RSAKeyAlgorithm)
 		Expect(err).ToNot(HaveOccurred())
 
 		// then default TrafficPermission for the mesh exist
@@ -53,11 +55,11 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 
 	It("should ignore subsequent calls to EnsureDefaultMeshResources", func() {
 		// given already ensured default resources
-		err := mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh)
+		err := mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh, util_tls.RSAKeyAlgorithm)
 		Expect(err).ToNot(HaveOccurred())
 
 		// when ensuring again
-		err = mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh)
+		err = mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh, util_tls.RSAKeyAlgorithm)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -72,4 +74,18 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 		err = resManager.Get(context.Background(), system.NewSecretResource(), core_store.GetBy(tokens.SigningKeyResourceKey(issuer.DataplaneTokenSigningKeyPrefix(model.DefaultMesh), tokens.DefaultSerialNumber, model.DefaultMesh)))
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("should create Dataplane Token Signing Key of the given algorithm", func() {
+		// when
+		err := mesh.EnsureDefaultMeshResources(context.Background(), resManager, model.DefaultMesh, util_tls.ECDSAP256KeyAlgorithm)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		secret := system.NewSecretResource()
+		err = resManager.Get(context.Background(), secret, core_store.GetBy(tokens.SigningKeyResourceKey(issuer.DataplaneTokenSigningKeyPrefix(model.DefaultMesh), tokens.DefaultSerialNumber, model.DefaultMesh)))
+		Expect(err).ToNot(HaveOccurred())
+		key, _, err := tokens.ParseSigningKey(secret.Spec.GetData().GetValue())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+	})
 })
@@ -8,8 +8,8 @@ import (
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
-func ensureDataplaneTokenSigningKey(ctx context.Context, resManager manager.ResourceManager, meshName string) (created bool, err error) {
-	signingKeyManager := tokens.NewMeshedSigningKeyManager(resManager, issuer.DataplaneTokenSigningKeyPrefix(meshName), meshName)
+func ensureDataplaneTokenSigningKey(ctx context.Context, resManager manager.ResourceManager, meshName string, signingKeyAlgorithm string) (created bool, err error) {
+	signingKeyManager := tokens.NewMeshedSigningKeyManagerWithAlgorithm(resManager, issuer.DataplaneTokenSigningKeyPrefix(meshName), meshName, signingKeyAlgorithm)
 	_, _, err = signingKeyManager.GetLatestSigningKey(ctx)
 	if err == nil {
 		return false, nil
@@ -0,0 +1,168 @@
+package v3
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	"github.com/pkg/errors"
+)
+
+// JsonFormatLogNamePrefix marks log names of `envoy.access_loggers.http_grpc`
+// and `envoy.tcp_grpc_access_log` that carry a structured access log format.
+const JsonFormatLogNamePrefix = "json"
+
+// JsonFormatField represents a single field of a structured access log record.
+type JsonFormatField struct {
+	Name   string
+	Format *AccessLogFormat
+}
+
+// JsonFormat represents a structured access log format, where every field
+// of a log record is rendered according to its own format string.
+// Log records are rendered as JSON objects, one per line.
+type JsonFormat struct {
+	// Fields sorted by name.
+	Fields []JsonFormatField
+}
+
+// ParseJsonFormat parses format strings of all fields of a structured access log format.
+func ParseJsonFormat(fields map[string]string) (*JsonFormat, error) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	jsonFormat := &JsonFormat{}
+	for _, name := range names {
+		format, err := ParseFormat(fields[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %q", name)
+		}
+		jsonFormat.Fields = append(jsonFormat.Fields, JsonFormatField{
+			Name:   name,
+			Format: format,
+		})
+	}
+	return jsonFormat, nil
+}
+
+// ValidateJsonFormat validates whether given format strings of a structured access log format are valid.
+func ValidateJsonFormat(fields map[string]string) error {
+	_, err := ParseJsonFormat(fields)
+	return err
+}
+
+func (f *JsonFormat) FormatHttpLogEntry(entry *accesslog_data.HTTPAccessLogEntry) (string, error) {
+	record := map[string]string{}
+	for _, field := range f.Fields {
+		value, err := field.Format.FormatHttpLogEntry(entry)
+		if err != nil {
+			return "", err
+		}
+		record[field.Name] = value
+	}
+	return f.marshal(record)
+}
+
+func (f *JsonFormat) FormatTcpLogEntry(entry *accesslog_data.TCPAccessLogEntry) (string, error) {
+	record := map[string]string{}
+	for _, field := range f.Fields {
+		value, err := field.Format.FormatTcpLogEntry(entry)
+		if err != nil {
+			return "", err
+		}
+		record[field.Name] = value
+	}
+	return f.marshal(record)
+}
+
+func (f *JsonFormat) marshal(record map[string]string) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(record); err != nil { // Encode() terminates the record with a newline
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (f *JsonFormat) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
+	for _, field := range f.Fields {
+		if err := field.Format.ConfigureHttpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *JsonFormat) ConfigureTcpLog(config *accesslog_config.TcpGrpcAccessLogConfig) error {
+	for _, field := range f.Fields {
+		if err := field.Format.ConfigureTcpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Interpolate returns a structured access log format with placeholders of all fields resolved.
+func (f *JsonFormat) Interpolate(variables InterpolationVariables) (*JsonFormat, error) {
+	interpolated := &JsonFormat{}
+	for _, field := range f.Fields {
+		format, err := field.Format.Interpolate(variables)
+		if err != nil {
+			return nil, err
+		}
+		interpolated.Fields = append(interpolated.Fields, JsonFormatField{
+			Name:   field.Name,
+			Format: format,
+		})
+	}
+	return interpolated, nil
+}
+
+// Map returns canonical format strings of all fields.
+func (f *JsonFormat) Map() map[string]string {
+	fields := map[string]string{}
+	for _, field := range f.Fields {
+		fields[field.Name] = field.Format.String()
+	}
+	return fields
+}
+
+// String returns the canonical representation of this format,
+// i.e. a JSON object of field names and their format strings.
+func (f *JsonFormat) String() string {
+	data, err := json.Marshal(f.Map())
+	if err != nil { // map[string]string can always be marshaled
+		panic(err)
+	}
+	return string(data)
+}
+
+// TextFormat returns a text access log format that renders records as JSON objects.
+// Unlike the structured format, it does not escape values of the fields.
+// It is used with kuma-dp that does not support structured access log formats.
+func (f *JsonFormat) TextFormat() (*AccessLogFormat, error) {
+	var buf strings.Builder
+	buf.WriteString("{")
+	for i, field := range f.Fields {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteString(`:"`)
+		buf.WriteString(field.Format.String())
+		buf.WriteString(`"`)
+	}
+	buf.WriteString("}\n")
+	return ParseFormat(buf.String())
+}
@@ -0,0 +1,162 @@
+package v3_test
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ParseJsonFormat()", func() {
+
+	Context("valid json format", func() {
+
+		It("should format HTTP and TCP log entries as JSON objects", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"protocol": "%PROTOCOL%",
+				"sent":     "%BYTES_SENT%",
+				"request":  `"%REQ(:METHOD)% %REQ(:PATH)%"`,
+				"kind":     "access",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := format.FormatHttpLogEntry(&accesslog_data.HTTPAccessLogEntry{
+				ProtocolVersion: accesslog_data.HTTPAccessLogEntry_HTTP2,
+				Request: &accesslog_data.HTTPRequestProperties{
+					RequestMethod: envoy_core.RequestMethod_GET,
+					Path:          "/api?a=<b>&c=d",
+				},
+				Response: &accesslog_data.HTTPResponseProperties{
+					ResponseBodyBytes: 123,
+				},
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(Equal(`{"kind":"access","protocol":"HTTP/2","request":"\"GET /api?a=<b>&c=d\"","sent":"123"}` + "\n"))
+
+			// when
+			actual, err = format.FormatTcpLogEntry(&accesslog_data.TCPAccessLogEntry{
+				ConnectionProperties: &accesslog_data.ConnectionProperties{
+					SentBytes: 456,
+				},
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(Equal(`{"kind":"access","protocol":"-","request":"\"- -\"","sent":"456"}` + "\n"))
+		})
+
+		It("should configure additional headers", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"tenant": "%REQ(X-TENANT)%",
+				"type":   "%RESP(CONTENT-TYPE)%",
+				"status": "%TRAILER(GRPC-STATUS)%",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			config := &accesslog_config.HttpGrpcAccessLogConfig{}
+			err = format.ConfigureHttpLog(config)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			actual, err := util_proto.ToYAML(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+            additionalRequestHeadersToLog:
+            - x-tenant
+            additionalResponseHeadersToLog:
+            - content-type
+            additionalResponseTrailersToLog:
+            - grpc-status
+`))
+		})
+
+		It("should have a canonical representation", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"source":      "%KUMA_SOURCE_SERVICE%",
+				"destination": "%KUMA_DESTINATION_SERVICE%",
+				"duration":    "%DURATION%",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// expect
+			Expect(format.String()).To(Equal(`{"destination":"%KUMA_DESTINATION_SERVICE%","duration":"%DURATION%","source":"%KUMA_SOURCE_SERVICE%"}`))
+		})
+
+		It("should convert to a text format", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"protocol": "%PROTOCOL%",
+				"sent":     "%BYTES_SENT%",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			textFormat, err := format.TextFormat()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := textFormat.FormatTcpLogEntry(&accesslog_data.TCPAccessLogEntry{
+				ConnectionProperties: &accesslog_data.ConnectionProperties{
+					SentBytes: 456,
+				},
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(Equal(`{"protocol":"-","sent":"456"}` + "\n"))
+		})
+
+		It("should bind to a given context", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"route":    "%KUMA_SOURCE_SERVICE% -> %KUMA_DESTINATION_SERVICE%",
+				"duration": "%DURATION%",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := format.Interpolate(InterpolationVariables{
+				"KUMA_SOURCE_SERVICE":      "web",
+				"KUMA_DESTINATION_SERVICE": "backend",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual.Map()).To(Equal(map[string]string{
+				"route":    "web -> backend",
+				"duration": "%DURATION%",
+			}))
+		})
+	})
+
+	Context("invalid json format", func() {
+
+		It("should reject a field with invalid format string", func() {
+			// when
+			format, err := ParseJsonFormat(map[string]string{
+				"sent": "%bytes_sent%",
+			})
+			// then
+			Expect(err).To(MatchError(`field "sent": format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`))
+			// and
+			Expect(format).To(BeNil())
+		})
+	})
+})
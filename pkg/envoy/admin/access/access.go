@@ -1,7 +1,13 @@
 package access
 
-import "github.com/kumahq/kuma/pkg/core/user"
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
 
 type ConfigDumpAccess interface {
-	ValidateViewConfigDump(user user.User) error
+	// ValidateViewConfigDump validates an access to the config dump, stats, clusters and listeners of the proxy.
+	ValidateViewConfigDump(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error
+	// ValidateChangeLogLevel validates an access to changing log levels of the proxy.
+	ValidateChangeLogLevel(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error
 }
@@ -1,12 +1,19 @@
 package access
 
-import "github.com/kumahq/kuma/pkg/core/user"
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
 
 type NoopConfigDumpAccess struct {
 }
 
 var _ ConfigDumpAccess = NoopConfigDumpAccess{}
 
-func (n NoopConfigDumpAccess) ValidateViewConfigDump(_ user.User) error {
+func (n NoopConfigDumpAccess) ValidateViewConfigDump(model.ResourceKey, model.ResourceTypeDescriptor, user.User) error {
+	return nil
+}
+
+func (n NoopConfigDumpAccess) ValidateChangeLogLevel(model.ResourceKey, model.ResourceTypeDescriptor, user.User) error {
 	return nil
 }
@@ -0,0 +1,31 @@
+package access
+
+import (
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacConfigDumpAccess struct {
+	authorizer rbac.Authorizer
+}
+
+var _ ConfigDumpAccess = &rbacConfigDumpAccess{}
+
+// NewRBACConfigDumpAccess requires the "inspect" verb on the type of the proxy
+// (Dataplane, ZoneIngress or ZoneEgress) to view its config dump and the "update"
+// verb to change its log levels.
+func NewRBACConfigDumpAccess(authorizer rbac.Authorizer) ConfigDumpAccess {
+	return &rbacConfigDumpAccess{
+		authorizer: authorizer,
+	}
+}
+
+func (r *rbacConfigDumpAccess) ValidateViewConfigDump(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbInspect, desc.Name, rbac.MeshOf(key, desc))
+}
+
+func (r *rbacConfigDumpAccess) ValidateChangeLogLevel(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbUpdate, desc.Name, rbac.MeshOf(key, desc))
+}
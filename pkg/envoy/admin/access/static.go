@@ -3,34 +3,58 @@ package access
 import (
 	config_access "github.com/kumahq/kuma/pkg/config/access"
 	"github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/user"
 )
 
 type staticConfigDumpAccess struct {
+	view           allowList
+	changeLogLevel allowList
+}
+
+type allowList struct {
 	usernames map[string]bool
 	groups    map[string]bool
 }
 
 var _ ConfigDumpAccess = &staticConfigDumpAccess{}
 
-func NewStaticConfigDumpAccess(cfg config_access.ViewConfigDumpStaticAccessConfig) ConfigDumpAccess {
-	s := &staticConfigDumpAccess{
+func NewStaticConfigDumpAccess(
+	viewCfg config_access.ViewConfigDumpStaticAccessConfig,
+	changeLogLevelCfg config_access.ChangeLogLevelStaticAccessConfig,
+) ConfigDumpAccess {
+	return &staticConfigDumpAccess{
+		view:           newAllowList(viewCfg.Users, viewCfg.Groups),
+		changeLogLevel: newAllowList(changeLogLevelCfg.Users, changeLogLevelCfg.Groups),
+	}
+}
+
+func newAllowList(users, groups []string) allowList {
+	a := allowList{
 		usernames: map[string]bool{},
 		groups:    map[string]bool{},
 	}
-	for _, usr := range cfg.Users {
-		s.usernames[usr] = true
+	for _, usr := range users {
+		a.usernames[usr] = true
 	}
-	for _, group := range cfg.Groups {
-		s.groups[group] = true
+	for _, group := range groups {
+		a.groups[group] = true
 	}
-	return s
+	return a
+}
+
+func (s *staticConfigDumpAccess) ValidateViewConfigDump(_ model.ResourceKey, _ model.ResourceTypeDescriptor, user user.User) error {
+	return s.view.validate(user)
+}
+
+func (s *staticConfigDumpAccess) ValidateChangeLogLevel(_ model.ResourceKey, _ model.ResourceTypeDescriptor, user user.User) error {
+	return s.changeLogLevel.validate(user)
 }
 
-func (s *staticConfigDumpAccess) ValidateViewConfigDump(user user.User) error {
-	allowed := s.usernames[user.Name]
+func (a allowList) validate(user user.User) error {
+	allowed := a.usernames[user.Name]
 	for _, group := range user.Groups {
-		if s.groups[group] {
+		if a.groups[group] {
 			allowed = true
 		}
 	}
@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
@@ -33,6 +34,17 @@ type ResourceWithAddress interface {
 type EnvoyAdminClient interface {
 	PostQuit(dataplane *core_mesh.DataplaneResource) error
 	ConfigDump(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error)
+	// Stats returns stats of the proxy in the text format. Filter is a regex that limits the stats to the matching names.
+	Stats(proxy ResourceWithAddress, defaultAdminPort uint32, filter string) ([]byte, error)
+	// Clusters returns upstream clusters of the proxy with health and outlier detection status of every host in JSON.
+	Clusters(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error)
+	// Listeners returns listeners of the proxy in JSON.
+	Listeners(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error)
+	// LogLevels returns log levels of every logger of the proxy by the name of the logger.
+	LogLevels(proxy ResourceWithAddress, defaultAdminPort uint32) (map[string]string, error)
+	// SetLogLevel changes the log level of the logger of the proxy, empty logger changes the level of all loggers.
+	// It returns log levels after the change.
+	SetLogLevel(proxy ResourceWithAddress, defaultAdminPort uint32, logger string, level string) (map[string]string, error)
 }
 
 type envoyAdminClient struct {
@@ -175,7 +187,100 @@ func (a *envoyAdminClient) PostQuit(dataplane *core_mesh.DataplaneResource) erro
 	return nil
 }
 
-func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminAddress uint32) ([]byte, error) {
+func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
+	configDump, err := a.executeRequest(proxy, defaultAdminPort, "GET", "config_dump", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cd := &envoy_admin_v3.ConfigDump{}
+	if err := util_proto.FromJSON(configDump, cd); err != nil {
+		return nil, err
+	}
+
+	if err := Sanitize(cd); err != nil {
+		return nil, err
+	}
+
+	return util_proto.ToJSONIndent(cd, " ")
+}
+
+func (a *envoyAdminClient) Stats(proxy ResourceWithAddress, defaultAdminPort uint32, filter string) ([]byte, error) {
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	return a.executeRequest(proxy, defaultAdminPort, "GET", "stats", query)
+}
+
+func (a *envoyAdminClient) Clusters(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
+	clusters, err := a.executeRequest(proxy, defaultAdminPort, "GET", "clusters", url.Values{"format": []string{"json"}})
+	if err != nil {
+		return nil, err
+	}
+	cs := &envoy_admin_v3.Clusters{}
+	if err := util_proto.FromJSON(clusters, cs); err != nil {
+		return nil, err
+	}
+	return util_proto.ToJSONIndent(cs, " ")
+}
+
+func (a *envoyAdminClient) Listeners(proxy ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
+	listeners, err := a.executeRequest(proxy, defaultAdminPort, "GET", "listeners", url.Values{"format": []string{"json"}})
+	if err != nil {
+		return nil, err
+	}
+	ls := &envoy_admin_v3.Listeners{}
+	if err := util_proto.FromJSON(listeners, ls); err != nil {
+		return nil, err
+	}
+	return util_proto.ToJSONIndent(ls, " ")
+}
+
+func (a *envoyAdminClient) LogLevels(proxy ResourceWithAddress, defaultAdminPort uint32) (map[string]string, error) {
+	// Envoy lists active loggers when /logging is called without parameters
+	levels, err := a.executeRequest(proxy, defaultAdminPort, "POST", "logging", nil)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogLevels(levels), nil
+}
+
+func (a *envoyAdminClient) SetLogLevel(proxy ResourceWithAddress, defaultAdminPort uint32, logger string, level string) (map[string]string, error) {
+	query := url.Values{}
+	if logger == "" {
+		query.Set("level", level)
+	} else {
+		query.Set(logger, level)
+	}
+	levels, err := a.executeRequest(proxy, defaultAdminPort, "POST", "logging", query)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogLevels(levels), nil
+}
+
+// ParseLogLevels parses the list of active loggers returned by /logging endpoint of Envoy, e.g.
+//
+//	active loggers:
+//	  admin: info
+//	  http: debug
+func ParseLogLevels(text []byte) map[string]string {
+	levels := map[string]string{}
+	for _, line := range strings.Split(string(text), "\n") {
+		if !strings.HasPrefix(line, "  ") {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimSpace(line), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		levels[parts[0]] = strings.TrimSpace(parts[1])
+	}
+	return levels
+}
+
+func (a *envoyAdminClient) executeRequest(proxy ResourceWithAddress, defaultAdminPort uint32, method string, path string, query url.Values) ([]byte, error) {
 	var httpClient *http.Client
 	var err error
 	u := &url.URL{}
@@ -197,43 +302,35 @@ func (a *envoyAdminClient) ConfigDump(proxy ResourceWithAddress, defaultAdminAdd
 		return nil, errors.New("unsupported proxy type")
 	}
 
-	if host, _, err := net.SplitHostPort(proxy.AdminAddress(defaultAdminAddress)); err == nil && host == "127.0.0.1" {
+	if host, _, err := net.SplitHostPort(proxy.AdminAddress(defaultAdminPort)); err == nil && host == "127.0.0.1" {
 		httpClient = &http.Client{
 			Timeout: 5 * time.Second,
 		}
 		u.Scheme = "http"
 	}
 
-	u.Host = proxy.AdminAddress(defaultAdminAddress)
-	u.Path = "config_dump"
-	request, err := http.NewRequest("GET", u.String(), nil)
+	u.Host = proxy.AdminAddress(defaultAdminPort)
+	u.Path = path
+	u.RawQuery = query.Encode()
+	request, err := http.NewRequest(method, u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to send GET to %s", "config_dump")
+		return nil, errors.Wrapf(err, "unable to send %s to %s", method, path)
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, response.Body)
-	}
-
-	configDump, err := io.ReadAll(response.Body)
+	body, err := io.ReadAll(response.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	cd := &envoy_admin_v3.ConfigDump{}
-	if err := util_proto.FromJSON(configDump, cd); err != nil {
-		return nil, err
-	}
-
-	if err := Sanitize(cd); err != nil {
-		return nil, err
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, body)
 	}
 
-	return util_proto.ToJSONIndent(cd, " ")
+	return body, nil
 }
@@ -0,0 +1,158 @@
+package admin_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("EnvoyAdminClient", func() {
+
+	var client admin.EnvoyAdminClient
+	var dataplane *core_mesh.DataplaneResource
+	var requests chan *http.Request
+	var response string
+
+	BeforeEach(func() {
+		requests = make(chan *http.Request, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requests <- req
+			if req.URL.Query().Get("http") == "invalid" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+
+		rm := manager.NewResourceManager(memory.NewStore())
+		Expect(rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", core_model.NoMesh))).To(Succeed())
+
+		var err error
+		client, err = admin.NewEnvoyAdminClient(
+			rm,
+			nil,
+			filepath.Join("..", "..", "..", "test", "certs", "client-cert.pem"),
+			filepath.Join("..", "..", "..", "test", "certs", "client-key.pem"),
+			9901,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		serverURL, err := url.Parse(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		_, port, err := net.SplitHostPort(serverURL.Host)
+		Expect(err).ToNot(HaveOccurred())
+		adminPort, err := strconv.ParseUint(port, 10, 32)
+		Expect(err).ToNot(HaveOccurred())
+
+		dataplane = &core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{Mesh: "default", Name: "backend-1"},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Admin: &mesh_proto.EnvoyAdmin{
+						Port: uint32(adminPort),
+					},
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{mesh_proto.ServiceTag: "backend"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should fetch filtered stats", func() {
+		// given
+		response = "cluster.backend.upstream_rq_total: 5\n"
+
+		// when
+		stats, err := client.Stats(dataplane, 0, "^cluster\\.backend\\.")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(stats)).To(Equal(response))
+		// and
+		req := <-requests
+		Expect(req.Method).To(Equal("GET"))
+		Expect(req.URL.Path).To(Equal("/stats"))
+		Expect(req.URL.Query().Get("filter")).To(Equal("^cluster\\.backend\\."))
+	})
+
+	It("should fetch clusters with health status of hosts", func() {
+		// given
+		response = `{"cluster_statuses":[{"name":"backend","host_statuses":[{"address":{"socket_address":{"address":"192.168.0.1","port_value":8080}},"health_status":{"failed_outlier_check":true,"eds_health_status":"HEALTHY"}}]}]}`
+
+		// when
+		clusters, err := client.Clusters(dataplane, 0)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clusters).To(matchers.MatchGoldenJSON(filepath.Join("testdata", "golden.clusters.json")))
+		// and
+		req := <-requests
+		Expect(req.URL.Path).To(Equal("/clusters"))
+		Expect(req.URL.Query().Get("format")).To(Equal("json"))
+	})
+
+	It("should change log level of a logger", func() {
+		// given
+		response = "active loggers:\n  admin: info\n  http: debug\n"
+
+		// when
+		levels, err := client.SetLogLevel(dataplane, 0, "http", "debug")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(levels).To(Equal(map[string]string{
+			"admin": "info",
+			"http":  "debug",
+		}))
+		// and
+		req := <-requests
+		Expect(req.Method).To(Equal("POST"))
+		Expect(req.URL.Path).To(Equal("/logging"))
+		Expect(req.URL.RawQuery).To(Equal("http=debug"))
+	})
+
+	It("should change log level of all loggers", func() {
+		// given
+		response = "active loggers:\n  admin: trace\n  http: trace\n"
+
+		// when
+		_, err := client.SetLogLevel(dataplane, 0, "", "trace")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect((<-requests).URL.RawQuery).To(Equal("level=trace"))
+	})
+
+	It("should return an error of Envoy", func() {
+		// given
+		response = "error: unknown logger level"
+
+		// when
+		_, err := client.SetLogLevel(dataplane, 0, "http", "invalid")
+
+		// then
+		Expect(err).To(MatchError("envoy response [400 400 Bad Request] [error: unknown logger level]"))
+	})
+})
@@ -0,0 +1,381 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sethvargo/go-retry"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+var log = core.Log.WithName("envoy-admin")
+
+const logLevelsConfigKeyPrefix = "kuma-log-levels-"
+
+// LogLevelsConfigKey returns the name of the Config that holds the pending revert of log levels of the proxy.
+func LogLevelsConfigKey(resType core_model.ResourceType, key core_model.ResourceKey) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", resType, key.Mesh, key.Name)))
+	return fmt.Sprintf("%s%x", logLevelsConfigKeyPrefix, hash[:16])
+}
+
+// pendingRevert is stored as JSON in the Config. Reverted marks that the revert
+// has started, levels are being restored and the Config is about to be deleted.
+type pendingRevert struct {
+	Type     core_model.ResourceType `json:"type"`
+	Mesh     string                  `json:"mesh,omitempty"`
+	Name     string                  `json:"name"`
+	Original map[string]string       `json:"original"`
+	RevertAt time.Time               `json:"revertAt"`
+	Reverted bool                    `json:"reverted,omitempty"`
+}
+
+func (p *pendingRevert) key() core_model.ResourceKey {
+	return core_model.ResourceKey{Mesh: p.Mesh, Name: p.Name}
+}
+
+func readPendingRevert(config *system.ConfigResource) (*pendingRevert, error) {
+	pending := &pendingRevert{}
+	if err := json.Unmarshal([]byte(config.Spec.GetConfig()), pending); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal pending revert of log levels")
+	}
+	return pending, nil
+}
+
+func writePendingRevert(config *system.ConfigResource, pending *pendingRevert) error {
+	bytes, err := json.Marshal(pending)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal pending revert of log levels")
+	}
+	config.Spec.Config = string(bytes)
+	return nil
+}
+
+var errRevertInProgress = errors.New("log levels of the proxy are being reverted, try again later")
+
+// setConflictRetries limits how many times Set is retried when the pending revert was concurrently changed
+const setConflictRetries = 5
+
+// TemporaryLogLevels changes log levels of proxies for a limited time.
+// Levels from before the change are stored in a Config until LogLevelsReverter
+// restores them, so the revert survives restarts of the Control Plane and
+// every instance of the Control Plane sees the same pending revert.
+type TemporaryLogLevels struct {
+	client        EnvoyAdminClient
+	configManager config_manager.ConfigManager
+}
+
+func NewTemporaryLogLevels(client EnvoyAdminClient, configManager config_manager.ConfigManager) *TemporaryLogLevels {
+	return &TemporaryLogLevels{
+		client:        client,
+		configManager: configManager,
+	}
+}
+
+// Set changes the log level of the logger of the proxy, empty logger changes the level of all loggers.
+// Levels are reverted after the ttl. If levels of the proxy were already changed and not yet reverted,
+// the revert is postponed and it restores levels from before the first change.
+// It returns log levels after the change and the time of the revert.
+func (t *TemporaryLogLevels) Set(ctx context.Context, proxy ResourceWithAddress, defaultAdminPort uint32, logger string, level string, ttl time.Duration) (map[string]string, time.Time, error) {
+	var levels map[string]string
+	var revertAt time.Time
+	backoff := retry.WithMaxRetries(setConflictRetries, retry.NewExponential(10*time.Millisecond))
+	err := retry.Do(ctx, backoff, func(ctx context.Context) error {
+		var err error
+		levels, revertAt, err = t.set(ctx, proxy, defaultAdminPort, logger, level, ttl)
+		// levels of the same proxy can be changed concurrently by many instances of the Control Plane
+		if store.IsResourceConflict(err) || store.IsResourceAlreadyExists(err) || errors.Is(err, errRevertInProgress) {
+			return retry.RetryableError(err)
+		}
+		return err
+	})
+	return levels, revertAt, err
+}
+
+func (t *TemporaryLogLevels) set(ctx context.Context, proxy ResourceWithAddress, defaultAdminPort uint32, logger string, level string, ttl time.Duration) (map[string]string, time.Time, error) {
+	key := core_model.MetaToResourceKey(proxy.GetMeta())
+	configKey := LogLevelsConfigKey(proxy.Descriptor().Name, key)
+
+	create := false
+	config := system.NewConfigResource()
+	pending := &pendingRevert{}
+	if err := t.configManager.Get(ctx, config, store.GetByKey(configKey, core_model.NoMesh)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return nil, time.Time{}, err
+		}
+		create = true
+	} else {
+		if pending, err = readPendingRevert(config); err != nil {
+			return nil, time.Time{}, err
+		}
+		if pending.Reverted {
+			return nil, time.Time{}, errRevertInProgress
+		}
+	}
+
+	if create {
+		original, err := t.client.LogLevels(proxy, defaultAdminPort)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		pending = &pendingRevert{
+			Type:     proxy.Descriptor().Name,
+			Mesh:     key.Mesh,
+			Name:     key.Name,
+			Original: original,
+		}
+	}
+	pending.RevertAt = core.Now().Add(ttl)
+	if err := writePendingRevert(config, pending); err != nil {
+		return nil, time.Time{}, err
+	}
+	// the revert is stored before the change, so the change is never left without it
+	if create {
+		if err := t.configManager.Create(ctx, config, store.CreateByKey(configKey, core_model.NoMesh)); err != nil {
+			return nil, time.Time{}, err
+		}
+	} else {
+		if err := t.configManager.Update(ctx, config); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	levels, err := t.client.SetLogLevel(proxy, defaultAdminPort, logger, level)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return levels, pending.RevertAt, nil
+}
+
+// RevertAt returns the time when log levels of the proxy are reverted.
+// It returns false if there is no pending revert.
+func (t *TemporaryLogLevels) RevertAt(ctx context.Context, proxy core_model.Resource) (time.Time, bool, error) {
+	config := system.NewConfigResource()
+	configKey := LogLevelsConfigKey(proxy.Descriptor().Name, core_model.MetaToResourceKey(proxy.GetMeta()))
+	if err := t.configManager.Get(ctx, config, store.GetByKey(configKey, core_model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, err
+	}
+	pending, err := readPendingRevert(config)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if pending.Reverted {
+		return time.Time{}, false, nil
+	}
+	return pending.RevertAt, true, nil
+}
+
+// LogLevelsReverter restores log levels of proxies changed by TemporaryLogLevels
+// once their ttl expires. Levels are reverted at most one interval late.
+type LogLevelsReverter struct {
+	client           EnvoyAdminClient
+	configManager    config_manager.ConfigManager
+	resManager       manager.ReadOnlyResourceManager
+	auditLogger      audit.Logger
+	defaultAdminPort uint32
+	interval         time.Duration
+}
+
+var _ component.Component = &LogLevelsReverter{}
+
+func NewLogLevelsReverter(
+	client EnvoyAdminClient,
+	configManager config_manager.ConfigManager,
+	resManager manager.ReadOnlyResourceManager,
+	auditLogger audit.Logger,
+	defaultAdminPort uint32,
+	interval time.Duration,
+) *LogLevelsReverter {
+	return &LogLevelsReverter{
+		client:           client,
+		configManager:    configManager,
+		resManager:       resManager,
+		auditLogger:      auditLogger,
+		defaultAdminPort: defaultAdminPort,
+		interval:         interval,
+	}
+}
+
+func (r *LogLevelsReverter) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(user.Ctx(context.Background(), user.ControlPlane))
+	defer cancel()
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.revertExpired(ctx); err != nil {
+				log.Error(err, "could not revert log levels of proxies")
+			}
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// NeedLeaderElection is true, so that only one instance of the Control Plane restores the levels.
+func (r *LogLevelsReverter) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *LogLevelsReverter) revertExpired(ctx context.Context) error {
+	configs := &system.ConfigResourceList{}
+	if err := r.configManager.List(ctx, configs); err != nil {
+		return err
+	}
+	for _, config := range configs.Items {
+		if !strings.HasPrefix(config.GetMeta().GetName(), logLevelsConfigKeyPrefix) {
+			continue
+		}
+		pending, err := readPendingRevert(config)
+		if err != nil {
+			log.Error(err, "could not read pending revert of log levels", "config", config.GetMeta().GetName())
+			continue
+		}
+		if !pending.Reverted && core.Now().Before(pending.RevertAt) {
+			continue
+		}
+		if err := r.revert(ctx, config, pending); err != nil {
+			log.Error(err, "could not revert log levels of the proxy", "type", pending.Type, "name", pending.Name, "mesh", pending.Mesh)
+		}
+	}
+	return nil
+}
+
+// revert marks the Config as reverted, restores levels of the proxy and deletes the Config.
+// Set postpones the revert only by updating the Config, so marking it fails on a version conflict
+// when the revert was postponed in the meantime. Once the Config is marked, Set waits until it's deleted,
+// so levels are never restored over a change made after the revert started. If restoring fails,
+// the marked Config is left for the next run.
+func (r *LogLevelsReverter) revert(ctx context.Context, config *system.ConfigResource, pending *pendingRevert) error {
+	if !pending.Reverted {
+		pending.Reverted = true
+		if err := writePendingRevert(config, pending); err != nil {
+			return err
+		}
+		if err := r.configManager.Update(ctx, config); err != nil {
+			if store.IsResourceConflict(err) {
+				// levels were changed again, the revert waits for the new ttl
+				return nil
+			}
+			return err
+		}
+	}
+
+	proxy, err := r.getProxy(ctx, pending)
+	switch {
+	case store.IsResourceNotFound(err):
+		// the proxy does not exist anymore, there is nothing to revert
+	case err != nil:
+		return err
+	default:
+		if err := r.restore(proxy, pending.Original); err != nil {
+			return err
+		}
+		r.auditLogger.Log(ctx, audit.Event{
+			Operation:    audit.RevertLogLevel,
+			ResourceType: pending.Type,
+			Key:          pending.key(),
+			Details: map[string]string{
+				"revertAt": pending.RevertAt.Format(time.RFC3339),
+			},
+		})
+		log.Info("log levels of the proxy reverted", "type", pending.Type, "name", pending.Name, "mesh", pending.Mesh)
+	}
+
+	if err := r.configManager.Delete(ctx, config, store.DeleteBy(core_model.MetaToResourceKey(config.GetMeta()))); err != nil && !store.IsResourceNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *LogLevelsReverter) getProxy(ctx context.Context, pending *pendingRevert) (ResourceWithAddress, error) {
+	desc, err := registry.Global().DescriptorFor(pending.Type)
+	if err != nil {
+		return nil, err
+	}
+	res := desc.NewObject()
+	if err := r.resManager.Get(ctx, res, store.GetBy(pending.key())); err != nil {
+		return nil, err
+	}
+	withAddress, ok := res.(ResourceWithAddress)
+	if !ok {
+		return nil, errors.Errorf("resource of type %s does not expose envoy admin API", pending.Type)
+	}
+	return withAddress, nil
+}
+
+func (r *LogLevelsReverter) restore(proxy ResourceWithAddress, original map[string]string) error {
+	current, err := r.client.LogLevels(proxy, r.defaultAdminPort)
+	if err != nil {
+		return err
+	}
+	for _, change := range logLevelChanges(current, original) {
+		if _, err := r.client.SetLogLevel(proxy, r.defaultAdminPort, change.logger, change.level); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type logLevelChange struct {
+	// logger is empty when the level of all loggers is changed
+	logger string
+	level  string
+}
+
+// logLevelChanges returns changes that turn current levels into desired levels.
+// When most of the loggers have to be changed, it first sets the most common
+// desired level on all loggers, so it doesn't send a request per logger.
+func logLevelChanges(current, desired map[string]string) []logLevelChange {
+	var loggers []string
+	for logger := range desired {
+		loggers = append(loggers, logger)
+	}
+	sort.Strings(loggers)
+
+	var changes []logLevelChange
+	for _, logger := range loggers {
+		if current[logger] != desired[logger] {
+			changes = append(changes, logLevelChange{logger: logger, level: desired[logger]})
+		}
+	}
+	if len(changes) <= len(desired)/2 {
+		return changes
+	}
+
+	counts := map[string]int{}
+	common := ""
+	for _, logger := range loggers {
+		level := desired[logger]
+		counts[level]++
+		if counts[level] > counts[common] || (counts[level] == counts[common] && level < common) {
+			common = level
+		}
+	}
+	changes = []logLevelChange{{level: common}}
+	for _, logger := range loggers {
+		if desired[logger] != common {
+			changes = append(changes, logLevelChange{logger: logger, level: desired[logger]})
+		}
+	}
+	return changes
+}
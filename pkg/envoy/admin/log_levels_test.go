@@ -0,0 +1,279 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type logLevelsClient struct {
+	admin.EnvoyAdminClient
+
+	// onLogLevels is called before levels are returned
+	onLogLevels func()
+
+	sync.Mutex
+	levels  map[string]string
+	changes []string
+}
+
+func (c *logLevelsClient) LogLevels(admin.ResourceWithAddress, uint32) (map[string]string, error) {
+	if c.onLogLevels != nil {
+		c.onLogLevels()
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.copyLevels(), nil
+}
+
+func (c *logLevelsClient) SetLogLevel(_ admin.ResourceWithAddress, _ uint32, logger string, level string) (map[string]string, error) {
+	c.Lock()
+	defer c.Unlock()
+	for l := range c.levels {
+		if logger == "" || logger == l {
+			c.levels[l] = level
+		}
+	}
+	c.changes = append(c.changes, logger+"="+level)
+	return c.copyLevels(), nil
+}
+
+func (c *logLevelsClient) copyLevels() map[string]string {
+	levels := map[string]string{}
+	for logger, level := range c.levels {
+		levels[logger] = level
+	}
+	return levels
+}
+
+func (c *logLevelsClient) Changes() []string {
+	c.Lock()
+	defer c.Unlock()
+	return append([]string{}, c.changes...)
+}
+
+type auditLog struct {
+	sync.Mutex
+	buf bytes.Buffer
+}
+
+func (a *auditLog) Write(p []byte) (int, error) {
+	a.Lock()
+	defer a.Unlock()
+	return a.buf.Write(p)
+}
+
+func (a *auditLog) Entries() []audit.Entry {
+	a.Lock()
+	defer a.Unlock()
+	var entries []audit.Entry
+	decoder := json.NewDecoder(bytes.NewReader(a.buf.Bytes()))
+	for decoder.More() {
+		entry := audit.Entry{}
+		Expect(decoder.Decode(&entry)).To(Succeed())
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+var _ = Describe("TemporaryLogLevels", func() {
+
+	var client *logLevelsClient
+	var configManager config_manager.ConfigManager
+	var resManager manager.ResourceManager
+	var auditEntries *auditLog
+	var logLevels *admin.TemporaryLogLevels
+	var dataplane *core_mesh.DataplaneResource
+
+	startReverter := func() {
+		reverter := admin.NewLogLevelsReverter(client, configManager, resManager, audit.NewLogger(audit.LevelMetadata, auditEntries), 0, 10*time.Millisecond)
+		stop := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			Expect(reverter.Start(stop)).To(Succeed())
+		}()
+		DeferCleanup(func() {
+			close(stop)
+			<-done
+		})
+	}
+
+	pendingRevert := func() bool {
+		_, pending, err := logLevels.RevertAt(context.Background(), dataplane)
+		Expect(err).ToNot(HaveOccurred())
+		return pending
+	}
+
+	configs := func() []*system.ConfigResource {
+		list := &system.ConfigResourceList{}
+		Expect(configManager.List(context.Background(), list)).To(Succeed())
+		return list.Items
+	}
+
+	BeforeEach(func() {
+		client = &logLevelsClient{
+			levels: map[string]string{
+				"admin":    "info",
+				"http":     "info",
+				"upstream": "info",
+				"lua":      "error",
+			},
+		}
+		resourceStore := memory.NewStore()
+		configManager = config_manager.NewConfigManager(resourceStore)
+		resManager = manager.NewResourceManager(resourceStore)
+		auditEntries = &auditLog{}
+		logLevels = admin.NewTemporaryLogLevels(client, configManager)
+
+		dataplane = &core_mesh.DataplaneResource{Spec: &mesh_proto.Dataplane{}}
+		Expect(resourceStore.Create(context.Background(), dataplane, core_store.CreateByKey("backend-1", "default"))).To(Succeed())
+	})
+
+	It("should revert a level of a logger after ttl", func() {
+		// given
+		startReverter()
+
+		// when
+		levels, revertAt, err := logLevels.Set(context.Background(), dataplane, 0, "http", "debug", 50*time.Millisecond)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(levels).To(HaveKeyWithValue("http", "debug"))
+		Expect(revertAt).To(BeTemporally("~", time.Now().Add(50*time.Millisecond), time.Second))
+		Expect(pendingRevert()).To(BeTrue())
+
+		// and eventually
+		Eventually(client.Changes).Should(Equal([]string{"http=debug", "http=info"}))
+		Eventually(configs).Should(BeEmpty())
+		Expect(pendingRevert()).To(BeFalse())
+
+		// and the revert is audited
+		Expect(auditEntries.Entries()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"User":         Equal("mesh-system:control-plane"),
+			"Operation":    Equal(audit.RevertLogLevel),
+			"ResourceType": Equal(core_mesh.DataplaneType),
+			"Mesh":         Equal("default"),
+			"Name":         Equal("backend-1"),
+			"Outcome":      Equal(audit.Success),
+		})))
+	})
+
+	It("should revert levels of all loggers with as few requests as possible", func() {
+		// given
+		startReverter()
+
+		// when
+		_, _, err := logLevels.Set(context.Background(), dataplane, 0, "", "trace", 50*time.Millisecond)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(client.Changes).Should(Equal([]string{"=trace", "=info", "lua=error"}))
+		Expect(client.LogLevels(dataplane, 0)).To(Equal(map[string]string{
+			"admin":    "info",
+			"http":     "info",
+			"upstream": "info",
+			"lua":      "error",
+		}))
+	})
+
+	It("should restore levels from before the first change when changed again by another instance", func() {
+		// given
+		startReverter()
+		_, _, err := logLevels.Set(context.Background(), dataplane, 0, "http", "debug", 50*time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		otherInstance := admin.NewTemporaryLogLevels(client, configManager)
+		_, _, err = otherInstance.Set(context.Background(), dataplane, 0, "upstream", "trace", 200*time.Millisecond)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Consistently(client.Changes, "100ms").Should(HaveLen(2))
+		Eventually(client.Changes).Should(Equal([]string{"http=debug", "upstream=trace", "http=info", "upstream=info"}))
+	})
+
+	It("should revert levels changed before the restart of the Control Plane", func() {
+		// given levels changed while the reverter is not running
+		_, _, err := logLevels.Set(context.Background(), dataplane, 0, "http", "debug", 10*time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+		Consistently(client.Changes, "50ms").Should(HaveLen(1))
+
+		// when
+		startReverter()
+
+		// then
+		Eventually(client.Changes).Should(Equal([]string{"http=debug", "http=info"}))
+	})
+
+	It("should forget the revert of a deleted proxy", func() {
+		// given
+		_, _, err := logLevels.Set(context.Background(), dataplane, 0, "http", "debug", 10*time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resManager.Delete(context.Background(), core_mesh.NewDataplaneResource(), core_store.DeleteBy(core_model.MetaToResourceKey(dataplane.GetMeta())))).To(Succeed())
+
+		// when
+		startReverter()
+
+		// then
+		Eventually(configs).Should(BeEmpty())
+		Expect(client.Changes()).To(Equal([]string{"http=debug"}))
+		Expect(auditEntries.Entries()).To(BeEmpty())
+	})
+
+	It("should not restore levels over a change made while the revert is in progress", func() {
+		// given levels that are about to be reverted
+		_, _, err := logLevels.Set(context.Background(), dataplane, 0, "http", "debug", 10*time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and the reverter that stops when it reads current levels of the proxy
+		restoring := make(chan struct{})
+		resume := make(chan struct{})
+		var once sync.Once
+		client.onLogLevels = func() {
+			once.Do(func() {
+				close(restoring)
+				<-resume
+			})
+		}
+		startReverter()
+		Eventually(restoring).Should(BeClosed())
+
+		// when
+		setErr := make(chan error, 1)
+		go func() {
+			_, _, err := logLevels.Set(context.Background(), dataplane, 0, "upstream", "trace", time.Hour)
+			setErr <- err
+		}()
+
+		// then Set waits for the revert
+		Consistently(setErr, "50ms").ShouldNot(Receive())
+
+		// when
+		close(resume)
+
+		// then
+		Eventually(setErr).Should(Receive(BeNil()))
+		Expect(client.Changes()).To(Equal([]string{"http=debug", "http=info", "upstream=trace"}))
+		Expect(pendingRevert()).To(BeTrue())
+		Consistently(client.Changes, "50ms").Should(HaveLen(3))
+	})
+})
@@ -0,0 +1,304 @@
+package graph
+
+import (
+	"sort"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+type NodeType string
+
+const (
+	InternalNode NodeType = "internal"
+	ExternalNode NodeType = "external"
+	GatewayNode  NodeType = "gateway"
+)
+
+// Graph is a graph of services in a mesh. Nodes are services and edges
+// are connections between them.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+type Node struct {
+	Service  string   `json:"service"`
+	Type     NodeType `json:"type"`
+	Protocol string   `json:"protocol,omitempty"`
+}
+
+type Edge struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Protocol    string `json:"protocol,omitempty"`
+	// Routes are TrafficRoutes that send traffic of the source to the destination.
+	Routes []string `json:"routes,omitempty"`
+	// Permitted is false when mTLS is enabled in the mesh and there is no
+	// TrafficPermission that allows the traffic.
+	Permitted bool `json:"permitted"`
+	// Permission is a TrafficPermission that allows the traffic.
+	Permission string     `json:"permission,omitempty"`
+	Stats      *EdgeStats `json:"stats,omitempty"`
+}
+
+type EdgeStats struct {
+	// RequestRate is a number of requests per second.
+	RequestRate float64 `json:"requestRate"`
+	// ErrorRate is a ratio of requests answered with 5xx status codes.
+	ErrorRate float64 `json:"errorRate"`
+}
+
+type edgeKey struct {
+	source      string
+	destination string
+}
+
+// edgeCandidate is a potential connection between services. Connections
+// that come only from TrafficPermissions are kept only if they are permitted.
+type edgeCandidate struct {
+	edge       *Edge
+	routes     map[string]struct{}
+	fromPolicy bool
+}
+
+// Build builds the graph of services in the mesh. Edges come from declared
+// outbounds of data plane proxies, destinations of TrafficRoutes and
+// TrafficPermissions which explicitly name both source and destination services.
+// Every edge is checked against effective TrafficPermissions.
+func Build(meshCtx xds_context.MeshContext) *Graph {
+	dataplanes := meshCtx.Resources.Dataplanes().Items
+	externalServices := meshCtx.Resources.ExternalServices().Items
+	routes := meshCtx.Resources.TrafficRoutes().Items
+	permissions := meshCtx.Resources.TrafficPermissions().Items
+
+	nodes := buildNodes(dataplanes, externalServices)
+	destinationTags := buildDestinationTags(dataplanes, externalServices)
+
+	permissionPolicies := make([]policy.ConnectionPolicy, len(permissions))
+	for i, permission := range permissions {
+		permissionPolicies[i] = permission
+	}
+	sort.Stable(policy.ConnectionPolicyByName(permissionPolicies))
+
+	candidates := map[edgeKey]*edgeCandidate{}
+	candidate := func(source, destination string) *edgeCandidate {
+		key := edgeKey{source: source, destination: destination}
+		if _, ok := candidates[key]; !ok {
+			edge := &Edge{
+				Source:      source,
+				Destination: destination,
+			}
+			if node, ok := nodes[destination]; ok {
+				edge.Protocol = node.Protocol
+			}
+			candidates[key] = &edgeCandidate{
+				edge:       edge,
+				routes:     map[string]struct{}{},
+				fromPolicy: true,
+			}
+		}
+		return candidates[key]
+	}
+
+	for _, dataplane := range dataplanes {
+		outbounds := outboundDestinations(dataplane, routes)
+		destinations := map[string]struct{}{}
+		for destination := range outbounds {
+			destinations[destination] = struct{}{}
+		}
+		for _, destination := range explicitPermissionDestinations(dataplane, permissions) {
+			if _, ok := nodes[destination]; ok {
+				destinations[destination] = struct{}{}
+			}
+		}
+
+		for _, source := range servicesOf(dataplane) {
+			for destination := range destinations {
+				c := candidate(source, destination)
+				if routeNames, ok := outbounds[destination]; ok {
+					c.fromPolicy = false
+					for _, name := range routeNames {
+						c.routes[name] = struct{}{}
+					}
+				}
+				if !c.edge.Permitted {
+					c.edge.Permitted, c.edge.Permission = permitted(meshCtx.Resource, dataplane, destinationTags[destination], permissionPolicies)
+				}
+			}
+		}
+	}
+
+	graph := &Graph{
+		Nodes: []Node{},
+		Edges: []Edge{},
+	}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, *node)
+	}
+	for _, c := range candidates {
+		if c.fromPolicy && !c.edge.Permitted {
+			continue
+		}
+		if _, ok := nodes[c.edge.Destination]; !ok {
+			// destination is declared, but there are no data plane proxies of the service
+			nodes[c.edge.Destination] = &Node{Service: c.edge.Destination, Type: InternalNode}
+			graph.Nodes = append(graph.Nodes, *nodes[c.edge.Destination])
+		}
+		for name := range c.routes {
+			c.edge.Routes = append(c.edge.Routes, name)
+		}
+		sort.Strings(c.edge.Routes)
+		graph.Edges = append(graph.Edges, *c.edge)
+	}
+	sort.SliceStable(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Service < graph.Nodes[j].Service
+	})
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+		return graph.Edges[i].Destination < graph.Edges[j].Destination
+	})
+	return graph
+}
+
+func buildNodes(dataplanes []*core_mesh.DataplaneResource, externalServices []*core_mesh.ExternalServiceResource) map[string]*Node {
+	endpoints := map[string][]core_xds.Endpoint{}
+	nodes := map[string]*Node{}
+	for _, dataplane := range dataplanes {
+		networking := dataplane.Spec.GetNetworking()
+		if svc := networking.GetGateway().GetTags()[mesh_proto.ServiceTag]; svc != "" {
+			nodes[svc] = &Node{Service: svc, Type: GatewayNode}
+		}
+		for _, inbound := range networking.GetInbound() {
+			svc := inbound.GetService()
+			nodes[svc] = &Node{Service: svc, Type: InternalNode}
+			endpoints[svc] = append(endpoints[svc], core_xds.Endpoint{Tags: inbound.GetTags()})
+		}
+	}
+	for _, es := range externalServices {
+		svc := es.Spec.GetService()
+		nodes[svc] = &Node{Service: svc, Type: ExternalNode}
+		endpoints[svc] = append(endpoints[svc], core_xds.Endpoint{Tags: es.Spec.GetTags()})
+	}
+	for svc, node := range nodes {
+		if node.Type == GatewayNode {
+			continue
+		}
+		protocol := generator.InferServiceProtocol(endpoints[svc])
+		if protocol == core_mesh.ProtocolUnknown {
+			// traffic of unknown protocol is proxied as TCP
+			protocol = core_mesh.ProtocolTCP
+		}
+		node.Protocol = string(protocol)
+	}
+	return nodes
+}
+
+// buildDestinationTags returns tags of every inbound and external service
+// by service name. They are matched against destinations of TrafficPermissions.
+func buildDestinationTags(dataplanes []*core_mesh.DataplaneResource, externalServices []*core_mesh.ExternalServiceResource) map[string][]map[string]string {
+	tags := map[string][]map[string]string{}
+	for _, dataplane := range dataplanes {
+		for _, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+			tags[inbound.GetService()] = append(tags[inbound.GetService()], inbound.GetTags())
+		}
+	}
+	for _, es := range externalServices {
+		tags[es.Spec.GetService()] = append(tags[es.Spec.GetService()], es.Spec.GetTags())
+	}
+	return tags
+}
+
+func servicesOf(dataplane *core_mesh.DataplaneResource) []string {
+	networking := dataplane.Spec.GetNetworking()
+	if svc := networking.GetGateway().GetTags()[mesh_proto.ServiceTag]; svc != "" {
+		return []string{svc}
+	}
+	var services []string
+	seen := map[string]bool{}
+	for _, inbound := range networking.GetInbound() {
+		if !seen[inbound.GetService()] {
+			seen[inbound.GetService()] = true
+			services = append(services, inbound.GetService())
+		}
+	}
+	return services
+}
+
+// outboundDestinations returns services reachable through declared outbounds
+// of the data plane proxy with TrafficRoutes that send traffic to them.
+func outboundDestinations(dataplane *core_mesh.DataplaneResource, routes []*core_mesh.TrafficRouteResource) map[string][]string {
+	routeMap := topology.BuildRouteMap(dataplane, routes)
+	destinations := map[string][]string{}
+	for _, oface := range dataplane.Spec.GetNetworking().GetOutbound() {
+		route, ok := routeMap[dataplane.Spec.Networking.ToOutboundInterface(oface)]
+		if !ok {
+			serviceName := oface.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]
+			if _, ok := destinations[serviceName]; !ok {
+				destinations[serviceName] = nil
+			}
+			continue
+		}
+		for _, destination := range route.Spec.GetConf().GetSplitWithDestination() {
+			service, ok := destination.Destination[mesh_proto.ServiceTag]
+			if !ok {
+				continue
+			}
+			destinations[service] = append(destinations[service], route.GetMeta().GetName())
+		}
+	}
+	return destinations
+}
+
+// explicitPermissionDestinations returns destination services of
+// TrafficPermissions which sources match the data plane proxy by a concrete
+// service name. Wildcard permissions, like the default allow-all one, would
+// connect every service with every other, so they only permit edges.
+func explicitPermissionDestinations(dataplane *core_mesh.DataplaneResource, permissions []*core_mesh.TrafficPermissionResource) []string {
+	var destinations []string
+	for _, permission := range permissions {
+		matched := false
+		for _, source := range permission.Spec.GetSources() {
+			if svc, ok := source.Match[mesh_proto.ServiceTag]; ok && svc != mesh_proto.MatchAllTag && dataplane.Spec.MatchTags(source.Match) {
+				matched = true
+			}
+		}
+		if !matched {
+			continue
+		}
+		for _, destination := range permission.Spec.GetDestinations() {
+			if svc, ok := destination.Match[mesh_proto.ServiceTag]; ok && svc != mesh_proto.MatchAllTag {
+				destinations = append(destinations, svc)
+			}
+		}
+	}
+	return destinations
+}
+
+// permitted checks if the most specific TrafficPermission of any destination
+// inbound lets in the traffic of the data plane proxy.
+func permitted(mesh *core_mesh.MeshResource, dataplane *core_mesh.DataplaneResource, destinationTags []map[string]string, permissions []policy.ConnectionPolicy) (bool, string) {
+	if !mesh.MTLSEnabled() {
+		// TrafficPermissions are enforced only when mTLS is enabled
+		return true, ""
+	}
+	for _, tags := range destinationTags {
+		permission := policy.SelectInboundConnectionPolicy(tags, permissions)
+		if permission == nil {
+			continue
+		}
+		for _, source := range permission.Sources() {
+			if dataplane.Spec.MatchTags(source.Match) {
+				return true, permission.GetMeta().GetName()
+			}
+		}
+	}
+	return false, ""
+}
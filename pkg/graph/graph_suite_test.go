@@ -0,0 +1,11 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestGraph(t *testing.T) {
+	test.RunSpecs(t, "Graph Suite")
+}
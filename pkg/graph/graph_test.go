@@ -0,0 +1,233 @@
+package graph_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/graph"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+)
+
+func resource(res core_model.Resource, name, spec string) core_model.Resource {
+	res.SetMeta(&test_model.ResourceMeta{Mesh: "default", Name: name})
+	Expect(util_proto.FromYAML([]byte(spec), res.GetSpec())).To(Succeed())
+	return res
+}
+
+func dataplane(name, spec string) *core_mesh.DataplaneResource {
+	return resource(core_mesh.NewDataplaneResource(), name, spec).(*core_mesh.DataplaneResource)
+}
+
+func trafficPermission(name, spec string) *core_mesh.TrafficPermissionResource {
+	return resource(core_mesh.NewTrafficPermissionResource(), name, spec).(*core_mesh.TrafficPermissionResource)
+}
+
+func meshContext(mtls bool, resources ...core_model.Resource) xds_context.MeshContext {
+	mesh := core_mesh.NewMeshResource()
+	mesh.SetMeta(&test_model.ResourceMeta{Name: "default"})
+	if mtls {
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{Name: "ca-1", Type: "builtin"},
+			},
+		}
+	}
+	meshCtx := xds_context.MeshContext{
+		Resource:  mesh,
+		Resources: xds_context.Resources{},
+	}
+	for _, res := range resources {
+		list := meshCtx.Resources.ListOrEmpty(res.Descriptor().Name)
+		Expect(list.AddItem(res)).To(Succeed())
+		meshCtx.Resources[res.Descriptor().Name] = list
+	}
+	return meshCtx
+}
+
+var webDataplane = `
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 8080
+    tags:
+      kuma.io/service: web
+      kuma.io/protocol: http
+  outbound:
+  - port: 10001
+    tags:
+      kuma.io/service: backend`
+
+var backendDataplane = `
+networking:
+  address: 192.168.0.2
+  inbound:
+  - port: 8080
+    tags:
+      kuma.io/service: backend
+      kuma.io/protocol: http
+      version: v1
+  outbound:
+  - port: 10001
+    tags:
+      kuma.io/service: db`
+
+var dbDataplane = `
+networking:
+  address: 192.168.0.3
+  inbound:
+  - port: 5432
+    tags:
+      kuma.io/service: db`
+
+var allowAll = `
+sources:
+- match:
+    kuma.io/service: '*'
+destinations:
+- match:
+    kuma.io/service: '*'`
+
+var _ = Describe("Build()", func() {
+
+	It("should build the graph from outbounds, routes and permissions", func() {
+		// given
+		meshCtx := meshContext(true,
+			dataplane("web-01", webDataplane),
+			dataplane("backend-01", backendDataplane),
+			dataplane("backend-canary-01", `
+                networking:
+                  address: 192.168.0.4
+                  inbound:
+                  - port: 8080
+                    tags:
+                      kuma.io/service: backend-canary
+                      kuma.io/protocol: http`),
+			dataplane("db-01", dbDataplane),
+			dataplane("gateway-01", `
+                networking:
+                  address: 192.168.0.5
+                  gateway:
+                    tags:
+                      kuma.io/service: edge-gateway
+                  outbound:
+                  - port: 10001
+                    tags:
+                      kuma.io/service: web`),
+			resource(core_mesh.NewExternalServiceResource(), "httpbin", `
+                networking:
+                  address: httpbin.org:443
+                tags:
+                  kuma.io/service: httpbin
+                  kuma.io/protocol: http`),
+			resource(core_mesh.NewTrafficRouteResource(), "web-to-backend", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  split:
+                  - weight: 90
+                    destination:
+                      kuma.io/service: backend
+                  - weight: 10
+                    destination:
+                      kuma.io/service: backend-canary`),
+			trafficPermission("gateway-to-web", `
+                sources:
+                - match:
+                    kuma.io/service: edge-gateway
+                destinations:
+                - match:
+                    kuma.io/service: web`),
+			trafficPermission("web-to-backend", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend`),
+			trafficPermission("backend-to-db", `
+                sources:
+                - match:
+                    kuma.io/service: backend
+                destinations:
+                - match:
+                    kuma.io/service: db`),
+			trafficPermission("backend-to-httpbin", `
+                sources:
+                - match:
+                    kuma.io/service: backend
+                destinations:
+                - match:
+                    kuma.io/service: httpbin`),
+			trafficPermission("db-to-unknown", `
+                sources:
+                - match:
+                    kuma.io/service: db
+                destinations:
+                - match:
+                    kuma.io/service: unknown`),
+		)
+
+		// when
+		g := graph.Build(meshCtx)
+
+		// then
+		actual, err := json.MarshalIndent(g, "", "  ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(matchers.MatchGoldenJSON(filepath.Join("testdata", "graph.golden.json")))
+	})
+
+	It("should not create edges from wildcard permissions", func() {
+		// given
+		meshCtx := meshContext(true,
+			dataplane("web-01", webDataplane),
+			dataplane("backend-01", backendDataplane),
+			dataplane("db-01", dbDataplane),
+			trafficPermission("allow-all-default", allowAll),
+		)
+
+		// when
+		g := graph.Build(meshCtx)
+
+		// then
+		Expect(g.Edges).To(Equal([]graph.Edge{
+			{Source: "backend", Destination: "db", Protocol: "tcp", Permitted: true, Permission: "allow-all-default"},
+			{Source: "web", Destination: "backend", Protocol: "http", Permitted: true, Permission: "allow-all-default"},
+		}))
+	})
+
+	It("should permit all edges when mTLS is disabled", func() {
+		// given
+		meshCtx := meshContext(false,
+			dataplane("web-01", webDataplane),
+			dataplane("backend-01", backendDataplane),
+		)
+
+		// when
+		g := graph.Build(meshCtx)
+
+		// then
+		Expect(g.Nodes).To(Equal([]graph.Node{
+			{Service: "backend", Type: graph.InternalNode, Protocol: "http"},
+			{Service: "db", Type: graph.InternalNode},
+			{Service: "web", Type: graph.InternalNode, Protocol: "http"},
+		}))
+		Expect(g.Edges).To(Equal([]graph.Edge{
+			{Source: "backend", Destination: "db", Permitted: true},
+			{Source: "web", Destination: "backend", Protocol: "http", Permitted: true},
+		}))
+	})
+})
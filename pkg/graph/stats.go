@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kumahq/kuma/pkg/slo"
+)
+
+// StatsWindow is a time range over which request and error rates are computed.
+const StatsWindow = "5m"
+
+const (
+	servicesLabel = "kuma_io_services"
+	clusterLabel  = "envoy_cluster_name"
+)
+
+// AddStats enriches edges of the graph with request and error rates of
+// outbound clusters reported by data plane proxies of source services.
+// Edges without metrics are left without stats.
+//
+// Stats are read from the Prometheus compatible query endpoint which scrapes
+// the metrics hijacker of every kuma-dp. Control plane does not scrape
+// kuma-dp itself, because it does not have to be able to reach the proxies.
+// Each metric is fetched for the whole mesh with a single query and
+// assigned to edges here.
+func AddStats(ctx context.Context, querier slo.Querier, mesh string, graph *Graph) error {
+	requests, err := queryOutboundRates(ctx, querier, RequestRateQuery(mesh))
+	if err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+	errorRates, err := queryOutboundRates(ctx, querier, ErrorRequestRateQuery(mesh))
+	if err != nil {
+		return err
+	}
+	for i := range graph.Edges {
+		edge := &graph.Edges[i]
+		key := edgeKey{source: edge.Source, destination: edge.Destination}
+		requestRate, ok := requests[key]
+		if !ok {
+			continue
+		}
+		errorRate := 0.0
+		if requestRate > 0 { // otherwise there were no requests in the window
+			errorRate = errorRates[key] / requestRate
+		}
+		edge.Stats = &EdgeStats{
+			RequestRate: requestRate,
+			ErrorRate:   errorRate,
+		}
+	}
+	return nil
+}
+
+// queryOutboundRates returns the rates of the query by source and destination
+// services. A series of a proxy with many inbound services counts for each of them.
+func queryOutboundRates(ctx context.Context, querier slo.Querier, query string) (map[edgeKey]float64, error) {
+	samples, err := querier.QueryVector(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rates := map[edgeKey]float64{}
+	for _, sample := range samples {
+		destination := sample.Labels[clusterLabel]
+		// kuma_io_services is a multi-value label, e.g. ",backend,backend-admin,"
+		for _, source := range strings.Split(sample.Labels[servicesLabel], ",") {
+			if source == "" || destination == "" {
+				continue
+			}
+			rates[edgeKey{source: source, destination: destination}] += sample.Value
+		}
+	}
+	return rates, nil
+}
+
+// RequestRateQuery returns a PromQL query of the number of requests per
+// second sent by source services to destination clusters in the mesh.
+// Clusters of TrafficRoute splits report stats under the name of the service
+// (the split ends up in the "kuma_split" tag), so they are summed up with
+// the rest of the traffic to the service.
+func RequestRateQuery(mesh string) string {
+	return fmt.Sprintf("sum by (%s, %s) (rate(envoy_cluster_upstream_rq_total{mesh=%s}[%s]))",
+		servicesLabel, clusterLabel, strconv.Quote(mesh), StatsWindow)
+}
+
+// ErrorRequestRateQuery returns a PromQL query of the number of requests per
+// second sent by source services to destination clusters in the mesh and
+// answered with 5xx status codes.
+func ErrorRequestRateQuery(mesh string) string {
+	return fmt.Sprintf(`sum by (%s, %s) (rate(envoy_cluster_upstream_rq_xx{mesh=%s,envoy_response_code_class="5"}[%s]))`,
+		servicesLabel, clusterLabel, strconv.Quote(mesh), StatsWindow)
+}
@@ -0,0 +1,98 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/graph"
+	"github.com/kumahq/kuma/pkg/slo"
+)
+
+type staticQuerier struct {
+	samples map[string][]slo.Sample
+	err     error
+}
+
+func (s *staticQuerier) Query(context.Context, string) (float64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (s *staticQuerier) QueryVector(_ context.Context, query string) ([]slo.Sample, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.samples[query], nil
+}
+
+func outboundSample(services, cluster string, value float64) slo.Sample {
+	return slo.Sample{
+		Labels: map[string]string{
+			"kuma_io_services":   services,
+			"envoy_cluster_name": cluster,
+		},
+		Value: value,
+	}
+}
+
+var _ = Describe("AddStats()", func() {
+
+	It("should add request and error rates to edges with metrics", func() {
+		// given
+		g := &graph.Graph{
+			Edges: []graph.Edge{
+				{Source: "web", Destination: "backend"},
+				{Source: "web-admin", Destination: "backend"},
+				{Source: "backend", Destination: "db"},
+				{Source: "backend", Destination: "cache"},
+			},
+		}
+		querier := &staticQuerier{
+			samples: map[string][]slo.Sample{
+				graph.RequestRateQuery("default"): {
+					outboundSample(",web,web-admin,", "backend", 10),
+					outboundSample(",web,", "backend", 2.5),
+					outboundSample(",backend,", "cache", 0),
+					outboundSample(",backend,", "external", 1),
+				},
+				graph.ErrorRequestRateQuery("default"): {
+					outboundSample(",web,web-admin,", "backend", 0.25),
+				},
+			},
+		}
+
+		// when
+		err := graph.AddStats(context.Background(), querier, "default", g)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(g.Edges[0].Stats).To(Equal(&graph.EdgeStats{RequestRate: 12.5, ErrorRate: 0.02}))
+		Expect(g.Edges[1].Stats).To(Equal(&graph.EdgeStats{RequestRate: 10, ErrorRate: 0.025}))
+		Expect(g.Edges[2].Stats).To(BeNil())
+		Expect(g.Edges[3].Stats).To(Equal(&graph.EdgeStats{RequestRate: 0, ErrorRate: 0}))
+	})
+
+	It("should build queries of outbound clusters in the mesh", func() {
+		Expect(graph.RequestRateQuery("default")).To(Equal(
+			`sum by (kuma_io_services, envoy_cluster_name) (rate(envoy_cluster_upstream_rq_total{mesh="default"}[5m]))`,
+		))
+		Expect(graph.ErrorRequestRateQuery("default")).To(Equal(
+			`sum by (kuma_io_services, envoy_cluster_name) (rate(envoy_cluster_upstream_rq_xx{mesh="default",envoy_response_code_class="5"}[5m]))`,
+		))
+	})
+
+	It("should return an error of the querier", func() {
+		// given
+		g := &graph.Graph{
+			Edges: []graph.Edge{{Source: "web", Destination: "backend"}},
+		}
+
+		// when
+		err := graph.AddStats(context.Background(), &staticQuerier{err: errors.New("connection refused")}, "default", g)
+
+		// then
+		Expect(err).To(MatchError("connection refused"))
+	})
+})
@@ -6,9 +6,18 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/slo"
 )
 
 func Setup(rt runtime.Runtime) error {
+	var sloQuerier slo.Querier
+	if sloCfg := rt.Config().Metrics.SLO; sloCfg.QueryEndpoint != "" {
+		querier, err := slo.NewPrometheusQuerier(sloCfg.QueryEndpoint, sloCfg.QueryTimeout)
+		if err != nil {
+			return err
+		}
+		sloQuerier = querier
+	}
 	resyncer := NewResyncer(&Config{
 		ResourceManager:    rt.ResourceManager(),
 		EventReaderFactory: rt.EventReaderFactory(),
@@ -17,7 +26,9 @@ func Setup(rt runtime.Runtime) error {
 		RateLimiterFactory: func() *rate.Limiter {
 			return rate.NewLimiter(rate.Every(rt.Config().Metrics.Mesh.MinResyncTimeout), 0)
 		},
-		Registry: registry.Global(),
+		Registry:           registry.Global(),
+		SLOQuerier:         sloQuerier,
+		SLORefreshInterval: rt.Config().Metrics.SLO.RefreshInterval,
 	})
 	return rt.Add(component.NewResilientComponent(log, resyncer))
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	"github.com/kumahq/kuma/pkg/events"
+	"github.com/kumahq/kuma/pkg/slo"
 )
 
 var (
@@ -46,6 +47,10 @@ type Config struct {
 	MaxResyncTimeout   time.Duration
 	Tick               func(d time.Duration) <-chan time.Time
 	RateLimiterFactory func() *rate.Limiter
+	// SLOQuerier is used to compute error budget of SLOs in ServiceInsight.
+	// If nil, SLOs are not included in ServiceInsight.
+	SLOQuerier         slo.Querier
+	SLORefreshInterval time.Duration
 }
 
 type resyncer struct {
@@ -65,6 +70,12 @@ type resyncer struct {
 	infosMux sync.RWMutex
 
 	registry registry.TypeRegistry
+
+	sloQuerier         slo.Querier
+	sloRefreshInterval time.Duration
+	// sloStatuses caches statuses of SLOs by mesh, so we don't query metrics on every resync.
+	sloStatuses    map[string]sloStatusesInfo
+	sloStatusesMux sync.RWMutex
 }
 
 type syncInfo struct {
@@ -72,6 +83,12 @@ type syncInfo struct {
 	lastSync time.Time
 }
 
+type sloStatusesInfo struct {
+	// statuses by service and SLO name
+	statuses    map[string]map[string]*mesh_proto.ServiceInsight_Service_SLOStatus
+	lastRefresh time.Time
+}
+
 // NewResyncer creates a new Component that periodically updates insights
 // for various policies (right now only for Mesh).
 //
@@ -90,6 +107,9 @@ func NewResyncer(config *Config) component.Component {
 		rateLimiters:       map[string]*rate.Limiter{},
 		infos:              map[model.ResourceKey]syncInfo{},
 		registry:           config.Registry,
+		sloQuerier:         config.SLOQuerier,
+		sloRefreshInterval: config.SLORefreshInterval,
+		sloStatuses:        map[string]sloStatusesInfo{},
 	}
 
 	r.tick = config.Tick
@@ -110,6 +130,10 @@ func (r *resyncer) Start(stop <-chan struct{}) error {
 				if err := r.createOrUpdateMeshInsights(); err != nil {
 					log.Error(err, "unable to resync MeshInsight")
 				}
+				// the refresh is bounded by the period of the ticker, so a slow metrics backend doesn't delay next resyncs
+				ctx, cancel := context.WithTimeout(context.Background(), r.maxResyncTimeout-r.minResyncTimeout)
+				r.refreshSLOStatuses(ctx)
+				cancel()
 				if err := r.createOrUpdateServiceInsights(); err != nil {
 					log.Error(err, "unable to resync ServiceInsight")
 				}
@@ -139,6 +163,7 @@ func (r *resyncer) Start(stop <-chan struct{}) error {
 		}
 		if resourceChanged.Type == core_mesh.MeshType && resourceChanged.Operation == events.Delete {
 			r.deleteRateLimiter(resourceChanged.Key.Name)
+			r.deleteSLOStatuses(resourceChanged.Key.Name)
 		}
 		if !r.getRateLimiter(resourceChanged.Key.Mesh).Allow() {
 			continue
@@ -271,6 +296,8 @@ func (r *resyncer) createOrUpdateServiceInsight(mesh string) error {
 		}
 	}
 
+	r.addSLOStatusesToInsight(insight, mesh)
+
 	key := ServiceInsightKey(mesh)
 	info, _ := r.getInfo(key)
 	if proto.Equal(info.resource, insight) {
@@ -304,6 +331,95 @@ func (r *resyncer) createOrUpdateServiceInsight(mesh string) error {
 	return nil
 }
 
+// addSLOStatusesToInsight adds statuses of SLOs cached by refreshSLOStatuses to the insight.
+func (r *resyncer) addSLOStatusesToInsight(insight *mesh_proto.ServiceInsight, mesh string) {
+	r.sloStatusesMux.RLock()
+	defer r.sloStatusesMux.RUnlock()
+	info := r.sloStatuses[mesh]
+	for svcName, svc := range insight.Services {
+		if statuses, ok := info.statuses[svcName]; ok {
+			svc.Slos = statuses
+		}
+	}
+}
+
+// refreshSLOStatuses queries statuses of SLOs of meshes which were refreshed more than SLORefreshInterval ago.
+// It's not called under serviceInsightMux, so querying metrics doesn't block resyncs of ServiceInsights.
+func (r *resyncer) refreshSLOStatuses(ctx context.Context) {
+	if r.sloQuerier == nil {
+		return
+	}
+	meshes := &core_mesh.MeshResourceList{}
+	if err := r.rm.List(ctx, meshes); err != nil {
+		log.Error(err, "unable to list meshes to refresh SLO statuses")
+		return
+	}
+	existing := map[string]bool{}
+	for _, mesh := range meshes.Items {
+		meshName := mesh.GetMeta().GetName()
+		existing[meshName] = true
+
+		r.sloStatusesMux.RLock()
+		info, ok := r.sloStatuses[meshName]
+		r.sloStatusesMux.RUnlock()
+		if ok && core.Now().Sub(info.lastRefresh) < r.sloRefreshInterval {
+			continue
+		}
+		statuses, err := r.computeSLOStatuses(ctx, meshName)
+		if err != nil {
+			log.Error(err, "unable to refresh SLO statuses", "mesh", meshName)
+			continue
+		}
+		r.sloStatusesMux.Lock()
+		r.sloStatuses[meshName] = sloStatusesInfo{
+			statuses:    statuses,
+			lastRefresh: core.Now(),
+		}
+		r.sloStatusesMux.Unlock()
+	}
+
+	// statuses of deleted meshes could be left when the resyncer missed the event
+	r.sloStatusesMux.Lock()
+	for meshName := range r.sloStatuses {
+		if !existing[meshName] {
+			delete(r.sloStatuses, meshName)
+		}
+	}
+	r.sloStatusesMux.Unlock()
+}
+
+func (r *resyncer) deleteSLOStatuses(mesh string) {
+	r.sloStatusesMux.Lock()
+	defer r.sloStatusesMux.Unlock()
+	delete(r.sloStatuses, mesh)
+}
+
+func (r *resyncer) computeSLOStatuses(ctx context.Context, mesh string) (map[string]map[string]*mesh_proto.ServiceInsight_Service_SLOStatus, error) {
+	slos := &core_mesh.SLOResourceList{}
+	if err := r.rm.List(ctx, slos, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	statuses := map[string]map[string]*mesh_proto.ServiceInsight_Service_SLOStatus{}
+	for _, res := range slos.Items {
+		status, err := slo.Status(ctx, r.sloQuerier, res)
+		if err == slo.ErrNoData {
+			log.V(1).Info("no metrics of the service to compute SLO status", "mesh", mesh, "slo", res.GetMeta().GetName())
+			continue
+		}
+		if err != nil {
+			// metrics backend may be temporarily unavailable, it should not block updating the rest of the insight
+			log.Error(err, "unable to compute SLO status", "mesh", mesh, "slo", res.GetMeta().GetName())
+			continue
+		}
+		svc := res.Spec.GetService()
+		if _, ok := statuses[svc]; !ok {
+			statuses[svc] = map[string]*mesh_proto.ServiceInsight_Service_SLOStatus{}
+		}
+		statuses[svc][res.GetMeta().GetName()] = status
+	}
+	return statuses, nil
+}
+
 func (r *resyncer) createOrUpdateMeshInsights() error {
 	meshes := &core_mesh.MeshResourceList{}
 	if err := r.rm.List(context.Background(), meshes); err != nil {
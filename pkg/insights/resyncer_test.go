@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -31,6 +32,7 @@ var _ = Describe("Insight Persistence", func() {
 
 	var eventCh chan events.Event
 	var stopCh chan struct{}
+	var sloQuerier *test_insights.TestSLOQuerier
 
 	tickMtx := &sync.RWMutex{}
 	var tickCh chan time.Time
@@ -55,6 +57,10 @@ var _ = Describe("Insight Persistence", func() {
 		tickCh = make(chan time.Time)
 		tickMtx.Unlock()
 
+		sloQuerier = &test_insights.TestSLOQuerier{
+			Values: map[string]float64{},
+		}
+
 		resyncer := insights.NewResyncer(&insights.Config{
 			MinResyncTimeout:   5 * time.Second,
 			MaxResyncTimeout:   1 * time.Minute,
@@ -66,7 +72,9 @@ var _ = Describe("Insight Persistence", func() {
 				Expect(d).To(Equal(55 * time.Second)) // should be equal MaxResyncTimeout - MinResyncTimeout
 				return tickCh
 			},
-			Registry: registry.Global(),
+			Registry:           registry.Global(),
+			SLOQuerier:         sloQuerier,
+			SLORefreshInterval: 2 * time.Minute,
 		})
 		go func(stopCh chan struct{}) {
 			err := resyncer.Start(stopCh)
@@ -960,4 +968,113 @@ var _ = Describe("Insight Persistence", func() {
 		Expect(serviceInsight.Spec.Services["gateway"].Dataplanes.Offline).To(Equal(uint32(2)))
 		Expect(serviceInsight.Spec.Services["gateway"].Status).To(Equal(mesh_proto.ServiceInsight_Service_partially_degraded))
 	})
+
+	It("should return error budget of SLOs in service insights", func() {
+		// given
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, svc := range []string{"backend", "web"} {
+			dp := core_mesh.NewDataplaneResource()
+			dp.Spec = &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.0.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 8080,
+						Tags: map[string]string{"kuma.io/service": svc},
+					}},
+				},
+			}
+			err = rm.Create(context.Background(), dp, store.CreateByKey(svc, "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		sloFor := func(svc string) *core_mesh.SLOResource {
+			res := core_mesh.NewSLOResource()
+			res.Spec = &mesh_proto.SLO{
+				Service: svc,
+				Conf: &mesh_proto.SLO_Conf{
+					Target: 99,
+					Window: durationpb.New(24 * time.Hour),
+					Objective: &mesh_proto.SLO_Conf_Availability_{
+						Availability: &mesh_proto.SLO_Conf_Availability{},
+					},
+				},
+			}
+			return res
+		}
+		err = rm.Create(context.Background(), sloFor("backend"), store.CreateByKey("backend-availability", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+		err = rm.Create(context.Background(), sloFor("web"), store.CreateByKey("web-availability", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		sloQuerier.Lock()
+		sloQuerier.Values[`slo="backend-availability"`] = 0.75
+		sloQuerier.Unlock()
+
+		// when
+		nowMtx.Lock()
+		now = now.Add(61 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		// then
+		serviceInsight := core_mesh.NewServiceInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), serviceInsight, store.GetBy(insights.ServiceInsightKey("mesh-1")))
+		}, "10s", "100ms").Should(BeNil())
+
+		Expect(serviceInsight.Spec.Services["backend"].Slos).To(HaveLen(1))
+		status := serviceInsight.Spec.Services["backend"].Slos["backend-availability"]
+		Expect(status.Target).To(Equal(99.0))
+		Expect(status.ErrorRatio).To(BeNumerically("~", 0.0025, 1e-9))
+		Expect(status.ErrorBudgetRemaining).To(Equal(0.75))
+		// there are no metrics of web service yet
+		Expect(serviceInsight.Spec.Services["web"].Slos).To(BeEmpty())
+		sloQuerier.Lock()
+		Expect(sloQuerier.Queries).To(Equal(2))
+		sloQuerier.Unlock()
+	})
+
+	It("should forget statuses of SLOs of deleted meshes", func() {
+		// given
+		createMeshWithSLO := func() {
+			err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+			res := core_mesh.NewSLOResource()
+			res.Spec = &mesh_proto.SLO{
+				Service: "backend",
+				Conf: &mesh_proto.SLO_Conf{
+					Target: 99,
+					Window: durationpb.New(24 * time.Hour),
+					Objective: &mesh_proto.SLO_Conf_Availability_{
+						Availability: &mesh_proto.SLO_Conf_Availability{},
+					},
+				},
+			}
+			err = rm.Create(context.Background(), res, store.CreateByKey("backend-availability", "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		queries := func() int {
+			sloQuerier.Lock()
+			defer sloQuerier.Unlock()
+			return sloQuerier.Queries
+		}
+		createMeshWithSLO()
+		tickCh <- now.Add(61 * time.Second)
+		Eventually(queries, "10s", "100ms").Should(Equal(1))
+
+		// when the mesh is deleted
+		err := rm.Delete(context.Background(), core_mesh.NewMeshResource(), store.DeleteByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		tickCh <- now.Add(62 * time.Second)
+		tickCh <- now.Add(62 * time.Second) // the second tick is received when the first one is processed
+
+		// and created again before SLORefreshInterval passes
+		createMeshWithSLO()
+		tickCh <- now.Add(63 * time.Second)
+
+		// then statuses are not taken from the cache
+		Eventually(queries, "10s", "100ms").Should(Equal(2))
+	})
 })
@@ -0,0 +1,42 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/kumahq/kuma/pkg/slo"
+)
+
+// TestSLOQuerier returns values of queries which contain a given substring.
+// It returns slo.ErrNoData if none of them matches.
+type TestSLOQuerier struct {
+	sync.Mutex
+	Values  map[string]float64
+	Queries int
+}
+
+var _ slo.Querier = &TestSLOQuerier{}
+
+func (t *TestSLOQuerier) Query(_ context.Context, query string) (float64, error) {
+	t.Lock()
+	defer t.Unlock()
+	t.Queries++
+	for substr, value := range t.Values {
+		if strings.Contains(query, substr) {
+			return value, nil
+		}
+	}
+	return 0, slo.ErrNoData
+}
+
+func (t *TestSLOQuerier) QueryVector(ctx context.Context, query string) ([]slo.Sample, error) {
+	value, err := t.Query(ctx, query)
+	if err == slo.ErrNoData {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []slo.Sample{{Value: value}}, nil
+}
@@ -19,13 +19,13 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
 	"github.com/kumahq/kuma/pkg/kds"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	"github.com/kumahq/kuma/pkg/kds/reconcile"
 	"github.com/kumahq/kuma/pkg/kds/util"
 	zone_tokens "github.com/kumahq/kuma/pkg/tokens/builtin/zone"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
-	"github.com/kumahq/kuma/pkg/util/rsa"
 )
 
 var log = core.Log.WithName("kds")
@@ -116,7 +116,7 @@ func MapZoneTokenSigningKeyGlobalToPublicKey(
 
 		if resType == system.GlobalSecretType && strings.HasPrefix(resName, zone_tokens.SigningKeyPrefix) {
 			signingKeyBytes := r.(*system.GlobalSecretResource).Spec.GetData().GetValue()
-			publicKeyBytes, err := rsa.FromPrivateKeyPEMBytesToPublicKeyPEMBytes(signingKeyBytes)
+			publicKeyBytes, err := core_tokens.PublicKeyFromSigningKey(signingKeyBytes)
 			if err != nil {
 				return nil, err
 			}
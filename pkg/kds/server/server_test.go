@@ -88,6 +88,7 @@ var _ = Describe("KDS Server", func() {
 				kds_samples.VirtualOutbound,
 				kds_samples.Gateway,
 				kds_samples.GatewayRoute,
+				kds_samples.SLO,
 			})))
 
 		vrf := kds_verifier.New().
@@ -10,8 +10,10 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	kds_client "github.com/kumahq/kuma/pkg/kds/client"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
@@ -37,7 +39,7 @@ func Setup(rt core_runtime.Runtime) error {
 	if err != nil {
 		return err
 	}
-	resourceSyncer := sync_store.NewResourceSyncer(kdsZoneLog, rt.ResourceStore())
+	resourceSyncer := sync_store.NewResourceSyncer(kdsZoneLog, SyncStore(rt.ResourceStore(), rt.SecretStore()))
 	kubeFactory := resources_k8s.NewSimpleKubeFactory()
 	cfg := rt.Config()
 	cfgForDisplay, err := config.ConfigForDisplay(&cfg)
@@ -77,6 +79,15 @@ func Setup(rt core_runtime.Runtime) error {
 	return rt.Add(component.NewResilientComponent(kdsZoneLog.WithName("kds-mux-client"), muxClient))
 }
 
+// SyncStore returns a store for resources synced from Global. Secrets and GlobalSecrets
+// go through the secret store, so they are encrypted at rest like the ones created in the Zone.
+func SyncStore(resourceStore core_store.ResourceStore, secretStore secret_store.SecretStore) core_store.ResourceStore {
+	return core_store.NewCustomizableResourceStore(resourceStore, map[model.ResourceType]core_store.ResourceStore{
+		system.SecretType:       secretStore,
+		system.GlobalSecretType: secretStore,
+	})
+}
+
 func Callbacks(rt core_runtime.Runtime, syncer sync_store.ResourceSyncer, k8sStore bool, localZone string, kubeFactory resources_k8s.KubeFactory) *kds_client.Callbacks {
 	return &kds_client.Callbacks{
 		OnResourcesReceived: func(clusterID string, rs model.ResourceList) error {
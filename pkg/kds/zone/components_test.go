@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/api/system/v1alpha1"
@@ -19,6 +20,8 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	kds_client "github.com/kumahq/kuma/pkg/kds/client"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
 	sync_store "github.com/kumahq/kuma/pkg/kds/store"
@@ -28,6 +31,7 @@ import (
 	"github.com/kumahq/kuma/pkg/test/kds/samples"
 	"github.com/kumahq/kuma/pkg/test/kds/setup"
 	"github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
 type testRuntimeContext struct {
@@ -81,7 +85,12 @@ var _ = Describe("Zone Sync", func() {
 		clientStream := serverStream.ClientStream(stop)
 
 		zoneStore = memory.NewStore()
-		zoneSyncer = sync_store.NewResourceSyncer(core.Log.WithName("kds-syncer"), zoneStore)
+		envelope, err := cipher.NewEnvelope(map[string][]byte{
+			"key-1": []byte("0123456789abcdef0123456789abcdef"),
+		}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		secretStore := secret_store.NewEncryptedSecretStore(secret_store.NewSecretStore(zoneStore), envelope)
+		zoneSyncer = sync_store.NewResourceSyncer(core.Log.WithName("kds-syncer"), zone.SyncStore(zoneStore, secretStore))
 
 		wg.Add(1)
 		go func() {
@@ -117,6 +126,28 @@ var _ = Describe("Zone Sync", func() {
 		Expect(actual.Items[0].Spec).To(Equal(samples.Mesh1))
 	})
 
+	It("should store synced GlobalSecrets encrypted", func() {
+		// given
+		secret := &system.GlobalSecretResource{
+			Spec: &v1alpha1.Secret{
+				Data: &wrapperspb.BytesValue{Value: []byte("secret data")},
+			},
+		}
+
+		name := zoneingress.ZoneIngressSigningKeyPrefix + "-1"
+
+		// when
+		err := globalStore.Create(context.Background(), secret, store.CreateByKey(name, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		actual := system.NewGlobalSecretResource()
+		Eventually(func() error {
+			return zoneStore.Get(context.Background(), actual, store.GetByKey(name, model.NoMesh))
+		}, "5s", "100ms").Should(Succeed())
+		Expect(string(actual.Spec.Data.Value)).To(HavePrefix("kuma:enc:v1:"))
+	})
+
 	It("should sync ingresses", func() {
 		// create Ingress for current zone, shouldn't be synced
 		err := globalStore.Create(context.Background(), &mesh.ZoneIngressResource{Spec: ingressFunc(zoneName)}, store.CreateByKey("dp-1", model.NoMesh))
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc"
+)
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// defaultPollInterval is defined by https://datatracker.ietf.org/doc/html/rfc8628#section-3.2
+const defaultPollInterval = 5 * time.Second
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type tokenResponse struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+// deviceAuthorization executes OAuth 2.0 Device Authorization Grant (RFC 8628), so the user can log in to the OIDC provider
+// in the browser, even on another machine, and kumactl receives the ID token.
+func deviceAuthorization(ctx context.Context, client *http.Client, issuer string, clientID string, scopes string, out io.Writer) (*tokenResponse, error) {
+	metadata, err := oidc.Discover(ctx, client, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.DeviceAuthorizationEndpoint == "" {
+		return nil, errors.New("the OIDC provider does not support device authorization")
+	}
+
+	auth := &deviceAuthorizationResponse{}
+	resp, err := postForm(ctx, client, metadata.DeviceAuthorizationEndpoint, url.Values{
+		"client_id": {clientID},
+		"scope":     {scopes},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decode(resp, auth); err != nil {
+		return nil, errors.Wrap(err, "could not start device authorization")
+	}
+	printInstructions(out, auth)
+
+	interval := defaultPollInterval
+	if auth.Interval > 0 {
+		interval = time.Duration(auth.Interval) * time.Second
+	}
+	if auth.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(auth.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("device code expired before the login was confirmed")
+		case <-time.After(interval):
+		}
+		token, err := requestToken(ctx, client, metadata.TokenEndpoint, url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {auth.DeviceCode},
+			"client_id":   {clientID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		switch token.Error {
+		case "":
+			return token, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += defaultPollInterval
+		default:
+			return nil, errors.Errorf("login failed: %s %s", token.Error, token.ErrorDesc)
+		}
+	}
+}
+
+func refresh(ctx context.Context, client *http.Client, issuer string, clientID string, refreshToken string) (*tokenResponse, error) {
+	metadata, err := oidc.Discover(ctx, client, issuer)
+	if err != nil {
+		return nil, err
+	}
+	token, err := requestToken(ctx, client, metadata.TokenEndpoint, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {clientID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if token.Error != "" {
+		return nil, errors.Errorf("%s %s", token.Error, token.ErrorDesc)
+	}
+	return token, nil
+}
+
+// requestToken returns the token or the error returned by the token endpoint.
+func requestToken(ctx context.Context, client *http.Client, endpoint string, values url.Values) (*tokenResponse, error) {
+	resp, err := postForm(ctx, client, endpoint, values)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	token := &tokenResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, errors.Wrapf(err, "could not parse response of %s (status code %d)", endpoint, resp.StatusCode)
+	}
+	if token.Error == "" && token.IDToken == "" {
+		return nil, errors.Errorf("response of %s (status code %d) does not contain ID token", endpoint, resp.StatusCode)
+	}
+	return token, nil
+}
+
+func postForm(ctx context.Context, client *http.Client, endpoint string, values url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not send request to %s", endpoint)
+	}
+	return resp, nil
+}
+
+func decode(resp *http.Response, into interface{}) error {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+	return json.NewDecoder(resp.Body).Decode(into)
+}
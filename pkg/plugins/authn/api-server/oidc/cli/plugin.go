@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/plugins"
+	"github.com/kumahq/kuma/pkg/core"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+const (
+	AuthType = "oidc"
+	// TokenFileKey is a path to a file with ID token. The file is read on every request, so it can be refreshed by an external tool.
+	TokenFileKey = "tokenFile"
+	// IssuerKey and ClientIDKey configure device authorization flow executed when the Control Plane is added.
+	IssuerKey   = "issuer"
+	ClientIDKey = "clientId"
+	// ScopesKey is a space separated list of requested scopes.
+	ScopesKey = "scopes"
+	// IDTokenKey and RefreshTokenKey are obtained by the device authorization flow.
+	IDTokenKey      = "idToken"
+	RefreshTokenKey = "refreshToken"
+)
+
+const defaultScopes = "openid profile email offline_access"
+
+// expirationMargin makes sure that the token does not expire while the request is in flight.
+const expirationMargin = 30 * time.Second
+
+type OIDCAuthnPlugin struct {
+	Client *http.Client
+}
+
+var _ plugins.AuthnLoginPlugin = &OIDCAuthnPlugin{}
+var _ plugins.AuthnRefreshPlugin = &OIDCAuthnPlugin{}
+
+func NewOIDCAuthnPlugin() *OIDCAuthnPlugin {
+	return &OIDCAuthnPlugin{
+		Client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (o *OIDCAuthnPlugin) Validate(authConf map[string]string) error {
+	if authConf[TokenFileKey] != "" {
+		return nil
+	}
+	if authConf[IssuerKey] == "" || authConf[ClientIDKey] == "" {
+		return errors.Errorf("provide either %s=PATH_TO_ID_TOKEN or %s=ISSUER_URL,%s=CLIENT_ID", TokenFileKey, IssuerKey, ClientIDKey)
+	}
+	return nil
+}
+
+func (o *OIDCAuthnPlugin) Login(ctx context.Context, authConf map[string]string, out io.Writer) (map[string]string, error) {
+	if authConf[TokenFileKey] != "" {
+		return authConf, nil
+	}
+	scopes := authConf[ScopesKey]
+	if scopes == "" {
+		scopes = defaultScopes
+	}
+	tokens, err := deviceAuthorization(ctx, o.Client, authConf[IssuerKey], authConf[ClientIDKey], scopes, out)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not log in")
+	}
+	result := map[string]string{}
+	for k, v := range authConf {
+		result[k] = v
+	}
+	result[IDTokenKey] = tokens.IDToken
+	result[RefreshTokenKey] = tokens.RefreshToken
+	return result, nil
+}
+
+func (o *OIDCAuthnPlugin) DecorateClient(delegate util_http.Client, authConf map[string]string) (util_http.Client, error) {
+	return o.DecorateClientWithRefresh(delegate, authConf, nil)
+}
+
+func (o *OIDCAuthnPlugin) DecorateClientWithRefresh(delegate util_http.Client, authConf map[string]string, onRefresh func(map[string]string)) (util_http.Client, error) {
+	source, err := o.tokenSource(authConf, onRefresh)
+	if err != nil {
+		return nil, err
+	}
+	return util_http.ClientFunc(func(req *http.Request) (*http.Response, error) {
+		token, err := source(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("authorization", "Bearer "+token)
+		return delegate.Do(req)
+	}), nil
+}
+
+func (o *OIDCAuthnPlugin) tokenSource(authConf map[string]string, onRefresh func(map[string]string)) (func(context.Context) (string, error), error) {
+	if path := authConf[TokenFileKey]; path != "" {
+		return func(context.Context) (string, error) {
+			token, err := os.ReadFile(path)
+			if err != nil {
+				return "", errors.Wrapf(err, "could not read ID token from %s", path)
+			}
+			return strings.TrimSpace(string(token)), nil
+		}, nil
+	}
+	if authConf[IDTokenKey] == "" {
+		return nil, errors.New("there is no ID token. Add the Control Plane again to log in")
+	}
+
+	var mutex sync.Mutex
+	idToken := authConf[IDTokenKey]
+	refreshToken := authConf[RefreshTokenKey]
+	return func(ctx context.Context) (string, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if !expired(idToken) {
+			return idToken, nil
+		}
+		if refreshToken == "" {
+			return "", errors.New("ID token expired. Add the Control Plane again with --overwrite to log in")
+		}
+		tokens, err := refresh(ctx, o.Client, authConf[IssuerKey], authConf[ClientIDKey], refreshToken)
+		if err != nil {
+			return "", errors.Wrap(err, "ID token expired and could not be refreshed. Add the Control Plane again with --overwrite to log in")
+		}
+		idToken = tokens.IDToken
+		// the provider may rotate the refresh token, in which case the previous one is no longer valid
+		if tokens.RefreshToken != "" {
+			refreshToken = tokens.RefreshToken
+		}
+		if onRefresh != nil {
+			refreshed := map[string]string{}
+			for k, v := range authConf {
+				refreshed[k] = v
+			}
+			refreshed[IDTokenKey] = idToken
+			refreshed[RefreshTokenKey] = refreshToken
+			onRefresh(refreshed)
+		}
+		return idToken, nil
+	}, nil
+}
+
+func expired(token string) bool {
+	claims := &jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return true
+	}
+	if claims.ExpiresAt == nil {
+		return false
+	}
+	return core.Now().Add(expirationMargin).After(claims.ExpiresAt.Time)
+}
+
+func printInstructions(out io.Writer, auth *deviceAuthorizationResponse) {
+	if auth.VerificationURIComplete != "" {
+		fmt.Fprintf(out, "To log in, open %s in the browser and confirm the code %s\n", auth.VerificationURIComplete, auth.UserCode)
+		return
+	}
+	fmt.Fprintf(out, "To log in, open %s in the browser and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+}
@@ -0,0 +1,204 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+func idToken(exp time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "john.doe@example.com",
+		ExpiresAt: jwt.NewNumericDate(exp),
+	}).SignedString([]byte("not-verified-by-kumactl"))
+	Expect(err).ToNot(HaveOccurred())
+	return token
+}
+
+var _ = Describe("OIDC authn plugin", func() {
+
+	var server *httptest.Server
+	var tokenRequests []map[string]string
+	var freshToken string
+	var plugin *cli.OIDCAuthnPlugin
+
+	BeforeEach(func() {
+		tokenRequests = nil
+		freshToken = idToken(time.Now().Add(time.Hour))
+		plugin = cli.NewOIDCAuthnPlugin()
+
+		mux := http.NewServeMux()
+		server = httptest.NewServer(mux)
+		mux.HandleFunc("/.well-known/openid-configuration", func(writer http.ResponseWriter, request *http.Request) {
+			_ = json.NewEncoder(writer).Encode(oidc.ProviderMetadata{
+				Issuer:                      server.URL,
+				TokenEndpoint:               server.URL + "/token",
+				DeviceAuthorizationEndpoint: server.URL + "/device",
+			})
+		})
+		mux.HandleFunc("/device", func(writer http.ResponseWriter, request *http.Request) {
+			Expect(request.ParseForm()).To(Succeed())
+			Expect(request.PostForm.Get("client_id")).To(Equal("kumactl"))
+			_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+				"device_code":      "device-code-1",
+				"user_code":        "ABCD-EFGH",
+				"verification_uri": server.URL + "/activate",
+				"expires_in":       60,
+				"interval":         1,
+			})
+		})
+		mux.HandleFunc("/token", func(writer http.ResponseWriter, request *http.Request) {
+			Expect(request.ParseForm()).To(Succeed())
+			form := map[string]string{}
+			for k := range request.PostForm {
+				form[k] = request.PostForm.Get(k)
+			}
+			tokenRequests = append(tokenRequests, form)
+			refreshToken := "refresh-token-1"
+			if form["grant_type"] == "refresh_token" { // the provider rotates refresh tokens
+				refreshToken = "refresh-token-2"
+			}
+			_ = json.NewEncoder(writer).Encode(map[string]string{
+				"id_token":      freshToken,
+				"refresh_token": refreshToken,
+			})
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	headerOf := func(authConf map[string]string) string {
+		var header string
+		client, err := plugin.DecorateClient(util_http.ClientFunc(func(req *http.Request) (*http.Response, error) {
+			header = req.Header.Get("authorization")
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}), authConf)
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:5681/meshes", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return header
+	}
+
+	It("should log in with the device authorization flow", func() {
+		// given
+		out := &bytes.Buffer{}
+		authConf := map[string]string{
+			cli.IssuerKey:   server.URL,
+			cli.ClientIDKey: "kumactl",
+		}
+		Expect(plugin.Validate(authConf)).To(Succeed())
+
+		// when
+		result, err := plugin.Login(context.Background(), authConf, out)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(Equal("To log in, open " + server.URL + "/activate in the browser and enter the code ABCD-EFGH\n"))
+		Expect(tokenRequests).To(ConsistOf(map[string]string{
+			"grant_type":  "urn:ietf:params:oauth:grant-type:device_code",
+			"device_code": "device-code-1",
+			"client_id":   "kumactl",
+		}))
+		Expect(result).To(Equal(map[string]string{
+			cli.IssuerKey:       server.URL,
+			cli.ClientIDKey:     "kumactl",
+			cli.IDTokenKey:      freshToken,
+			cli.RefreshTokenKey: "refresh-token-1",
+		}))
+		Expect(headerOf(result)).To(Equal("Bearer " + freshToken))
+	})
+
+	It("should refresh expired ID token", func() {
+		// given
+		authConf := map[string]string{
+			cli.IssuerKey:       server.URL,
+			cli.ClientIDKey:     "kumactl",
+			cli.IDTokenKey:      idToken(time.Now().Add(-time.Minute)),
+			cli.RefreshTokenKey: "refresh-token-1",
+		}
+
+		// when
+		header := headerOf(authConf)
+
+		// then
+		Expect(header).To(Equal("Bearer " + freshToken))
+		Expect(tokenRequests).To(ConsistOf(map[string]string{
+			"grant_type":    "refresh_token",
+			"refresh_token": "refresh-token-1",
+			"client_id":     "kumactl",
+		}))
+	})
+
+	It("should pass refreshed tokens to be saved", func() {
+		// given
+		authConf := map[string]string{
+			cli.IssuerKey:       server.URL,
+			cli.ClientIDKey:     "kumactl",
+			cli.IDTokenKey:      idToken(time.Now().Add(-time.Minute)),
+			cli.RefreshTokenKey: "refresh-token-1",
+		}
+		var refreshed map[string]string
+		client, err := plugin.DecorateClientWithRefresh(util_http.ClientFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}), authConf, func(authConf map[string]string) {
+			refreshed = authConf
+		})
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:5681/meshes", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = client.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(refreshed).To(Equal(map[string]string{
+			cli.IssuerKey:       server.URL,
+			cli.ClientIDKey:     "kumactl",
+			cli.IDTokenKey:      freshToken,
+			cli.RefreshTokenKey: "refresh-token-2",
+		}))
+	})
+
+	It("should read ID token from the file", func() {
+		// given
+		file := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(file, []byte(freshToken+"\n"), 0600)).To(Succeed())
+		authConf := map[string]string{
+			cli.TokenFileKey: file,
+		}
+		Expect(plugin.Validate(authConf)).To(Succeed())
+
+		// when
+		header := headerOf(authConf)
+
+		// then
+		Expect(header).To(Equal("Bearer " + freshToken))
+		Expect(tokenRequests).To(BeEmpty())
+	})
+
+	It("should require either token file or issuer and client ID", func() {
+		// when
+		err := plugin.Validate(map[string]string{cli.IssuerKey: server.URL})
+
+		// then
+		Expect(err).To(MatchError("provide either tokenFile=PATH_TO_ID_TOKEN or issuer=ISSUER_URL,clientId=CLIENT_ID"))
+	})
+})
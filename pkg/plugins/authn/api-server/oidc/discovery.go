@@ -0,0 +1,47 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+// ProviderMetadata is a subset of OpenID Provider Metadata used by Kuma.
+// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type ProviderMetadata struct {
+	Issuer                      string `json:"issuer"`
+	JwksURI                     string `json:"jwks_uri"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// Discover fetches metadata of the OIDC provider from its well-known endpoint.
+func Discover(ctx context.Context, client *http.Client, issuer string) (*ProviderMetadata, error) {
+	url := strings.TrimSuffix(issuer, "/") + discoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("could not fetch %s: unexpected status code %d", url, resp.StatusCode)
+	}
+	metadata := &ProviderMetadata{}
+	if err := json.NewDecoder(resp.Body).Decode(metadata); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s", url)
+	}
+	// the issuer has to be identical, otherwise tokens signed by the keys of the provider would be rejected anyway
+	if metadata.Issuer != issuer {
+		return nil, errors.Errorf("issuer %q of the provider does not match configured issuer %q", metadata.Issuer, issuer)
+	}
+	return metadata, nil
+}
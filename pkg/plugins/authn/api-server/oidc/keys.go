@@ -0,0 +1,243 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+// KeySet provides public keys that the OIDC provider uses to sign tokens.
+type KeySet interface {
+	// Key returns a key of the given ID. The ID may be empty if the token does not specify "kid" header.
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC and OKP
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// ParseJWKS parses JSON Web Key Set into public keys indexed by their ID.
+// Keys that are not meant for signatures or of an unsupported type are skipped.
+func ParseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	set := jsonWebKeySet{}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrap(err, "could not parse JSON Web Key Set")
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse key %q", jwk.Kid)
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JSON Web Key Set does not contain any signing key")
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid size of Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, errors.New("missing key parameter")
+	}
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
+
+func lookupKey(keys map[string]crypto.PublicKey, kid string) crypto.PublicKey {
+	if key, ok := keys[kid]; ok {
+		return key
+	}
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key
+		}
+	}
+	return nil
+}
+
+// NewStaticKeySet returns keys loaded once from a JWKS file.
+func NewStaticKeySet(path string) (KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read JSON Web Key Set from %s", path)
+	}
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	return staticKeySet(keys), nil
+}
+
+type staticKeySet map[string]crypto.PublicKey
+
+func (s staticKeySet) Key(_ context.Context, kid string) (crypto.PublicKey, error) {
+	if key := lookupKey(s, kid); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("unknown key %q", kid)
+}
+
+// minRefreshInterval limits how often JWKS is fetched, so tokens with unknown key ID can't be used to flood the OIDC provider.
+const minRefreshInterval = 1 * time.Minute
+
+// NewRemoteKeySet returns keys fetched from jwksURL. If jwksURL is empty, it is discovered from the issuer.
+// Keys are cached and fetched again when the token is signed by unknown key, which is the case after the provider rotates its keys.
+func NewRemoteKeySet(client *http.Client, issuer string, jwksURL string) KeySet {
+	return &remoteKeySet{
+		client:  client,
+		issuer:  issuer,
+		jwksURL: jwksURL,
+	}
+}
+
+type remoteKeySet struct {
+	client *http.Client
+	issuer string
+
+	sync.Mutex
+	jwksURL     string
+	keys        map[string]crypto.PublicKey
+	lastFetched time.Time
+}
+
+func (r *remoteKeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	r.Lock()
+	defer r.Unlock()
+	if key := lookupKey(r.keys, kid); key != nil {
+		return key, nil
+	}
+	if !r.lastFetched.IsZero() && core.Now().Sub(r.lastFetched) < minRefreshInterval {
+		return nil, errors.Errorf("unknown key %q", kid)
+	}
+	if err := r.fetch(ctx); err != nil {
+		return nil, err
+	}
+	if key := lookupKey(r.keys, kid); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("unknown key %q", kid)
+}
+
+func (r *remoteKeySet) fetch(ctx context.Context) error {
+	r.lastFetched = core.Now()
+	if r.jwksURL == "" {
+		metadata, err := Discover(ctx, r.client, r.issuer)
+		if err != nil {
+			return err
+		}
+		r.jwksURL = metadata.JwksURI
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.jwksURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not fetch JSON Web Key Set from %s", r.jwksURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("could not fetch JSON Web Key Set from %s: unexpected status code %d", r.jwksURL, resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return err
+	}
+	r.keys = keys
+	return nil
+}
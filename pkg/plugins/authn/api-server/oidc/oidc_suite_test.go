@@ -0,0 +1,11 @@
+package oidc_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestOIDC(t *testing.T) {
+	test.RunSpecs(t, "OIDC Suite")
+}
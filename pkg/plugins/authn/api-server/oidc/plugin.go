@@ -0,0 +1,40 @@
+package oidc
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/api-server/authn"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/plugins"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens"
+)
+
+const PluginName = "oidc"
+
+var log = core.Log.WithName("plugins").WithName("authn").WithName("api-server").WithName("oidc")
+
+type plugin struct {
+}
+
+func init() {
+	plugins.Register(PluginName, &plugin{})
+}
+
+var _ plugins.AuthnAPIServerPlugin = plugin{}
+
+func (c plugin) NewAuthenticator(context plugins.PluginContext) (authn.Authenticator, error) {
+	cfg := context.Config().ApiServer.Authn.OIDC
+	var keys KeySet
+	if cfg.JwksFile != "" {
+		staticKeys, err := NewStaticKeySet(cfg.JwksFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = staticKeys
+	} else {
+		keys = NewRemoteKeySet(&http.Client{Timeout: 10 * time.Second}, cfg.Issuer, cfg.JwksURL)
+	}
+	log.Info("authenticating users with ID tokens", "issuer", cfg.Issuer, "audience", cfg.Audience)
+	return tokens.UserTokenAuthenticator(NewValidator(keys, cfg)), nil
+}
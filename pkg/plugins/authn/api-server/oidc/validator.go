@@ -0,0 +1,98 @@
+package oidc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/tokens"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/issuer"
+)
+
+// validMethods are asymmetric algorithms. HMAC is not accepted, because it would require sharing a secret with the OIDC provider.
+var validMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// NewValidator returns a validator of ID tokens issued by the OIDC provider.
+// Claims of the token are mapped into the user, so the same access control applies as to User Tokens.
+func NewValidator(keys KeySet, cfg api_server.ApiServerAuthnOIDC) issuer.UserTokenValidator {
+	return &validator{
+		keys: keys,
+		cfg:  cfg,
+	}
+}
+
+type validator struct {
+	keys KeySet
+	cfg  api_server.ApiServerAuthnOIDC
+}
+
+var _ issuer.UserTokenValidator = &validator{}
+
+func (v *validator) Validate(ctx context.Context, rawToken tokens.Token) (user.User, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(validMethods))
+	_, err := parser.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return user.User{}, errors.Wrap(err, "could not parse token")
+	}
+	if !claims.VerifyIssuer(v.cfg.Issuer, true) {
+		return user.User{}, errors.Errorf("token is not issued by %q", v.cfg.Issuer)
+	}
+	if !claims.VerifyAudience(v.cfg.Audience, true) {
+		return user.User{}, errors.Errorf("token is not issued for %q", v.cfg.Audience)
+	}
+	if _, ok := claims["exp"]; !ok {
+		return user.User{}, errors.New("token has to have an expiration time")
+	}
+	name, ok := claims[v.cfg.UsernameClaim].(string)
+	if !ok || name == "" {
+		return user.User{}, errors.Errorf("token does not contain %q claim", v.cfg.UsernameClaim)
+	}
+	name = v.cfg.UsernamePrefix + name
+	if strings.HasPrefix(name, user.SystemPrefix) {
+		return user.User{}, errors.Errorf("user name %q is reserved for Kuma", name)
+	}
+	groups, err := v.groups(claims)
+	if err != nil {
+		return user.User{}, err
+	}
+	return user.User{
+		Name:   name,
+		Groups: groups,
+	}, nil
+}
+
+func (v *validator) groups(claims jwt.MapClaims) ([]string, error) {
+	if v.cfg.GroupsClaim == "" {
+		return nil, nil
+	}
+	var groups []string
+	switch value := claims[v.cfg.GroupsClaim].(type) {
+	case nil:
+	case string:
+		groups = append(groups, v.cfg.GroupsPrefix+value)
+	case []interface{}:
+		for _, group := range value {
+			g, ok := group.(string)
+			if !ok {
+				return nil, errors.Errorf("%q claim has to be a list of strings", v.cfg.GroupsClaim)
+			}
+			groups = append(groups, v.cfg.GroupsPrefix+g)
+		}
+	default:
+		return nil, errors.Errorf("%q claim has to be a list of strings", v.cfg.GroupsClaim)
+	}
+	return groups, nil
+}
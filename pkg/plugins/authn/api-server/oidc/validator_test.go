@@ -0,0 +1,219 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc"
+)
+
+func jwks(keys map[string]*rsa.PrivateKey) []byte {
+	var set []map[string]string
+	for kid, key := range keys {
+		set = append(set, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	bytes, err := json.Marshal(map[string]interface{}{"keys": set})
+	Expect(err).ToNot(HaveOccurred())
+	return bytes
+}
+
+func sign(key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	Expect(err).ToNot(HaveOccurred())
+	return signed
+}
+
+var _ = Describe("OIDC token validator", func() {
+
+	var key *rsa.PrivateKey
+	var keys map[string]*rsa.PrivateKey
+	var jwksRequests int
+	var server *httptest.Server
+	var cfg api_server.ApiServerAuthnOIDC
+
+	BeforeEach(func() {
+		var err error
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		keys = map[string]*rsa.PrivateKey{"key-1": key}
+		jwksRequests = 0
+
+		mux := http.NewServeMux()
+		server = httptest.NewServer(mux)
+		mux.HandleFunc("/.well-known/openid-configuration", func(writer http.ResponseWriter, request *http.Request) {
+			_ = json.NewEncoder(writer).Encode(oidc.ProviderMetadata{
+				Issuer:  server.URL,
+				JwksURI: server.URL + "/keys",
+			})
+		})
+		mux.HandleFunc("/keys", func(writer http.ResponseWriter, request *http.Request) {
+			jwksRequests++
+			_, _ = writer.Write(jwks(keys))
+		})
+
+		cfg = api_server.DefaultApiServerConfig().Authn.OIDC
+		cfg.Issuer = server.URL
+		cfg.Audience = "kumactl"
+		cfg.UsernameClaim = "email"
+		cfg.UsernamePrefix = "sso:"
+		cfg.GroupsPrefix = "sso:"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    server.URL,
+			"aud":    "kumactl",
+			"sub":    "1234",
+			"email":  "john.doe@example.com",
+			"groups": []string{"team-a", "team-b"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	It("should map claims of the token into the user", func() {
+		// given
+		validator := oidc.NewValidator(oidc.NewRemoteKeySet(http.DefaultClient, cfg.Issuer, ""), cfg)
+
+		// when
+		u, err := validator.Validate(context.Background(), sign(key, "key-1", claims()))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u).To(Equal(user.User{
+			Name:   "sso:john.doe@example.com",
+			Groups: []string{"sso:team-a", "sso:team-b"},
+		}))
+	})
+
+	It("should validate the token with keys from a file", func() {
+		// given
+		file := filepath.Join(GinkgoT().TempDir(), "jwks.json")
+		Expect(os.WriteFile(file, jwks(keys), 0600)).To(Succeed())
+		keySet, err := oidc.NewStaticKeySet(file)
+		Expect(err).ToNot(HaveOccurred())
+		validator := oidc.NewValidator(keySet, cfg)
+
+		// when
+		u, err := validator.Validate(context.Background(), sign(key, "key-1", claims()))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Name).To(Equal("sso:john.doe@example.com"))
+		Expect(jwksRequests).To(Equal(0))
+	})
+
+	It("should fetch keys again when the provider rotates them", func() {
+		// given
+		validator := oidc.NewValidator(oidc.NewRemoteKeySet(http.DefaultClient, cfg.Issuer, server.URL+"/keys"), cfg)
+		_, err := validator.Validate(context.Background(), sign(key, "key-1", claims()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		keys["key-2"] = newKey
+		_, err = validator.Validate(context.Background(), sign(newKey, "key-2", claims()))
+
+		// then
+		Expect(err).To(HaveOccurred()) // keys are not fetched more often than once a minute
+		Expect(jwksRequests).To(Equal(1))
+	})
+
+	type testCase struct {
+		claims      func(jwt.MapClaims)
+		expectedErr string
+	}
+
+	DescribeTable("should reject invalid tokens",
+		func(given testCase) {
+			// given
+			validator := oidc.NewValidator(oidc.NewRemoteKeySet(http.DefaultClient, cfg.Issuer, ""), cfg)
+			c := claims()
+			given.claims(c)
+
+			// when
+			_, err := validator.Validate(context.Background(), sign(key, "key-1", c))
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(given.expectedErr)))
+		},
+		Entry("different issuer", testCase{
+			claims:      func(c jwt.MapClaims) { c["iss"] = "https://other-idp.example.com" },
+			expectedErr: "token is not issued by",
+		}),
+		Entry("different audience", testCase{
+			claims:      func(c jwt.MapClaims) { c["aud"] = "other-app" },
+			expectedErr: `token is not issued for "kumactl"`,
+		}),
+		Entry("expired", testCase{
+			claims:      func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+			expectedErr: "Token is expired",
+		}),
+		Entry("without expiration", testCase{
+			claims:      func(c jwt.MapClaims) { delete(c, "exp") },
+			expectedErr: "token has to have an expiration time",
+		}),
+		Entry("without username", testCase{
+			claims:      func(c jwt.MapClaims) { delete(c, "email") },
+			expectedErr: `token does not contain "email" claim`,
+		}),
+		Entry("invalid groups", testCase{
+			claims:      func(c jwt.MapClaims) { c["groups"] = 1 },
+			expectedErr: `"groups" claim has to be a list of strings`,
+		}),
+	)
+
+	It("should reject user names reserved for Kuma", func() {
+		// given
+		cfg.UsernamePrefix = ""
+		validator := oidc.NewValidator(oidc.NewRemoteKeySet(http.DefaultClient, cfg.Issuer, ""), cfg)
+		c := claims()
+		c["email"] = "mesh-system:admin"
+
+		// when
+		_, err := validator.Validate(context.Background(), sign(key, "key-1", c))
+
+		// then
+		Expect(err).To(MatchError(`user name "mesh-system:admin" is reserved for Kuma`))
+	})
+
+	It("should reject token signed by unknown key", func() {
+		// given
+		validator := oidc.NewValidator(oidc.NewRemoteKeySet(http.DefaultClient, cfg.Issuer, ""), cfg)
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = validator.Validate(context.Background(), sign(otherKey, "key-1", claims()))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("verification error")))
+	})
+})
@@ -0,0 +1,23 @@
+package access
+
+import (
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacGenerateUserTokenAccess struct {
+	authorizer rbac.Authorizer
+}
+
+var _ GenerateUserTokenAccess = &rbacGenerateUserTokenAccess{}
+
+func NewRBACGenerateUserTokenAccess(authorizer rbac.Authorizer) GenerateUserTokenAccess {
+	return &rbacGenerateUserTokenAccess{
+		authorizer: authorizer,
+	}
+}
+
+func (r *rbacGenerateUserTokenAccess) ValidateGenerate(user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbCreate, rbac.UserTokenType, "")
+}
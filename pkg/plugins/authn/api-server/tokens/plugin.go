@@ -6,6 +6,7 @@ import (
 	"github.com/kumahq/kuma/pkg/api-server/authn"
 	config_access "github.com/kumahq/kuma/pkg/config/access"
 	"github.com/kumahq/kuma/pkg/core/plugins"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/access"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/issuer"
@@ -25,6 +26,9 @@ var AccessStrategies = map[string]func(*plugins.MutablePluginContext) access.Gen
 	config_access.StaticType: func(context *plugins.MutablePluginContext) access.GenerateUserTokenAccess {
 		return access.NewStaticGenerateUserTokenAccess(context.Config().Access.Static.GenerateUserToken)
 	},
+	config_access.RBACType: func(context *plugins.MutablePluginContext) access.GenerateUserTokenAccess {
+		return access.NewRBACGenerateUserTokenAccess(rbac.NewAuthorizer(context.ReadOnlyResourceManager(), context.Config().Access.RBAC))
+	},
 }
 
 func init() {
@@ -47,7 +51,7 @@ func (c plugin) BeforeBootstrap(*plugins.MutablePluginContext, plugins.PluginCon
 }
 
 func (c plugin) AfterBootstrap(context *plugins.MutablePluginContext, config plugins.PluginConfig) error {
-	signingKeyManager := core_tokens.NewSigningKeyManager(context.ResourceManager(), issuer.UserTokenSigningKeyPrefix)
+	signingKeyManager := core_tokens.NewSigningKeyManagerWithAlgorithm(context.ResourceManager(), issuer.UserTokenSigningKeyPrefix, context.Config().Defaults.SigningKeyAlgorithm)
 	component := core_tokens.NewDefaultSigningKeyComponent(signingKeyManager, log)
 	if err := context.ComponentManager().Add(component); err != nil {
 		return err
@@ -62,7 +66,7 @@ func (c plugin) AfterBootstrap(context *plugins.MutablePluginContext, config plu
 			return err
 		}
 	}
-	webService := server.NewWebService(tokenIssuer, accessFn(context))
+	webService := server.NewWebService(tokenIssuer, accessFn(context), context.AuditLogger())
 	context.APIManager().Add(webService)
 	return nil
 }
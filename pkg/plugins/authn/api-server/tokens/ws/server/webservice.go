@@ -7,6 +7,8 @@ import (
 	"github.com/emicklei/go-restful"
 
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/rest/errors"
 	"github.com/kumahq/kuma/pkg/core/user"
 	"github.com/kumahq/kuma/pkg/core/validators"
@@ -18,14 +20,16 @@ import (
 var log = core.Log.WithName("user-token-ws")
 
 type userTokenWebService struct {
-	issuer issuer.UserTokenIssuer
-	access access.GenerateUserTokenAccess
+	issuer      issuer.UserTokenIssuer
+	access      access.GenerateUserTokenAccess
+	auditLogger audit.Logger
 }
 
-func NewWebService(issuer issuer.UserTokenIssuer, access access.GenerateUserTokenAccess) *restful.WebService {
+func NewWebService(issuer issuer.UserTokenIssuer, access access.GenerateUserTokenAccess, auditLogger audit.Logger) *restful.WebService {
 	webservice := userTokenWebService{
-		issuer: issuer,
-		access: access,
+		issuer:      issuer,
+		access:      access,
+		auditLogger: auditLogger,
 	}
 	return webservice.createWs()
 }
@@ -40,7 +44,13 @@ func (d *userTokenWebService) createWs() *restful.WebService {
 }
 
 func (d *userTokenWebService) handleIdentityRequest(request *restful.Request, response *restful.Response) {
+	event := audit.Event{
+		Operation: audit.GenerateToken,
+		TokenType: audit.UserToken,
+	}
+	// access is validated before the request is read, so callers without access learn nothing about the request format
 	if err := d.access.ValidateGenerate(user.FromCtx(request.Request.Context())); err != nil {
+		d.auditLogger.Log(request.Request.Context(), audit.AccessDenied(event, err))
 		errors.HandleError(response, err, "Could not issue a token")
 		return
 	}
@@ -51,6 +61,7 @@ func (d *userTokenWebService) handleIdentityRequest(request *restful.Request, re
 		response.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	event.Key = model.ResourceKey{Name: idReq.Name}
 
 	verr := validators.ValidationError{}
 	if idReq.Name == "" {
@@ -77,6 +88,8 @@ func (d *userTokenWebService) handleIdentityRequest(request *restful.Request, re
 		Name:   idReq.Name,
 		Groups: idReq.Groups,
 	}, validFor)
+	event.Err = err
+	d.auditLogger.Log(request.Request.Context(), event)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/emicklei/go-restful"
@@ -12,6 +13,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	error_types "github.com/kumahq/kuma/pkg/core/rest/errors/types"
 	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
@@ -30,6 +33,13 @@ func (n *noopGenerateUserTokenAccess) ValidateGenerate(user.User) error {
 	return nil
 }
 
+type denyGenerateUserTokenAccess struct {
+}
+
+func (d *denyGenerateUserTokenAccess) ValidateGenerate(user.User) error {
+	return &access.AccessDeniedError{Reason: "user is not allowed to generate tokens"}
+}
+
 var _ = Describe("Auth Tokens WS", func() {
 
 	var userTokenClient client.UserTokenClient
@@ -48,7 +58,7 @@ var _ = Describe("Auth Tokens WS", func() {
 		)
 
 		Expect(signingKeyManager.CreateDefaultSigningKey(context.Background())).To(Succeed())
-		ws := server.NewWebService(tokenIssuer, &noopGenerateUserTokenAccess{})
+		ws := server.NewWebService(tokenIssuer, &noopGenerateUserTokenAccess{}, audit.NopLogger())
 
 		container := restful.NewContainer()
 		container.Add(ws)
@@ -93,4 +103,21 @@ var _ = Describe("Auth Tokens WS", func() {
 			},
 		}))
 	})
+
+	It("should deny access before reading the request", func() {
+		// given
+		ws := server.NewWebService(nil, &denyGenerateUserTokenAccess{}, audit.NopLogger())
+		container := restful.NewContainer()
+		container.Add(ws)
+		srv := httptest.NewServer(container)
+		defer srv.Close()
+
+		// when
+		resp, err := http.Post(srv.URL+"/tokens/user", restful.MIME_JSON, strings.NewReader("not a json"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	})
 })
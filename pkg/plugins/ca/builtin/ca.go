@@ -3,8 +3,10 @@ package builtin
 import (
 	"crypto"
 	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"math/big"
 	"net/url"
 	"time"
@@ -15,7 +17,6 @@ import (
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	util_tls "github.com/kumahq/kuma/pkg/tls"
-	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
 )
 
 const (
@@ -32,11 +33,8 @@ func withExpirationTime(expiration time.Duration) certOptsFn {
 	}
 }
 
-func newRootCa(mesh string, rsaBits int, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
-	if rsaBits == 0 {
-		rsaBits = util_rsa.DefaultKeySize
-	}
-	key, err := util_rsa.GenerateKey(rsaBits)
+func newRootCa(mesh string, keyType util_tls.KeyType, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
+	key, err := keyType()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate a private key")
 	}
@@ -47,16 +45,96 @@ func newRootCa(mesh string, rsaBits int, certOpts ...certOptsFn) (*core_ca.KeyPa
 	return util_tls.ToKeyPair(key, cert)
 }
 
-func newCACert(signer crypto.Signer, trustDomain string, certOpts ...certOptsFn) ([]byte, error) {
-	spiffeID := &url.URL{
+// newIntermediateCa generates a key of the intermediate CA and signs its certificate with the parent CA.
+// The certificate of the returned key pair is followed by the chain of the parent.
+func newIntermediateCa(mesh string, keyType util_tls.KeyType, parent core_ca.KeyPair, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
+	parentPair, err := tls.X509KeyPair(parent.CertPEM, parent.KeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the key pair of the parent CA")
+	}
+	parentCert, err := x509.ParseCertificate(parentPair.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the certificate of the parent CA")
+	}
+	parentSigner, ok := parentPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("the key of the parent CA cannot be used for signing")
+	}
+
+	key, err := keyType()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a private key")
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a serial number")
+	}
+	serialNumber.Add(serialNumber, big.NewInt(1)) // serial number has to be positive
+	now := core.Now()
+	template, err := caTemplate(spiffeIDOf(mesh).String(), mesh, caSubject(mesh), key.Public(), now.Add(-DefaultAllowedClockSkew), now.Add(DefaultCACertValidityPeriod), serialNumber)
+	if err != nil {
+		return nil, err
+	}
+	// the intermediate CA only signs dataplane certificates
+	template.MaxPathLenZero = true
+	for _, opt := range certOpts {
+		opt(template)
+	}
+	if template.NotAfter.After(parentCert.NotAfter) {
+		template.NotAfter = parentCert.NotAfter
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, parentCert, key.Public(), parentSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 certificate")
+	}
+	pair, err := util_tls.ToKeyPair(key, cert)
+	if err != nil {
+		return nil, err
+	}
+	pair.CertPEM = append(pair.CertPEM, parent.CertPEM...)
+	return pair, nil
+}
+
+// newIntermediateCsr generates a key of the intermediate CA and a Certificate Signing Request that has to be signed by the root CA.
+func newIntermediateCsr(mesh string, keyType util_tls.KeyType) (keyPEM []byte, csrPEM []byte, err error) {
+	key, err := keyType()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: caSubject(mesh),
+		URIs:    []*url.URL{spiffeIDOf(mesh)},
+	}, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate a certificate signing request")
+	}
+	keyPEM, err = util_tls.PEMEncodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keyPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), nil
+}
+
+func spiffeIDOf(trustDomain string) *url.URL {
+	return &url.URL{
 		Scheme: "spiffe",
 		Host:   trustDomain,
 	}
-	subject := pkix.Name{
+}
+
+func caSubject(trustDomain string) pkix.Name {
+	return pkix.Name{
 		Organization:       []string{"Kuma"},
 		OrganizationalUnit: []string{"Mesh"},
 		CommonName:         trustDomain,
 	}
+}
+
+func newCACert(signer crypto.Signer, trustDomain string, certOpts ...certOptsFn) ([]byte, error) {
+	spiffeID := spiffeIDOf(trustDomain)
+	subject := caSubject(trustDomain)
 	now := core.Now()
 	notBefore := now.Add(-DefaultAllowedClockSkew)
 	notAfter := now.Add(DefaultCACertValidityPeriod)
@@ -7,6 +7,7 @@
 package config
 
 import (
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -30,6 +31,11 @@ type BuiltinCertificateAuthorityConfig struct {
 
 	// Configuration of CA Certificate
 	CaCert *BuiltinCertificateAuthorityConfig_CaCert `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// Configuration of the intermediate CA. If not defined, the CA is a
+	// self-signed root CA.
+	Intermediate *BuiltinCertificateAuthorityConfig_Intermediate `protobuf:"bytes,2,opt,name=intermediate,proto3" json:"intermediate,omitempty"`
+	// Configuration of dataplane certificates
+	DpCert *BuiltinCertificateAuthorityConfig_DpCert `protobuf:"bytes,3,opt,name=dpCert,proto3" json:"dpCert,omitempty"`
 }
 
 func (x *BuiltinCertificateAuthorityConfig) Reset() {
@@ -71,6 +77,20 @@ func (x *BuiltinCertificateAuthorityConfig) GetCaCert() *BuiltinCertificateAutho
 	return nil
 }
 
+func (x *BuiltinCertificateAuthorityConfig) GetIntermediate() *BuiltinCertificateAuthorityConfig_Intermediate {
+	if x != nil {
+		return x.Intermediate
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig) GetDpCert() *BuiltinCertificateAuthorityConfig_DpCert {
+	if x != nil {
+		return x.DpCert
+	}
+	return nil
+}
+
 // CaCert defines configuration for Certificate of CA.
 type BuiltinCertificateAuthorityConfig_CaCert struct {
 	state         protoimpl.MessageState
@@ -81,6 +101,9 @@ type BuiltinCertificateAuthorityConfig_CaCert struct {
 	RSAbits *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=RSAbits,proto3" json:"RSAbits,omitempty"`
 	// Expiration time of the certificate
 	Expiration string `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Algorithm of the key of the CA. One of "RSA", "ECDSA-P256",
+	// "ECDSA-P384" or "Ed25519". Defaults to "RSA".
+	KeyAlgorithm string `protobuf:"bytes,3,opt,name=keyAlgorithm,proto3" json:"keyAlgorithm,omitempty"`
 }
 
 func (x *BuiltinCertificateAuthorityConfig_CaCert) Reset() {
@@ -129,6 +152,272 @@ func (x *BuiltinCertificateAuthorityConfig_CaCert) GetExpiration() string {
 	return ""
 }
 
+func (x *BuiltinCertificateAuthorityConfig_CaCert) GetKeyAlgorithm() string {
+	if x != nil {
+		return x.KeyAlgorithm
+	}
+	return ""
+}
+
+// DpCert defines configuration of dataplane certificates.
+type BuiltinCertificateAuthorityConfig_DpCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Algorithm of keys of dataplane certificates. One of "RSA",
+	// "ECDSA-P256" or "ECDSA-P384". Defaults to "RSA". Generating ECDSA keys
+	// is much cheaper than RSA keys, which matters when many dataplanes
+	// rotate their certificates. Ed25519 is not supported, because Envoy
+	// only accepts RSA and ECDSA certificates.
+	KeyAlgorithm string `protobuf:"bytes,1,opt,name=keyAlgorithm,proto3" json:"keyAlgorithm,omitempty"`
+	// RSAbits of keys of dataplane certificates. Only used with "RSA".
+	RSAbits *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=RSAbits,proto3" json:"RSAbits,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_DpCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_DpCert) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_DpCert.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_DpCert) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) GetKeyAlgorithm() string {
+	if x != nil {
+		return x.KeyAlgorithm
+	}
+	return ""
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) GetRSAbits() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.RSAbits
+	}
+	return nil
+}
+
+// Intermediate defines configuration of the CA acting as an intermediate
+// CA chained to an external root CA.
+type BuiltinCertificateAuthorityConfig_Intermediate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*BuiltinCertificateAuthorityConfig_Intermediate_Parent_
+	//	*BuiltinCertificateAuthorityConfig_Intermediate_Csr_
+	Type isBuiltinCertificateAuthorityConfig_Intermediate_Type `protobuf_oneof:"type"`
+	// Data source for certificates of the root CA that are distributed to
+	// dataplanes as the trust bundle. Required for the CSR flow. Defaults to
+	// the certificate of the parent CA.
+	RootCert *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=rootCert,proto3" json:"rootCert,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_Intermediate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_Intermediate) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_Intermediate.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_Intermediate) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (m *BuiltinCertificateAuthorityConfig_Intermediate) GetType() isBuiltinCertificateAuthorityConfig_Intermediate_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) GetParent() *BuiltinCertificateAuthorityConfig_Intermediate_Parent {
+	if x, ok := x.GetType().(*BuiltinCertificateAuthorityConfig_Intermediate_Parent_); ok {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) GetCsr() *BuiltinCertificateAuthorityConfig_Intermediate_Csr {
+	if x, ok := x.GetType().(*BuiltinCertificateAuthorityConfig_Intermediate_Csr_); ok {
+		return x.Csr
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) GetRootCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.RootCert
+	}
+	return nil
+}
+
+type isBuiltinCertificateAuthorityConfig_Intermediate_Type interface {
+	isBuiltinCertificateAuthorityConfig_Intermediate_Type()
+}
+
+type BuiltinCertificateAuthorityConfig_Intermediate_Parent_ struct {
+	Parent *BuiltinCertificateAuthorityConfig_Intermediate_Parent `protobuf:"bytes,1,opt,name=parent,proto3,oneof"`
+}
+
+type BuiltinCertificateAuthorityConfig_Intermediate_Csr_ struct {
+	Csr *BuiltinCertificateAuthorityConfig_Intermediate_Csr `protobuf:"bytes,2,opt,name=csr,proto3,oneof"`
+}
+
+func (*BuiltinCertificateAuthorityConfig_Intermediate_Parent_) isBuiltinCertificateAuthorityConfig_Intermediate_Type() {
+}
+
+func (*BuiltinCertificateAuthorityConfig_Intermediate_Csr_) isBuiltinCertificateAuthorityConfig_Intermediate_Type() {
+}
+
+// Parent CA that signs the intermediate CA. Control Plane generates the
+// key of the intermediate CA and signs its certificate with the parent.
+type BuiltinCertificateAuthorityConfig_Intermediate_Parent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the certificate of the parent CA. It can contain a
+	// chain of certificates, starting with the certificate of the parent.
+	Cert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Data source for the key of the parent CA
+	Key *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Parent) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_Intermediate_Parent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Parent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_Intermediate_Parent) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Parent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_Intermediate_Parent.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_Intermediate_Parent) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Parent) GetCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Parent) GetKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// Certificate Signing Request flow. Control Plane generates the key of
+// the intermediate CA and stores the CSR in the Secret
+// "<mesh>.ca-builtin-csr-<backend>". The certificate signed by the root
+// CA has to be uploaded to the Secret "<mesh>.ca-builtin-cert-<backend>".
+type BuiltinCertificateAuthorityConfig_Intermediate_Csr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Csr) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_Intermediate_Csr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Csr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_Intermediate_Csr) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate_Csr) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_Intermediate_Csr.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_Intermediate_Csr) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 2, 1}
+}
+
 var File_pkg_plugins_ca_builtin_config_builtin_ca_config_proto protoreflect.FileDescriptor
 
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
@@ -138,24 +427,71 @@ var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
 	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c,
 	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x21, 0x42, 0x75, 0x69,
-	0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51,
-	0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61,
-	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72,
-	0x74, 0x1a, 0x60, 0x0a, 0x06, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52,
-	0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41, 0x62,
-	0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x07, 0x0a, 0x21, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x51, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x63, 0x61, 0x43,
+	0x65, 0x72, 0x74, 0x12, 0x63, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x64, 0x70, 0x43, 0x65,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x70, 0x43,
+	0x65, 0x72, 0x74, 0x52, 0x06, 0x64, 0x70, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x84, 0x01, 0x0a, 0x06,
+	0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x1a, 0x64, 0x0a, 0x06, 0x44, 0x70, 0x43, 0x65, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x6b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x36, 0x0a, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x1a, 0x8a, 0x03, 0x0a, 0x0c, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x03, 0x63,
+	0x73, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x73, 0x72, 0x48, 0x00, 0x52,
+	0x03, 0x63, 0x73, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x1a, 0x72, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04,
+	0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x12, 0x32, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x05, 0x0a, 0x03, 0x43, 0x73, 0x72, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -170,20 +506,33 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP() []
 	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescData
 }
 
-var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_goTypes = []interface{}{
-	(*BuiltinCertificateAuthorityConfig)(nil),        // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig
-	(*BuiltinCertificateAuthorityConfig_CaCert)(nil), // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
-	(*wrapperspb.UInt32Value)(nil),                   // 2: google.protobuf.UInt32Value
+	(*BuiltinCertificateAuthorityConfig)(nil),                     // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig
+	(*BuiltinCertificateAuthorityConfig_CaCert)(nil),              // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
+	(*BuiltinCertificateAuthorityConfig_DpCert)(nil),              // 2: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.DpCert
+	(*BuiltinCertificateAuthorityConfig_Intermediate)(nil),        // 3: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate
+	(*BuiltinCertificateAuthorityConfig_Intermediate_Parent)(nil), // 4: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.Parent
+	(*BuiltinCertificateAuthorityConfig_Intermediate_Csr)(nil),    // 5: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.Csr
+	(*wrapperspb.UInt32Value)(nil),                                // 6: google.protobuf.UInt32Value
+	(*v1alpha1.DataSource)(nil),                                   // 7: kuma.system.v1alpha1.DataSource
 }
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_depIdxs = []int32{
-	1, // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.caCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
-	2, // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.caCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
+	3,  // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.intermediate:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate
+	2,  // 2: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.dpCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.DpCert
+	6,  // 3: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
+	6,  // 4: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.DpCert.RSAbits:type_name -> google.protobuf.UInt32Value
+	4,  // 5: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.parent:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.Parent
+	5,  // 6: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.csr:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.Csr
+	7,  // 7: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.rootCert:type_name -> kuma.system.v1alpha1.DataSource
+	7,  // 8: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.Parent.cert:type_name -> kuma.system.v1alpha1.DataSource
+	7,  // 9: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.Parent.key:type_name -> kuma.system.v1alpha1.DataSource
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() }
@@ -216,6 +565,58 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_DpCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_Intermediate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_Intermediate_Parent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_Intermediate_Csr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*BuiltinCertificateAuthorityConfig_Intermediate_Parent_)(nil),
+		(*BuiltinCertificateAuthorityConfig_Intermediate_Csr_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -223,7 +624,7 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
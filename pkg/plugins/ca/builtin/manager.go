@@ -10,6 +10,7 @@ import (
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -17,20 +18,26 @@ import (
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_validators "github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
+	"github.com/kumahq/kuma/pkg/plugins/ca/provided"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 type builtinCaManager struct {
-	secretManager manager.ResourceManager
+	secretManager    manager.ResourceManager
+	dataSourceLoader datasource.Loader
 }
 
 func NewBuiltinCaManager(secretManager manager.ResourceManager) core_ca.Manager {
 	return &builtinCaManager{
-		secretManager: secretManager,
+		secretManager:    secretManager,
+		dataSourceLoader: datasource.NewDataSourceLoader(secretManager),
 	}
 }
 
 var _ core_ca.Manager = &builtinCaManager{}
+var _ core_ca.RevocationListIssuer = &builtinCaManager{}
+var _ core_ca.SecretValidator = &builtinCaManager{}
 
 func (b *builtinCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
 	for _, backend := range backends {
@@ -57,14 +64,118 @@ func (b *builtinCaManager) ValidateBackend(ctx context.Context, mesh string, bac
 		verr.AddViolation("", "could not convert backend config: "+err.Error())
 		return verr.OrNil()
 	}
+	if _, err := util_tls.NewKeyType(cfg.GetCaCert().GetKeyAlgorithm(), 0); err != nil {
+		verr.AddViolation("caCert.keyAlgorithm", err.Error())
+	}
+	if cfg.GetDpCert().GetKeyAlgorithm() == util_tls.Ed25519KeyAlgorithm {
+		verr.AddViolation("dpCert.keyAlgorithm", "Ed25519 is not supported by Envoy. Use RSA or ECDSA")
+	} else if _, err := util_tls.NewKeyType(cfg.GetDpCert().GetKeyAlgorithm(), 0); err != nil {
+		verr.AddViolation("dpCert.keyAlgorithm", err.Error())
+	}
+	if intermediate := cfg.GetIntermediate(); intermediate != nil {
+		verr.AddError("intermediate", b.validateIntermediate(ctx, mesh, backend.Name, intermediate))
+	}
+	return verr.OrNil()
+}
+
+func (b *builtinCaManager) validateIntermediate(ctx context.Context, mesh string, backendName string, intermediate *config.BuiltinCertificateAuthorityConfig_Intermediate) core_validators.ValidationError {
+	verr := core_validators.ValidationError{}
+	if intermediate.GetRootCert() != nil {
+		verr.AddError("rootCert", datasource.Validate(intermediate.GetRootCert()))
+	}
+	switch {
+	case intermediate.GetParent() != nil:
+		parent := intermediate.GetParent()
+		if parent.GetCert() == nil {
+			verr.AddViolation("parent.cert", "has to be defined")
+		} else {
+			verr.AddError("parent.cert", datasource.Validate(parent.GetCert()))
+		}
+		if parent.GetKey() == nil {
+			verr.AddViolation("parent.key", "has to be defined")
+		} else {
+			verr.AddError("parent.key", datasource.Validate(parent.GetKey()))
+		}
+		if !verr.HasViolations() {
+			pair, err := b.loadParent(ctx, mesh, parent)
+			if err != nil {
+				verr.AddViolation("parent", err.Error())
+			} else if err := provided.ValidateCaCert(pair); err != nil {
+				verr.AddViolation("parent", err.Error())
+			}
+		}
+	case intermediate.GetCsr() != nil:
+		if intermediate.GetRootCert() == nil {
+			verr.AddViolation("rootCert", "has to be defined when the CSR flow is used")
+		}
+		certSecret := core_system.NewSecretResource()
+		if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err == nil {
+			if err := b.validateUploadedCert(ctx, mesh, backendName, certSecret.Spec.GetData().GetValue()); err != nil {
+				verr.AddViolation("csr", err.Error())
+			}
+		} else if !core_store.IsResourceNotFound(err) {
+			verr.AddViolation("csr", fmt.Sprintf("could not load the certificate of the Intermediate CA: %s", err))
+		}
+	default:
+		verr.AddViolation("", "either parent or csr has to be defined")
+	}
+	return verr
+}
+
+// ValidateSecret validates the certificate of the Intermediate CA uploaded by the user in the CSR flow.
+func (b *builtinCaManager) ValidateSecret(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, name string, data []byte) error {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	if cfg.GetIntermediate().GetCsr() == nil || name != certSecretResKey(mesh, backend.Name).Name {
+		return nil
+	}
+	return b.validateUploadedCert(ctx, mesh, backend.Name, data)
+}
+
+// validateUploadedCert checks that the certificate of the Intermediate CA was issued for the key generated together with the CSR,
+// otherwise the mismatch would surface only when dataplane certificates are signed.
+func (b *builtinCaManager) validateUploadedCert(ctx context.Context, mesh string, backendName string, cert []byte) error {
+	keySecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, keySecret, core_store.GetBy(keySecretResKey(mesh, backendName))); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return errors.Errorf("the key of the Intermediate CA is not generated yet. Upload the certificate signed from the CSR in the Secret %q", csrSecretResKey(mesh, backendName).Name)
+		}
+		return errors.Wrap(err, "could not load the key of the Intermediate CA")
+	}
+	if err := provided.ValidateCaCert(core_ca.KeyPair{
+		CertPEM: cert,
+		KeyPEM:  keySecret.Spec.GetData().GetValue(),
+	}); err != nil {
+		return errors.Wrapf(err, "the certificate does not match the CSR from the Secret %q", csrSecretResKey(mesh, backendName).Name)
+	}
 	return nil
 }
 
 func (b *builtinCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
-	return []string{
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	secrets := []string{
 		certSecretResKey(mesh, backend.Name).Name,
 		keySecretResKey(mesh, backend.Name).Name,
-	}, nil
+	}
+	intermediate := cfg.GetIntermediate()
+	if intermediate.GetCsr() != nil {
+		secrets = append(secrets, csrSecretResKey(mesh, backend.Name).Name)
+	}
+	for _, source := range []*system_proto.DataSource{
+		intermediate.GetRootCert(),
+		intermediate.GetParent().GetCert(),
+		intermediate.GetParent().GetKey(),
+	} {
+		if source.GetSecret() != "" {
+			secrets = append(secrets, source.GetSecret())
+		}
+	}
+	return secrets, nil
 }
 
 func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
@@ -81,9 +192,29 @@ func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mes
 		}
 		opts = append(opts, withExpirationTime(duration))
 	}
-	keyPair, err := newRootCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), opts...)
+	keyType, err := util_tls.NewKeyType(cfg.GetCaCert().GetKeyAlgorithm(), int(cfg.GetCaCert().GetRSAbits().GetValue()))
 	if err != nil {
-		return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
+		return err
+	}
+
+	var keyPair *core_ca.KeyPair
+	switch intermediate := cfg.GetIntermediate(); {
+	case intermediate.GetCsr() != nil:
+		return b.createCsr(ctx, mesh, backend.Name, keyType)
+	case intermediate.GetParent() != nil:
+		parent, err := b.loadParent(ctx, mesh, intermediate.GetParent())
+		if err != nil {
+			return err
+		}
+		keyPair, err = newIntermediateCa(mesh, keyType, parent, opts...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate an Intermediate CA cert for Mesh %q", mesh)
+		}
+	default:
+		keyPair, err = newRootCa(mesh, keyType, opts...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
+		}
 	}
 
 	certSecret := &core_system.SecretResource{
@@ -106,6 +237,63 @@ func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mes
 	return nil
 }
 
+// createCsr generates the key of the intermediate CA and the CSR. The certificate is uploaded by the user once the CSR is signed by the root CA.
+func (b *builtinCaManager) createCsr(ctx context.Context, mesh string, backendName string, keyType util_tls.KeyType) error {
+	keySecret := core_system.NewSecretResource()
+	err := b.secretManager.Get(ctx, keySecret, core_store.GetBy(keySecretResKey(mesh, backendName)))
+	if err == nil { // CSR is already generated, but the signed certificate is not uploaded yet
+		return nil
+	}
+	if !core_store.IsResourceNotFound(err) {
+		return err
+	}
+
+	keyPEM, csrPEM, err := newIntermediateCsr(mesh, keyType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate a CSR of the Intermediate CA for Mesh %q", mesh)
+	}
+
+	// CSR can be left from a previous attempt, so it is replaced to match the new key
+	csrSecret := core_system.NewSecretResource()
+	err = b.secretManager.Get(ctx, csrSecret, core_store.GetBy(csrSecretResKey(mesh, backendName)))
+	switch {
+	case core_store.IsResourceNotFound(err):
+		csrSecret.Spec.Data = util_proto.Bytes(csrPEM)
+		if err := b.secretManager.Create(ctx, csrSecret, core_store.CreateBy(csrSecretResKey(mesh, backendName))); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		csrSecret.Spec.Data = util_proto.Bytes(csrPEM)
+		if err := b.secretManager.Update(ctx, csrSecret); err != nil {
+			return err
+		}
+	}
+
+	keySecret = &core_system.SecretResource{
+		Spec: &system_proto.Secret{
+			Data: util_proto.Bytes(keyPEM),
+		},
+	}
+	return b.secretManager.Create(ctx, keySecret, core_store.CreateBy(keySecretResKey(mesh, backendName)))
+}
+
+func (b *builtinCaManager) loadParent(ctx context.Context, mesh string, parent *config.BuiltinCertificateAuthorityConfig_Intermediate_Parent) (core_ca.KeyPair, error) {
+	cert, err := b.dataSourceLoader.Load(ctx, mesh, parent.GetCert())
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not load the certificate of the parent CA")
+	}
+	key, err := b.dataSourceLoader.Load(ctx, mesh, parent.GetKey())
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not load the key of the parent CA")
+	}
+	return core_ca.KeyPair{
+		CertPEM: cert,
+		KeyPEM:  key,
+	}, nil
+}
+
 func certSecretResKey(mesh string, backendName string) core_model.ResourceKey {
 	return core_model.ResourceKey{
 		Mesh: mesh,
@@ -113,6 +301,13 @@ func certSecretResKey(mesh string, backendName string) core_model.ResourceKey {
 	}
 }
 
+func csrSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-csr-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
 func keySecretResKey(mesh string, backendName string) core_model.ResourceKey {
 	return core_model.ResourceKey{
 		Mesh: mesh,
@@ -121,6 +316,23 @@ func keySecretResKey(mesh string, backendName string) core_model.ResourceKey {
 }
 
 func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	if intermediate := cfg.GetIntermediate(); intermediate != nil {
+		// dataplanes trust the external root, so they can talk to meshes and clients that are chained to the same root
+		rootCert := intermediate.GetRootCert()
+		if rootCert == nil {
+			rootCert = intermediate.GetParent().GetCert()
+		}
+		cert, err := b.dataSourceLoader.Load(ctx, mesh, rootCert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load the root CA cert for Mesh %q and backend %q", mesh, backend.Name)
+		}
+		return []core_ca.Cert{cert}, nil
+	}
+
 	ca, err := b.getCa(ctx, mesh, backend.Name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
@@ -129,8 +341,17 @@ func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend
 }
 
 func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (core_ca.KeyPair, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+
 	ca, err := b.getCa(ctx, mesh, backend.Name)
 	if err != nil {
+		if core_store.IsResourceNotFound(err) && cfg.GetIntermediate().GetCsr() != nil {
+			return core_ca.KeyPair{}, errors.Errorf("certificate of the Intermediate CA for Mesh %q and backend %q is not uploaded. Sign the CSR from the Secret %q with the root CA and upload the certificate to the Secret %q",
+				mesh, backend.Name, csrSecretResKey(mesh, backend.Name).Name, certSecretResKey(mesh, backend.Name).Name)
+		}
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
 
@@ -142,13 +363,38 @@ func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh strin
 		}
 		opts = append(opts, ca_issuer.WithExpirationTime(duration))
 	}
-	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, tags, opts...)
+	keyType, err := util_tls.NewKeyType(cfg.GetDpCert().GetKeyAlgorithm(), int(cfg.GetDpCert().GetRSAbits().GetValue()))
+	if err != nil {
+		return core_ca.KeyPair{}, err
+	}
+	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, tags, keyType, opts...)
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend)
 	}
+	if cfg.GetIntermediate() != nil {
+		// dataplanes only trust the root, so the chain up to the root has to be presented
+		keyPair.CertPEM = append(keyPair.CertPEM, ca.CertPEM...)
+	}
 	return *keyPair, nil
 }
 
+func (b *builtinCaManager) GenerateRevocationList(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, revoked core_ca.RevokedCerts) ([][]byte, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	// in the intermediate mode the list is signed by the intermediate CA which issues dataplane certificates
+	ca, err := b.getCa(ctx, mesh, backend.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	crl, err := ca_issuer.NewRevocationList(ca, revoked)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate a revocation list for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return [][]byte{crl}, nil
+}
+
 func (b *builtinCaManager) getCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, error) {
 	certSecret := core_system.NewSecretResource()
 	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err != nil {
@@ -2,20 +2,24 @@ package builtin_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
-	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	"github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin"
@@ -35,7 +39,7 @@ var _ = Describe("Builtin CA Manager", func() {
 		core.Now = func() time.Time {
 			return now
 		}
-		secretManager = secret_manager.NewSecretManager(store.NewSecretStore(memory.NewStore()), cipher.None(), nil)
+		secretManager = secret_manager.NewSecretManager(store.NewSecretStore(memory.NewStore()), nil)
 		caManager = builtin.NewBuiltinCaManager(secretManager)
 	})
 
@@ -244,4 +248,397 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-non-existent": Resource not found: type="Secret" name="default.ca-builtin-cert-builtin-non-existent" mesh="default"`))
 		})
 	})
+
+	Context("GenerateRevocationList", func() {
+		It("should generate a revocation list signed by the CA", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			Expect(caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})).To(Succeed())
+			revoked := core_ca.RevokedCerts{{
+				Serial:    "1a2b",
+				Backend:   "builtin-1",
+				RevokedAt: now,
+				ExpiresAt: now.Add(time.Hour),
+			}}
+
+			// when
+			crls, err := caManager.(core_ca.RevocationListIssuer).GenerateRevocationList(context.Background(), mesh, backend, revoked)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crls).To(HaveLen(1))
+			crl, err := x509.ParseCRL(crls[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber.Text(16)).To(Equal("1a2b"))
+
+			// and the list is signed by the CA
+			rootCerts, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(rootCerts[0])
+			rootCert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCert.CheckCRLSignature(crl)).To(Succeed())
+		})
+	})
+
+	Context("Key algorithms", func() {
+		DescribeTable("should generate CA and dataplane certs",
+			func(caAlgorithm string, dpAlgorithm string, expectedCaKey x509.PublicKeyAlgorithm, expectedDpKey x509.PublicKeyAlgorithm) {
+				// given
+				mesh := "default"
+				backend := &mesh_proto.CertificateAuthorityBackend{
+					Name: "builtin-1",
+					Type: "builtin",
+					Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+						CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+							KeyAlgorithm: caAlgorithm,
+						},
+						DpCert: &config.BuiltinCertificateAuthorityConfig_DpCert{
+							KeyAlgorithm: dpAlgorithm,
+						},
+					}),
+				}
+				Expect(caManager.ValidateBackend(context.Background(), mesh, backend)).To(Succeed())
+				Expect(caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})).To(Succeed())
+
+				// when
+				roots, err := caManager.GetRootCert(context.Background(), mesh, backend)
+				Expect(err).ToNot(HaveOccurred())
+				pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, mesh_proto.MultiValueTagSetFrom(map[string][]string{
+					"kuma.io/service": {"web"},
+				}))
+				Expect(err).ToNot(HaveOccurred())
+
+				// then
+				block, _ := pem.Decode(roots[0])
+				caCert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(caCert.PublicKeyAlgorithm).To(Equal(expectedCaKey))
+
+				block, _ = pem.Decode(pair.CertPEM)
+				dpCert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dpCert.PublicKeyAlgorithm).To(Equal(expectedDpKey))
+				Expect(dpCert.CheckSignatureFrom(caCert)).To(Succeed())
+			},
+			Entry("RSA by default", "", "", x509.RSA, x509.RSA),
+			Entry("ECDSA P-256", "ECDSA-P256", "ECDSA-P256", x509.ECDSA, x509.ECDSA),
+			Entry("ECDSA P-384 CA with RSA dataplanes", "ECDSA-P384", "RSA", x509.ECDSA, x509.RSA),
+			Entry("Ed25519 CA with ECDSA dataplanes", "Ed25519", "ECDSA-P256", x509.Ed25519, x509.ECDSA),
+		)
+
+		It("should validate key algorithms", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+						KeyAlgorithm: "DSA",
+					},
+					DpCert: &config.BuiltinCertificateAuthorityConfig_DpCert{
+						KeyAlgorithm: "Ed25519",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError(`caCert.keyAlgorithm: unsupported key algorithm "DSA". Supported algorithms are [RSA ECDSA-P256 ECDSA-P384 Ed25519]; dpCert.keyAlgorithm: Ed25519 is not supported by Envoy. Use RSA or ECDSA`))
+		})
+	})
+
+	Context("Intermediate CA", func() {
+		var rootCert *x509.Certificate
+		var rootKey *rsa.PrivateKey
+		var rootCertPEM, rootKeyPEM []byte
+
+		BeforeEach(func() {
+			var err error
+			rootKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "Org Root CA"},
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(24 * time.Hour),
+				KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+				BasicConstraintsValid: true,
+				IsCA:                  true,
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, template, &rootKey.PublicKey, rootKey)
+			Expect(err).ToNot(HaveOccurred())
+			rootCert, err = x509.ParseCertificate(der)
+			Expect(err).ToNot(HaveOccurred())
+			rootCertPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+			rootKeyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rootKey)})
+		})
+
+		verifyChain := func(pair core_ca.KeyPair, roots []core_ca.Cert) {
+			pool := x509.NewCertPool()
+			for _, root := range roots {
+				Expect(pool.AppendCertsFromPEM(root)).To(BeTrue())
+			}
+			var certs []*x509.Certificate
+			rest := pair.CertPEM
+			for {
+				var block *pem.Block
+				block, rest = pem.Decode(rest)
+				if block == nil {
+					break
+				}
+				cert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				certs = append(certs, cert)
+			}
+			Expect(len(certs)).To(BeNumerically(">", 1))
+			intermediates := x509.NewCertPool()
+			for _, cert := range certs[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := certs[0].Verify(x509.VerifyOptions{
+				Roots:         pool,
+				Intermediates: intermediates,
+				CurrentTime:   now,
+				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+			"kuma.io/service": {"web"},
+		})
+
+		It("should sign the intermediate CA with the parent CA", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					Intermediate: &config.BuiltinCertificateAuthorityConfig_Intermediate{
+						Type: &config.BuiltinCertificateAuthorityConfig_Intermediate_Parent_{
+							Parent: &config.BuiltinCertificateAuthorityConfig_Intermediate_Parent{
+								Cert: &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: util_proto.Bytes(rootCertPEM)}},
+								Key:  &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: util_proto.Bytes(rootKeyPEM)}},
+							},
+						},
+					},
+				}),
+			}
+			Expect(caManager.ValidateBackend(context.Background(), mesh, backend)).To(Succeed())
+
+			// when
+			err := caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and the intermediate CA is signed by the root and does not outlive it
+			secretRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), secretRes, core_store.GetByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(secretRes.Spec.Data.Value)
+			intermediate, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(intermediate.CheckSignatureFrom(rootCert)).To(Succeed())
+			Expect(intermediate.IsCA).To(BeTrue())
+			Expect(intermediate.NotAfter).To(Equal(rootCert.NotAfter))
+
+			// when
+			roots, err := caManager.GetRootCert(context.Background(), mesh, backend)
+
+			// then the trust bundle is the root of the organization
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roots).To(Equal([]core_ca.Cert{rootCertPEM}))
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, tags)
+
+			// then the dataplane cert chains to the root
+			Expect(err).ToNot(HaveOccurred())
+			verifyChain(pair, roots)
+
+			// when
+			crls, err := caManager.(core_ca.RevocationListIssuer).GenerateRevocationList(context.Background(), mesh, backend, core_ca.RevokedCerts{{
+				Serial:    "1a2b",
+				Backend:   "builtin-1",
+				RevokedAt: now,
+				ExpiresAt: now.Add(time.Hour),
+			}})
+
+			// then the revocation list is signed by the intermediate CA that issues dataplane certs
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crls).To(HaveLen(1))
+			crl, err := x509.ParseCRL(crls[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(intermediate.CheckCRLSignature(crl)).To(Succeed())
+		})
+
+		It("should generate CSR and use the uploaded intermediate CA", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					Intermediate: &config.BuiltinCertificateAuthorityConfig_Intermediate{
+						Type: &config.BuiltinCertificateAuthorityConfig_Intermediate_Csr_{
+							Csr: &config.BuiltinCertificateAuthorityConfig_Intermediate_Csr{},
+						},
+						RootCert: &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: util_proto.Bytes(rootCertPEM)}},
+					},
+				}),
+			}
+			Expect(caManager.ValidateBackend(context.Background(), mesh, backend)).To(Succeed())
+
+			// when
+			err := caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then CSR is stored
+			Expect(err).ToNot(HaveOccurred())
+			csrRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), csrRes, core_store.GetByKey("default.ca-builtin-csr-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(csrRes.Spec.Data.Value)
+			csr, err := x509.ParseCertificateRequest(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(csr.URIs[0].String()).To(Equal("spiffe://default"))
+
+			// when called again
+			err = caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then CSR is not regenerated
+			Expect(err).ToNot(HaveOccurred())
+			sameCsrRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), sameCsrRes, core_store.GetByKey("default.ca-builtin-csr-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sameCsrRes.Spec.Data.Value).To(Equal(csrRes.Spec.Data.Value))
+
+			// when the certificate is not uploaded yet
+			_, err = caManager.GenerateDataplaneCert(context.Background(), mesh, backend, tags)
+
+			// then
+			Expect(err).To(MatchError(`certificate of the Intermediate CA for Mesh "default" and backend "builtin-1" is not uploaded. Sign the CSR from the Secret "default.ca-builtin-csr-builtin-1" with the root CA and upload the certificate to the Secret "default.ca-builtin-cert-builtin-1"`))
+
+			// when the admin signs the CSR and uploads the certificate
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(2),
+				Subject:               csr.Subject,
+				URIs:                  csr.URIs,
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(12 * time.Hour),
+				KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+				BasicConstraintsValid: true,
+				IsCA:                  true,
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, rootCert, csr.PublicKey, rootKey)
+			Expect(err).ToNot(HaveOccurred())
+			certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+			Expect(caManager.(core_ca.SecretValidator).ValidateSecret(context.Background(), mesh, backend, "default.ca-builtin-cert-builtin-1", certPEM)).To(Succeed())
+			certRes := &system.SecretResource{
+				Spec: &system_proto.Secret{
+					Data: util_proto.Bytes(certPEM),
+				},
+			}
+			err = secretManager.Create(context.Background(), certRes, core_store.CreateByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, tags)
+
+			// then the dataplane cert chains to the root
+			Expect(err).ToNot(HaveOccurred())
+			roots, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			verifyChain(pair, roots)
+
+			// and the backend with the uploaded certificate is valid
+			Expect(caManager.ValidateBackend(context.Background(), mesh, backend)).To(Succeed())
+		})
+
+		It("should reject uploaded intermediate CA that does not match the CSR", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					Intermediate: &config.BuiltinCertificateAuthorityConfig_Intermediate{
+						Type: &config.BuiltinCertificateAuthorityConfig_Intermediate_Csr_{
+							Csr: &config.BuiltinCertificateAuthorityConfig_Intermediate_Csr{},
+						},
+						RootCert: &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: util_proto.Bytes(rootCertPEM)}},
+					},
+				}),
+			}
+			Expect(caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})).To(Succeed())
+
+			// and a certificate signed for other key than the key of the CSR
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(2),
+				Subject:               pkix.Name{CommonName: "Intermediate CA"},
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(12 * time.Hour),
+				KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+				BasicConstraintsValid: true,
+				IsCA:                  true,
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, rootCert, &otherKey.PublicKey, rootKey)
+			Expect(err).ToNot(HaveOccurred())
+			certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+			// when
+			err = caManager.(core_ca.SecretValidator).ValidateSecret(context.Background(), mesh, backend, "default.ca-builtin-cert-builtin-1", certPEM)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`the certificate does not match the CSR from the Secret "default.ca-builtin-csr-builtin-1"`))
+
+			// and other secrets are not validated
+			err = caManager.(core_ca.SecretValidator).ValidateSecret(context.Background(), mesh, backend, "other-secret", certPEM)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when the certificate was stored bypassing the validation
+			err = secretManager.Create(context.Background(), &system.SecretResource{
+				Spec: &system_proto.Secret{
+					Data: util_proto.Bytes(certPEM),
+				},
+			}, core_store.CreateByKey("default.ca-builtin-cert-builtin-1", mesh))
+			Expect(err).ToNot(HaveOccurred())
+			err = caManager.ValidateBackend(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("intermediate.csr: the certificate does not match the CSR"))
+		})
+
+		It("should validate intermediate config", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					Intermediate: &config.BuiltinCertificateAuthorityConfig_Intermediate{
+						Type: &config.BuiltinCertificateAuthorityConfig_Intermediate_Csr_{
+							Csr: &config.BuiltinCertificateAuthorityConfig_Intermediate_Csr{},
+						},
+					},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError("intermediate.rootCert: has to be defined when the CSR flow is used"))
+		})
+	})
 })
@@ -12,6 +12,7 @@ import (
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/plugins/ca/provided/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
@@ -20,6 +21,7 @@ type providedCaManager struct {
 }
 
 var _ ca.Manager = &providedCaManager{}
+var _ ca.RevocationListIssuer = &providedCaManager{}
 
 func NewProvidedCaManager(dataSourceLoader datasource.Loader) ca.Manager {
 	return &providedCaManager{
@@ -120,9 +122,21 @@ func (p *providedCaManager) GenerateDataplaneCert(ctx context.Context, mesh stri
 		}
 		opts = append(opts, ca_issuer.WithExpirationTime(duration))
 	}
-	keyPair, err := ca_issuer.NewWorkloadCert(meshCa, mesh, tags, opts...)
+	keyPair, err := ca_issuer.NewWorkloadCert(meshCa, mesh, tags, util_tls.DefaultKeyType, opts...)
 	if err != nil {
 		return ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
 	}
 	return *keyPair, nil
 }
+
+func (p *providedCaManager) GenerateRevocationList(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, revoked ca.RevokedCerts) ([][]byte, error) {
+	meshCa, err := p.getCa(ctx, mesh, backend)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	crl, err := ca_issuer.NewRevocationList(meshCa, revoked)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate a revocation list for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return [][]byte{crl}, nil
+}
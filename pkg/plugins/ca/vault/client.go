@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultAppRoleMountPath = "approle"
+	requestTimeout          = 10 * time.Second
+)
+
+type tlsConfig struct {
+	caCert     []byte
+	skipVerify bool
+	serverName string
+}
+
+// tokenFn returns a token used to authenticate requests to Vault.
+type tokenFn func(ctx context.Context, c *vaultClient) (string, error)
+
+// vaultClient is a minimal client of the HTTP API of Vault. It covers only endpoints that are needed by the CA.
+type vaultClient struct {
+	httpClient *http.Client
+	address    string
+	namespace  string
+	token      tokenFn
+}
+
+func newVaultClient(address string, namespace string, tlsCfg tlsConfig, token tokenFn) (*vaultClient, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: tlsCfg.skipVerify,
+		ServerName:         tlsCfg.serverName,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if len(tlsCfg.caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(tlsCfg.caCert) {
+			return nil, errors.New("could not parse CA certificate of Vault")
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	return &vaultClient{
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+		address:   strings.TrimSuffix(address, "/"),
+		namespace: namespace,
+		token:     token,
+	}, nil
+}
+
+// close releases idle connections of the client, it is called when the client is no longer used.
+func (c *vaultClient) close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+type vaultErrorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+func (c *vaultClient) do(ctx context.Context, method string, path string, body interface{}, authenticated bool, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address+"/v1/"+strings.TrimPrefix(path, "/"), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("content-type", "application/json")
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if authenticated {
+		token, err := c.token(ctx, c)
+		if err != nil {
+			return errors.Wrap(err, "could not authenticate to Vault")
+		}
+		req.Header.Set("X-Vault-Token", token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errResp := vaultErrorResponse{}
+		if err := json.Unmarshal(respBody, &errResp); err == nil && len(errResp.Errors) > 0 {
+			return errors.Errorf("Vault returned %d: %s", resp.StatusCode, strings.Join(errResp.Errors, ", "))
+		}
+		return errors.Errorf("Vault returned %d", resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+type loginResponse struct {
+	Auth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+	} `json:"auth"`
+}
+
+// appRoleLogin exchanges Role ID and Secret ID for a token.
+func (c *vaultClient) appRoleLogin(ctx context.Context, mountPath string, roleID string, secretID string) (string, time.Duration, error) {
+	if mountPath == "" {
+		mountPath = defaultAppRoleMountPath
+	}
+	resp := loginResponse{}
+	body := map[string]string{
+		"role_id":   roleID,
+		"secret_id": secretID,
+	}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", mountPath), body, false, &resp); err != nil {
+		return "", 0, err
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", 0, errors.New("Vault did not return a token")
+	}
+	return resp.Auth.ClientToken, time.Duration(resp.Auth.LeaseDuration) * time.Second, nil
+}
+
+type certResponse struct {
+	Data struct {
+		Certificate string   `json:"certificate"`
+		IssuingCA   string   `json:"issuing_ca"`
+		CAChain     []string `json:"ca_chain"`
+	} `json:"data"`
+}
+
+// caCert returns the certificate of the CA of the PKI mount.
+func (c *vaultClient) caCert(ctx context.Context, pki string) ([]byte, error) {
+	resp := certResponse{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/cert/ca", pki), nil, true, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Certificate == "" {
+		return nil, errors.Errorf("PKI mount %q does not have a CA certificate", pki)
+	}
+	return []byte(resp.Data.Certificate), nil
+}
+
+type signRequest struct {
+	CSR        string `json:"csr"`
+	CommonName string `json:"common_name,omitempty"`
+	URISans    string `json:"uri_sans"`
+	TTL        string `json:"ttl"`
+	Format     string `json:"format"`
+}
+
+// sign signs the CSR with the role of the PKI mount. It returns the certificate followed by the chain of intermediate CAs.
+func (c *vaultClient) sign(ctx context.Context, pki string, role string, req signRequest) ([]byte, error) {
+	resp := certResponse{}
+	req.Format = "pem"
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/sign/%s", pki, role), req, true, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Certificate == "" {
+		return nil, errors.New("Vault did not return a certificate")
+	}
+	chain := []string{strings.TrimSpace(resp.Data.Certificate)}
+	if len(resp.Data.CAChain) > 0 {
+		for _, cert := range resp.Data.CAChain {
+			chain = append(chain, strings.TrimSpace(cert))
+		}
+	} else if resp.Data.IssuingCA != "" {
+		chain = append(chain, strings.TrimSpace(resp.Data.IssuingCA))
+	}
+	return []byte(strings.Join(chain, "\n") + "\n"), nil
+}
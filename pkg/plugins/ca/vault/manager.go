@@ -0,0 +1,313 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/ca"
+	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/ca/vault/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
+)
+
+// maxTokenRenewalMargin is the longest time before expiration when a token obtained from AppRole login is renewed.
+const maxTokenRenewalMargin = 30 * time.Second
+
+// tokenRenewalMargin returns how long before expiration a token is renewed.
+// It is a third of the lease, so tokens with short leases are reused as well.
+func tokenRenewalMargin(ttl time.Duration) time.Duration {
+	if margin := ttl / 3; margin < maxTokenRenewalMargin {
+		return margin
+	}
+	return maxTokenRenewalMargin
+}
+
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// cachedClient is a client of Vault built for a CA backend of a mesh.
+// It is reused until the backend config or the CA certificate of Vault changes, so connections to Vault are kept alive.
+type cachedClient struct {
+	cfg    *config.VaultCertificateAuthorityConfig
+	caCert []byte
+	client *vaultClient
+}
+
+type vaultCaManager struct {
+	dataSourceLoader datasource.Loader
+
+	sync.Mutex
+	tokens map[string]cachedToken
+	// logins ensures that there is only one AppRole login in flight for a token
+	logins singleflight.Group
+
+	clientsMux sync.Mutex
+	clients    map[string]*cachedClient
+}
+
+var _ ca.Manager = &vaultCaManager{}
+
+// NewVaultCaManager returns a CA manager that delegates signing of dataplane certificates to the PKI secrets engine of Vault.
+// Neither the key of the CA nor its certificate is stored by Kuma.
+func NewVaultCaManager(dataSourceLoader datasource.Loader) ca.Manager {
+	return &vaultCaManager{
+		dataSourceLoader: dataSourceLoader,
+		tokens:           map[string]cachedToken{},
+		clients:          map[string]*cachedClient{},
+	}
+}
+
+func (v *vaultCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+
+	if cfg.GetAddress() == "" {
+		verr.AddViolation("address", "has to be defined")
+	} else if u, err := url.Parse(cfg.GetAddress()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.AddViolation("address", "has to be a valid http or https URL")
+	}
+	if cfg.GetPki() == "" {
+		verr.AddViolation("pki", "has to be defined")
+	}
+	if cfg.GetRole() == "" {
+		verr.AddViolation("role", "has to be defined")
+	}
+	if cfg.GetTls().GetCaCert() != nil {
+		verr.AddError("tls.caCert", datasource.Validate(cfg.GetTls().GetCaCert()))
+	}
+	switch {
+	case cfg.GetAuth().GetToken() != nil:
+		if cfg.GetAuth().GetToken().GetSecret() == nil {
+			verr.AddViolation("auth.token.secret", "has to be defined")
+		} else {
+			verr.AddError("auth.token.secret", datasource.Validate(cfg.GetAuth().GetToken().GetSecret()))
+		}
+	case cfg.GetAuth().GetAppRole() != nil:
+		if cfg.GetAuth().GetAppRole().GetRoleId() == "" {
+			verr.AddViolation("auth.appRole.roleId", "has to be defined")
+		}
+		if cfg.GetAuth().GetAppRole().GetSecretId() == nil {
+			verr.AddViolation("auth.appRole.secretId", "has to be defined")
+		} else {
+			verr.AddError("auth.appRole.secretId", datasource.Validate(cfg.GetAuth().GetAppRole().GetSecretId()))
+		}
+	default:
+		verr.AddViolation("auth", "either token or appRole has to be defined")
+	}
+
+	if !verr.HasViolations() {
+		if _, err := v.GetRootCert(ctx, mesh, backend); err != nil {
+			verr.AddViolation("", err.Error())
+		}
+	}
+	return verr.OrNil()
+}
+
+func (v *vaultCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
+	return nil // CA is managed by Vault
+}
+
+func (v *vaultCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to VaultCertificateAuthorityConfig")
+	}
+	var secrets []string
+	if cfg.GetTls().GetCaCert().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetTls().GetCaCert().GetSecret())
+	}
+	if cfg.GetAuth().GetToken().GetSecret().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetToken().GetSecret().GetSecret())
+	}
+	if cfg.GetAuth().GetAppRole().GetSecretId().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetAppRole().GetSecretId().GetSecret())
+	}
+	return secrets, nil
+}
+
+func (v *vaultCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	cfg, client, err := v.client(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := client.caCert(ctx, cfg.Pki)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch CA certificate from Vault for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return []ca.Cert{cert}, nil
+}
+
+func (v *vaultCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (ca.KeyPair, error) {
+	cfg, client, err := v.client(ctx, mesh, backend)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+
+	ttl := ca_issuer.DefaultWorkloadCertValidityPeriod
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		ttl, err = core_mesh.ParseDuration(backend.GetDpCert().GetRotation().Expiration)
+		if err != nil {
+			return ca.KeyPair{}, err
+		}
+	}
+
+	uris, err := ca_issuer.WorkloadURIs(mesh, tags)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	var uriSans []string
+	for _, uri := range uris {
+		uriSans = append(uriSans, uri.String())
+	}
+
+	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrap(err, "failed to generate a private key")
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		URIs: uris,
+	}, key)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrap(err, "failed to create a certificate signing request")
+	}
+
+	certChain, err := client.sign(ctx, cfg.Pki, cfg.Role, signRequest{
+		CSR:        string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+		CommonName: cfg.CommonName,
+		URISans:    strings.Join(uriSans, ","),
+		TTL:        fmt.Sprintf("%ds", int64(ttl.Seconds())),
+	})
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to sign a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+
+	keyPEM, err := util_tls.PEMEncodeKey(key)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	return ca.KeyPair{
+		CertPEM: certChain,
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+func (v *vaultCaManager) client(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) (*config.VaultCertificateAuthorityConfig, *vaultClient, error) {
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, nil, errors.Wrap(err, "could not convert backend config to VaultCertificateAuthorityConfig")
+	}
+	tlsCfg := tlsConfig{
+		skipVerify: cfg.GetTls().GetSkipVerify(),
+		serverName: cfg.GetTls().GetServerName(),
+	}
+	if cfg.GetTls().GetCaCert() != nil {
+		caCert, err := v.dataSourceLoader.Load(ctx, mesh, cfg.GetTls().GetCaCert())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not load CA certificate of Vault")
+		}
+		tlsCfg.caCert = caCert
+	}
+
+	key := mesh + "|" + backend.Name
+	v.clientsMux.Lock()
+	defer v.clientsMux.Unlock()
+	if cached, ok := v.clients[key]; ok {
+		if proto.Equal(cached.cfg, cfg) && bytes.Equal(cached.caCert, tlsCfg.caCert) {
+			return cached.cfg, cached.client, nil
+		}
+		cached.client.close()
+		delete(v.clients, key)
+	}
+
+	client, err := newVaultClient(cfg.Address, cfg.Namespace, tlsCfg, v.tokenFn(mesh, cfg))
+	if err != nil {
+		return nil, nil, err
+	}
+	v.clients[key] = &cachedClient{
+		cfg:    cfg,
+		caCert: tlsCfg.caCert,
+		client: client,
+	}
+	return cfg, client, nil
+}
+
+func (v *vaultCaManager) tokenFn(mesh string, cfg *config.VaultCertificateAuthorityConfig) tokenFn {
+	return func(ctx context.Context, client *vaultClient) (string, error) {
+		if token := cfg.GetAuth().GetToken(); token != nil {
+			token, err := v.dataSourceLoader.Load(ctx, mesh, token.GetSecret())
+			if err != nil {
+				return "", errors.Wrap(err, "could not load Vault token")
+			}
+			return strings.TrimSpace(string(token)), nil
+		}
+
+		appRole := cfg.GetAuth().GetAppRole()
+		secretID, err := v.dataSourceLoader.Load(ctx, mesh, appRole.GetSecretId())
+		if err != nil {
+			return "", errors.Wrap(err, "could not load Secret ID of AppRole")
+		}
+		// Secret ID is a part of the key, so a token is not reused after Secret ID has changed
+		key := strings.Join([]string{cfg.Address, cfg.Namespace, appRole.MountPath, appRole.RoleId, string(secretID)}, "|")
+
+		if token, ok := v.cachedToken(key); ok {
+			return token, nil
+		}
+		token, err, _ := v.logins.Do(key, func() (interface{}, error) {
+			// the token might have been renewed by a login that finished after the check above
+			if token, ok := v.cachedToken(key); ok {
+				return token, nil
+			}
+			token, ttl, err := client.appRoleLogin(ctx, appRole.MountPath, appRole.RoleId, strings.TrimSpace(string(secretID)))
+			if err != nil {
+				return "", err
+			}
+			v.Lock()
+			v.tokens[key] = cachedToken{
+				token:     token,
+				expiresAt: core.Now().Add(ttl - tokenRenewalMargin(ttl)),
+			}
+			v.Unlock()
+			return token, nil
+		})
+		if err != nil {
+			return "", err
+		}
+		return token.(string), nil
+	}
+}
+
+func (v *vaultCaManager) cachedToken(key string) (string, bool) {
+	v.Lock()
+	defer v.Unlock()
+	cached, ok := v.tokens[key]
+	if !ok || !core.Now().Before(cached.expiresAt) {
+		return "", false
+	}
+	return cached.token, true
+}
@@ -0,0 +1,462 @@
+package vault_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	"github.com/kumahq/kuma/pkg/plugins/ca/vault"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// fakeVault implements the subset of the PKI secrets engine and AppRole auth method that is used by the CA.
+type fakeVault struct {
+	sync.Mutex
+	caKey    *rsa.PrivateKey
+	caCert   *x509.Certificate
+	caPEM    string
+	logins   int
+	lastSign map[string]string
+	// leaseDuration of tokens returned by AppRole login in seconds
+	leaseDuration int
+	// loginDelay slows down AppRole login, so concurrent logins overlap
+	loginDelay time.Duration
+}
+
+func newFakeVault() *fakeVault {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Vault CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return &fakeVault{
+		caKey:         key,
+		caCert:        cert,
+		caPEM:         string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		leaseDuration: 3600,
+	}
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	body := map[string]string{}
+	if req.Body != nil {
+		_ = json.NewDecoder(req.Body).Decode(&body)
+	}
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/auth/approle/login":
+		if body["role_id"] != "kuma-role" || body["secret_id"] != "kuma-secret" {
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{"invalid role or secret ID"}})
+			return
+		}
+		f.logins++
+		time.Sleep(f.loginDelay)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"auth": map[string]interface{}{"client_token": "approle-token", "lease_duration": f.leaseDuration},
+		})
+	case req.Header.Get("X-Vault-Token") != "root-token" && req.Header.Get("X-Vault-Token") != "approle-token":
+		writeJSON(w, http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+	case req.Method == http.MethodGet && req.URL.Path == "/v1/pki/cert/ca":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{"certificate": f.caPEM},
+		})
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/pki/sign/kuma":
+		f.lastSign = body
+		cert, err := f.sign(body)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{err.Error()}})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{"certificate": cert, "issuing_ca": f.caPEM},
+		})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+	}
+}
+
+func (f *fakeVault) sign(body map[string]string) (string, error) {
+	block, _ := pem.Decode([]byte(body["csr"]))
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	ttl, err := time.ParseDuration(body["ttl"])
+	if err != nil {
+		return "", err
+	}
+	var uris []*url.URL
+	for _, uri := range strings.Split(body["uri_sans"], ",") {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return "", err
+		}
+		uris = append(uris, u)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: body["common_name"]},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(ttl),
+		URIs:         uris,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, f.caCert, csr.PublicKey, f.caKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+var _ = Describe("Vault CA", func() {
+	var caManager core_ca.Manager
+	var fake *fakeVault
+	var server *httptest.Server
+	var connections int32
+
+	BeforeEach(func() {
+		fake = newFakeVault()
+		server = httptest.NewUnstartedServer(fake)
+		atomic.StoreInt32(&connections, 0)
+		server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				atomic.AddInt32(&connections, 1)
+			}
+		}
+		server.Start()
+		caManager = vault.NewVaultCaManager(datasource.NewDataSourceLoader(nil))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	backend := func(configYAML string) *mesh_proto.CertificateAuthorityBackend {
+		str := structpb.Struct{}
+		Expect(proto.FromYAML([]byte(strings.ReplaceAll(configYAML, "ADDRESS", server.URL)), &str)).To(Succeed())
+		return &mesh_proto.CertificateAuthorityBackend{
+			Name: "vault-1",
+			Type: "vault",
+			Conf: &str,
+			DpCert: &mesh_proto.CertificateAuthorityBackend_DpCert{
+				Rotation: &mesh_proto.CertificateAuthorityBackend_DpCert_Rotation{
+					Expiration: "1h",
+				},
+			},
+		}
+	}
+
+	tokenConfig := `
+      address: ADDRESS
+      pki: pki
+      role: kuma
+      commonName: kuma-dp
+      auth:
+        token:
+          secret:
+            inlineString: root-token`
+
+	appRoleConfig := `
+      address: ADDRESS
+      pki: pki
+      role: kuma
+      auth:
+        appRole:
+          roleId: kuma-role
+          secretId:
+            inlineString: kuma-secret`
+
+	Context("ValidateBackend", func() {
+		type testCase struct {
+			configYAML string
+			expected   string
+		}
+
+		DescribeTable("should validate invalid config",
+			func(given testCase) {
+				// when
+				verr := caManager.ValidateBackend(context.Background(), "default", backend(given.configYAML))
+
+				// then
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty config", testCase{
+				configYAML: ``,
+				expected: `
+                violations:
+                - field: address
+                  message: has to be defined
+                - field: pki
+                  message: has to be defined
+                - field: role
+                  message: has to be defined
+                - field: auth
+                  message: either token or appRole has to be defined`,
+			}),
+			Entry("invalid address and empty AppRole", testCase{
+				configYAML: `
+                address: vault:8200
+                pki: pki
+                role: kuma
+                auth:
+                  appRole: {}`,
+				expected: `
+                violations:
+                - field: address
+                  message: has to be a valid http or https URL
+                - field: auth.appRole.roleId
+                  message: has to be defined
+                - field: auth.appRole.secretId
+                  message: has to be defined`,
+			}),
+			Entry("invalid token", testCase{
+				configYAML: `
+                address: ADDRESS
+                pki: pki
+                role: kuma
+                auth:
+                  token:
+                    secret:
+                      inlineString: invalid-token`,
+				expected: `
+                violations:
+                - field: ""
+                  message: 'failed to fetch CA certificate from Vault for Mesh "default" and backend "vault-1": Vault returned 403: permission denied'`,
+			}),
+		)
+
+		It("should accept a valid config", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend(tokenConfig))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("GetRootCert", func() {
+		It("should return the CA certificate of the PKI mount", func() {
+			// when
+			certs, err := caManager.GetRootCert(context.Background(), "default", backend(tokenConfig))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(certs).To(HaveLen(1))
+			Expect(string(certs[0])).To(Equal(fake.caPEM))
+		})
+	})
+
+	Context("GenerateDataplaneCert", func() {
+		It("should sign a dataplane cert with SPIFFE URI SANs", func() {
+			// given
+			tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"web", "web-api"},
+				"version":         {"v1"},
+			})
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(tokenConfig), tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fake.lastSign["ttl"]).To(Equal("3600s"))
+			Expect(fake.lastSign["common_name"]).To(Equal("kuma-dp"))
+
+			// and
+			block, rest := pem.Decode(pair.CertPEM)
+			Expect(block).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			var uris []string
+			for _, uri := range cert.URIs {
+				uris = append(uris, uri.String())
+			}
+			Expect(uris).To(Equal([]string{
+				"spiffe://default/web",
+				"spiffe://default/web-api",
+				"kuma://kuma.io/service/web",
+				"kuma://kuma.io/service/web-api",
+				"kuma://version/v1",
+			}))
+
+			// and the chain contains the issuing CA
+			Expect(strings.TrimSpace(string(rest))).To(Equal(strings.TrimSpace(fake.caPEM)))
+
+			// and the key matches the cert
+			keyBlock, _ := pem.Decode(pair.KeyPEM)
+			Expect(keyBlock).ToNot(BeNil())
+			key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.PublicKey).To(Equal(&key.PublicKey))
+		})
+
+		It("should authenticate with AppRole and reuse the token", func() {
+			// given
+			tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"web"},
+			})
+
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(appRoleConfig), tags)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", backend(appRoleConfig), tags)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(fake.logins).To(Equal(1))
+		})
+
+		It("should reuse the token with a short lease", func() {
+			// given
+			fake.Lock()
+			fake.leaseDuration = 6
+			fake.Unlock()
+			tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"web"},
+			})
+
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(appRoleConfig), tags)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", backend(appRoleConfig), tags)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(fake.logins).To(Equal(1))
+		})
+
+		It("should log in once when tokens are requested concurrently", func() {
+			// given
+			fake.Lock()
+			fake.loginDelay = 100 * time.Millisecond
+			fake.Unlock()
+			tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"web"},
+			})
+
+			// when
+			wg := sync.WaitGroup{}
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(appRoleConfig), tags)
+					Expect(err).ToNot(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			// then
+			Expect(fake.logins).To(Equal(1))
+		})
+
+		It("should reuse connections to Vault until the config changes", func() {
+			// given
+			tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"web"},
+			})
+
+			// when
+			for i := 0; i < 3; i++ {
+				_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(tokenConfig), tags)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// then
+			Expect(atomic.LoadInt32(&connections)).To(Equal(int32(1)))
+
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(tokenConfig+"\n      namespace: team-a"), tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(atomic.LoadInt32(&connections)).To(Equal(int32(2)))
+		})
+	})
+
+	Context("UsedSecrets", func() {
+		It("should return secrets of token auth and TLS", func() {
+			// given
+			cfg := `
+            address: https://vault:8200
+            pki: pki
+            role: kuma
+            tls:
+              caCert:
+                secret: vault-ca
+            auth:
+              token:
+                secret:
+                  secret: vault-token`
+
+			// when
+			secrets, err := caManager.UsedSecrets("default", backend(cfg))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(ConsistOf("vault-ca", "vault-token"))
+		})
+
+		It("should return secret of AppRole", func() {
+			// given
+			cfg := `
+            address: https://vault:8200
+            pki: pki
+            role: kuma
+            auth:
+              appRole:
+                roleId: kuma-role
+                secretId:
+                  secret: vault-secret-id`
+
+			// when
+			secrets, err := caManager.UsedSecrets("default", backend(cfg))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(ConsistOf("vault-secret-id"))
+		})
+	})
+})
@@ -1216,6 +1216,101 @@ func init() {
 	})
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type SLO struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma SLO resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type SLOList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SLO `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SLO{}, &SLOList{})
+}
+
+func (cb *SLO) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *SLO) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *SLO) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *SLO) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *SLO) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := mesh_proto.SLO{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *SLO) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.SLO); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *SLO) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *SLOList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.SLO{}, &SLO{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "SLO",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.SLO{}, &SLOList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "SLOList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 type ServiceInsight struct {
@@ -17,6 +17,196 @@ import (
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type AccessRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma AccessRole resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type AccessRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessRole{}, &AccessRoleList{})
+}
+
+func (cb *AccessRole) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *AccessRole) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *AccessRole) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *AccessRole) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *AccessRole) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := system_proto.AccessRole{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *AccessRole) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*system_proto.AccessRole); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *AccessRole) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *AccessRoleList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&system_proto.AccessRole{}, &AccessRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AccessRole",
+		},
+	})
+	registry.RegisterListType(&system_proto.AccessRole{}, &AccessRoleList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AccessRoleList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type AccessRoleBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma AccessRoleBinding resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type AccessRoleBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessRoleBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessRoleBinding{}, &AccessRoleBindingList{})
+}
+
+func (cb *AccessRoleBinding) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *AccessRoleBinding) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *AccessRoleBinding) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *AccessRoleBinding) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *AccessRoleBinding) GetSpec() proto.Message {
+	spec := cb.Spec
+	m := system_proto.AccessRoleBinding{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m
+	}
+
+	return util_proto.MustUnmarshalJSON(spec.Raw, &m)
+}
+
+func (cb *AccessRoleBinding) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*system_proto.AccessRoleBinding); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *AccessRoleBinding) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *AccessRoleBindingList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&system_proto.AccessRoleBinding{}, &AccessRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AccessRoleBinding",
+		},
+	})
+	registry.RegisterListType(&system_proto.AccessRoleBinding{}, &AccessRoleBindingList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AccessRoleBindingList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 type Zone struct {
@@ -27,6 +27,16 @@ type postgresResourceStore struct {
 }
 
 var _ store.ResourceStore = &postgresResourceStore{}
+var _ store.Transactions = &postgresResourceStore{}
+
+// querier is implemented by both *sql.DB and *sql.Tx
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type txCtxKey struct{}
 
 func NewStore(metrics core_metrics.Metrics, config config.PostgresStoreConfig) (store.ResourceStore, error) {
 	db, err := common_postgres.ConnectToDb(config)
@@ -43,7 +53,7 @@ func NewStore(metrics core_metrics.Metrics, config config.PostgresStoreConfig) (
 	}, nil
 }
 
-func (r *postgresResourceStore) Create(_ context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+func (r *postgresResourceStore) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 
 	bytes, err := proto.ToJSON(resource.GetSpec())
@@ -64,7 +74,7 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 
 	version := 0
 	statement := `INSERT INTO resources VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
-	_, err = r.db.Exec(statement, opts.Name, opts.Mesh, resource.Descriptor().Name, version, string(bytes),
+	_, err = r.querier(ctx).Exec(statement, opts.Name, opts.Mesh, resource.Descriptor().Name, version, string(bytes),
 		opts.CreationTime.UTC(), opts.CreationTime.UTC(), ownerName, ownerMesh, ownerType)
 	if err != nil {
 		if strings.Contains(err.Error(), duplicateKeyErrorMsg) {
@@ -83,7 +93,7 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 	return nil
 }
 
-func (r *postgresResourceStore) Update(_ context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+func (r *postgresResourceStore) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
 	bytes, err := proto.ToJSON(resource.GetSpec())
 	if err != nil {
 		return err
@@ -97,7 +107,7 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 		return errors.Wrap(err, "failed to convert meta version to int")
 	}
 	statement := `UPDATE resources SET spec=$1, version=$2, modification_time=$3 WHERE name=$4 AND mesh=$5 AND type=$6 AND version=$7;`
-	result, err := r.db.Exec(
+	result, err := r.querier(ctx).Exec(
 		statement,
 		string(bytes),
 		newVersion,
@@ -125,11 +135,11 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 	return nil
 }
 
-func (r *postgresResourceStore) Delete(_ context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+func (r *postgresResourceStore) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
 	opts := store.NewDeleteOptions(fs...)
 
 	statement := `DELETE FROM resources WHERE name=$1 AND type=$2 AND mesh=$3`
-	result, err := r.db.Exec(statement, opts.Name, resource.Descriptor().Name, opts.Mesh)
+	result, err := r.querier(ctx).Exec(statement, opts.Name, resource.Descriptor().Name, opts.Mesh)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query: %s", statement)
 	}
@@ -140,11 +150,11 @@ func (r *postgresResourceStore) Delete(_ context.Context, resource model.Resourc
 	return nil
 }
 
-func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+func (r *postgresResourceStore) Get(ctx context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
 	opts := store.NewGetOptions(fs...)
 
 	statement := `SELECT spec, version, creation_time, modification_time FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
-	row := r.db.QueryRow(statement, opts.Name, opts.Mesh, resource.Descriptor().Name)
+	row := r.querier(ctx).QueryRow(statement, opts.Name, opts.Mesh, resource.Descriptor().Name)
 
 	var spec string
 	var version int
@@ -176,7 +186,7 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 	return nil
 }
 
-func (r *postgresResourceStore) List(_ context.Context, resources model.ResourceList, args ...store.ListOptionsFunc) error {
+func (r *postgresResourceStore) List(ctx context.Context, resources model.ResourceList, args ...store.ListOptionsFunc) error {
 	opts := store.NewListOptions(args...)
 
 	statement := `SELECT name, mesh, spec, version, creation_time, modification_time FROM resources WHERE type=$1`
@@ -190,7 +200,7 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 	}
 	statement += " ORDER BY name, mesh"
 
-	rows, err := r.db.Query(statement, statementArgs...)
+	rows, err := r.querier(ctx).Query(statement, statementArgs...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query: %s", statement)
 	}
@@ -237,6 +247,36 @@ func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, er
 	return item, nil
 }
 
+// Transaction executes fn in a single database transaction.
+// If ctx is already bound to a transaction, fn joins it.
+func (r *postgresResourceStore) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txCtxKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	if err := fn(context.WithValue(ctx, txCtxKey{}, tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Wrapf(err, "failed to rollback transaction: %v", rollbackErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// querier returns the transaction bound to ctx or the database if there is none.
+func (r *postgresResourceStore) querier(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txCtxKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return r.db
+}
+
 func (r *postgresResourceStore) Close() error {
 	return r.db.Close()
 }
@@ -261,7 +261,7 @@ func newFilterChain(ctx xds_context.MeshContext, info GatewayListenerInfo) *envo
 		// is a no-op unless we later add a per-route configuration.
 		envoy_listeners.RateLimit([]*core_mesh.RateLimitResource{nil}),
 		envoy_listeners.DefaultCompressorFilter(),
-		envoy_listeners.Tracing(ctx.GetTracingBackend(info.Proxy.Policies.TrafficTrace), service),
+		envoy_listeners.Tracing(ctx.GetTracingBackend(info.Proxy.Policies.TrafficTrace), service, info.Proxy.Dataplane.Spec.TagSet(), info.Proxy.Metadata.GetVersion().GetEnvoy()),
 		// In mesh proxies, the access log is configured on the outbound
 		// listener, which is why we index the Logs slice by destination
 		// service name.  A Gateway listener by definition forwards traffic
@@ -275,6 +275,7 @@ func newFilterChain(ctx xds_context.MeshContext, info GatewayListenerInfo) *envo
 			service,                // Source service is the gateway service.
 			mesh_proto.MatchAllTag, // Destination service could be anywhere, depending on the routes.
 			ctx.GetLoggingBackend(info.Proxy.Policies.TrafficLogs[core_mesh.PassThroughService]),
+			info.Proxy.Policies.TrafficLogs[core_mesh.PassThroughService].GetFilter(),
 			info.Proxy,
 		),
 	)
@@ -80,6 +80,7 @@ func NewProxyProfile(zone string) generator.ResourceGenerator {
 		generator.PrometheusEndpointGenerator{},
 		generator.SecretsProxyGenerator{},
 		generator.TracingProxyGenerator{},
+		generator.LoggingProxyGenerator{},
 		generator.TransparentProxyGenerator{},
 		generator.DNSGenerator{},
 
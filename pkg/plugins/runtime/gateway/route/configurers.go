@@ -27,6 +27,18 @@ func regexOf(regex string) *envoy_type_matcher.RegexMatcher {
 	}
 }
 
+// RouteName sets the name of the route. Named routes get virtual clusters,
+// so Envoy emits request stats per route.
+func RouteName(name string) RouteConfigurer {
+	if name == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Name = name
+	})
+}
+
 // RouteMatchExactPath updates the route to match the exact path. This
 // replaces any previous path match specification.
 func RouteMatchExactPath(path string) RouteConfigurer {
@@ -9,6 +9,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/match"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
 	v3 "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
 )
@@ -43,10 +44,20 @@ func GenerateVirtualHost(
 	// Sort routing table entries so the most specific match comes first.
 	sort.Sort(route.Sorter(routes))
 
+	// number of routes generated so far for every MeshGatewayRoute
+	routeCount := map[string]int{}
+
 	for _, e := range routes {
 		routeBuilder := route.RouteBuilder{}
 
+		var routeName string
+		if e.Route != "" {
+			routeName = envoy_names.GetMeshGatewayRouteName(e.Route, routeCount[e.Route])
+			routeCount[e.Route]++
+		}
+
 		routeBuilder.Configure(
+			route.RouteName(routeName),
 			route.RouteMatchExactPath(e.Match.ExactPath),
 			route.RouteMatchPrefixPath(e.Match.PrefixPath),
 			route.RouteMatchRegexPath(e.Match.RegexPath),
@@ -127,6 +138,8 @@ func GenerateVirtualHost(
 		vh.Configure(route.VirtualHostRoute(&routeBuilder))
 	}
 
+	vh.Configure(envoy_routes.VirtualClusters())
+
 	return vh, nil
 }
 
@@ -112,6 +112,7 @@ func MakeGeneratorContext(rt runtime.Runtime, key core_model.ResourceKey) (*xds_
 	secrets, err := secrets.NewSecrets(
 		secrets.NewCaProvider(rt.CaManagers()),
 		secrets.NewIdentityProvider(rt.CaManagers()),
+		rt.ReadOnlyResourceManager(),
 		rt.Metrics(),
 	)
 	Expect(err).To(Succeed())
@@ -23,7 +23,8 @@ import (
 
 // MeshDefaultsReconciler creates default resources for created Mesh
 type MeshDefaultsReconciler struct {
-	ResourceManager manager.ResourceManager
+	ResourceManager     manager.ResourceManager
+	SigningKeyAlgorithm string
 }
 
 func (r *MeshDefaultsReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request) (kube_ctrl.Result, error) {
@@ -42,7 +43,7 @@ func (r *MeshDefaultsReconciler) Reconcile(ctx context.Context, req kube_ctrl.Re
 		return kube_ctrl.Result{}, nil
 	}
 
-	if err := defaults_mesh.EnsureDefaultMeshResources(ctx, r.ResourceManager, req.Name); err != nil {
+	if err := defaults_mesh.EnsureDefaultMeshResources(ctx, r.ResourceManager, req.Name, r.SigningKeyAlgorithm); err != nil {
 		return kube_ctrl.Result{}, errors.Wrap(err, "could not create default mesh resources")
 	}
 
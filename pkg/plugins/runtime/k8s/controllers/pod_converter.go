@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -180,18 +181,77 @@ func GatewayFor(clusterName string, pod *kube_core.Pod, services []*kube_core.Se
 	}, nil
 }
 
+// aggregateMetricsFor returns application metrics endpoints declared with
+// "prometheus.metrics.kuma.io/aggregate-<name>-(port|path|address|enabled)" annotations.
+func aggregateMetricsFor(pod *kube_core.Pod) ([]*mesh_proto.PrometheusAggregateMetricsConfig, error) {
+	annotations := metadata.Annotations(pod.Annotations)
+	suffixes := []string{
+		metadata.KumaMetricsPrometheusAggregatePort,
+		metadata.KumaMetricsPrometheusAggregatePath,
+		metadata.KumaMetricsPrometheusAggregateAddress,
+		metadata.KumaMetricsPrometheusAggregateEnabled,
+	}
+	var names []string
+	for key := range annotations {
+		if !strings.HasPrefix(key, metadata.KumaMetricsPrometheusAggregatePrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(key, metadata.KumaMetricsPrometheusAggregatePrefix)
+		for _, suffix := range suffixes {
+			if name := strings.TrimSuffix(rest, suffix); name != rest && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	var aggregate []*mesh_proto.PrometheusAggregateMetricsConfig
+	for i, name := range names {
+		if i > 0 && names[i-1] == name {
+			continue
+		}
+		prefix := metadata.KumaMetricsPrometheusAggregatePrefix + name
+		port, _, err := annotations.GetUint32(prefix + metadata.KumaMetricsPrometheusAggregatePort)
+		if err != nil {
+			return nil, err
+		}
+		path, _ := annotations.GetString(prefix + metadata.KumaMetricsPrometheusAggregatePath)
+		address, _ := annotations.GetString(prefix + metadata.KumaMetricsPrometheusAggregateAddress)
+		app := &mesh_proto.PrometheusAggregateMetricsConfig{
+			Name:    name,
+			Address: address,
+			Port:    port,
+			Path:    path,
+		}
+		enabled, exist, err := annotations.GetEnabled(prefix + metadata.KumaMetricsPrometheusAggregateEnabled)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			app.Enabled = util_proto.Bool(enabled)
+		}
+		aggregate = append(aggregate, app)
+	}
+	return aggregate, nil
+}
+
 func MetricsFor(pod *kube_core.Pod) (*mesh_proto.MetricsBackend, error) {
 	path, _ := metadata.Annotations(pod.Annotations).GetString(metadata.KumaMetricsPrometheusPath)
 	port, exist, err := metadata.Annotations(pod.Annotations).GetUint32(metadata.KumaMetricsPrometheusPort)
 	if err != nil {
 		return nil, err
 	}
-	if path == "" && !exist {
+	aggregate, err := aggregateMetricsFor(pod)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" && !exist && len(aggregate) == 0 {
 		return nil, nil
 	}
 	cfg := &mesh_proto.PrometheusMetricsBackendConfig{
-		Path: path,
-		Port: port,
+		Path:      path,
+		Port:      port,
+		Aggregate: aggregate,
 	}
 	str, err := util_proto.ToStruct(cfg)
 	if err != nil {
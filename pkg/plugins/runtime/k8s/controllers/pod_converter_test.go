@@ -190,6 +190,11 @@ var _ = Describe("PodToDataplane(..)", func() {
 			servicesForPod: "07.services-for-pod.yaml",
 			dataplane:      "07.dataplane.yaml",
 		}),
+		Entry("18. Pod with application metrics endpoints", testCase{
+			pod:            "18.pod.yaml",
+			servicesForPod: "18.services-for-pod.yaml",
+			dataplane:      "18.dataplane.yaml",
+		}),
 		Entry("08. Pod with transparent proxy enabled, without direct access servies", testCase{
 			pod:            "08.pod.yaml",
 			servicesForPod: "08.services-for-pod.yaml",
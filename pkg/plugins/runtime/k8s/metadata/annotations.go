@@ -69,6 +69,20 @@ const (
 	// KumaMetricsPrometheusPath to override `Mesh`-wide default path
 	KumaMetricsPrometheusPath = "prometheus.metrics.kuma.io/path"
 
+	// KumaMetricsPrometheusAggregatePrefix is a prefix of annotations that declare metrics endpoints
+	// of applications in the Pod, which are exposed together with Envoy metrics.
+	// Example: "prometheus.metrics.kuma.io/aggregate-app-port: 8080"
+	KumaMetricsPrometheusAggregatePrefix = "prometheus.metrics.kuma.io/aggregate-"
+	// KumaMetricsPrometheusAggregatePort is a suffix of an annotation with the port of an application metrics endpoint
+	KumaMetricsPrometheusAggregatePort = "-port"
+	// KumaMetricsPrometheusAggregatePath is a suffix of an annotation with the path of an application metrics endpoint
+	KumaMetricsPrometheusAggregatePath = "-path"
+	// KumaMetricsPrometheusAggregateAddress is a suffix of an annotation with the address of an application metrics endpoint
+	KumaMetricsPrometheusAggregateAddress = "-address"
+	// KumaMetricsPrometheusAggregateEnabled is a suffix of an annotation that disables an application metrics endpoint
+	// defined `Mesh`-wide
+	KumaMetricsPrometheusAggregateEnabled = "-enabled"
+
 	// KumaBuiltinDNS the sidecar will use its builtin DNS
 	KumaBuiltinDNS     = "kuma.io/builtindns"
 	KumaBuiltinDNSPort = "kuma.io/builtindnsport"
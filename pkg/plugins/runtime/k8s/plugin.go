@@ -136,7 +136,8 @@ func addMeshReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter
 		return errors.Wrap(err, "could not setup mesh reconciller")
 	}
 	defaultsReconciller := &k8s_controllers.MeshDefaultsReconciler{
-		ResourceManager: rt.ResourceManager(),
+		ResourceManager:     rt.ResourceManager(),
+		SigningKeyAlgorithm: rt.Config().Defaults.SigningKeyAlgorithm,
 	}
 	if err := defaultsReconciller.SetupWithManager(mgr); err != nil {
 		return errors.Wrap(err, "could not setup mesh defaults reconciller")
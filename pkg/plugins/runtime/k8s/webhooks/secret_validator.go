@@ -119,6 +119,15 @@ func (v *SecretValidator) validateMeshSecret(ctx context.Context, verr *validato
 		}
 	}
 	v.validateSecretData(verr, secret)
+	if validator, ok := v.Validator.(secret_manager.UpsertValidator); ok {
+		if err := validator.ValidateUpsert(ctx, secret.Name, meshOfSecret(secret), secret.Data["value"]); err != nil {
+			if dataErr, ok := err.(*validators.ValidationError); ok {
+				verr.Add(*dataErr)
+			} else {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,138 @@
+package slo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoData is returned by Querier when the query does not return any series.
+var ErrNoData = errors.New("query returned no data")
+
+// Sample is a single series of an instant vector.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Querier evaluates instant PromQL queries.
+type Querier interface {
+	// Query evaluates a query that returns a single value.
+	Query(ctx context.Context, query string) (float64, error)
+	// QueryVector evaluates a query that returns any number of series.
+	QueryVector(ctx context.Context, query string) ([]Sample, error)
+}
+
+// NewPrometheusQuerier returns Querier that uses the HTTP API of Prometheus
+// or any other compatible server (Thanos, Cortex, VictoriaMetrics etc.).
+func NewPrometheusQuerier(endpoint string, timeout time.Duration) (Querier, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid query endpoint %q", endpoint)
+	}
+	u.Path = path.Join(u.Path, "/api/v1/query")
+	return &prometheusQuerier{
+		url: u,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}, nil
+}
+
+type prometheusQuerier struct {
+	url    *url.URL
+	client *http.Client
+}
+
+var _ Querier = &prometheusQuerier{}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (p *prometheusQuerier) Query(ctx context.Context, query string) (float64, error) {
+	samples, err := p.QueryVector(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	switch len(samples) {
+	case 0:
+		return 0, ErrNoData
+	case 1:
+	default:
+		return 0, errors.Errorf("expected a single series, got %d", len(samples))
+	}
+	return samples[0].Value, nil
+}
+
+func (p *prometheusQuerier) QueryVector(ctx context.Context, query string) ([]Sample, error) {
+	u := *p.url
+	u.RawQuery = url.Values{"query": []string{query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not query metrics")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the response")
+	}
+
+	res := queryResponse{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, errors.Errorf("could not parse the response (%d): %s", resp.StatusCode, string(body))
+	}
+	if res.Status != "success" {
+		return nil, errors.Errorf("query failed (%d): %s", resp.StatusCode, res.Error)
+	}
+	if res.Data.ResultType != "vector" {
+		return nil, errors.Errorf("expected vector result, got %q", res.Data.ResultType)
+	}
+	var samples []Sample
+	for _, result := range res.Data.Result {
+		value, err := parseSampleValue(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, Sample{
+			Labels: result.Metric,
+			Value:  value,
+		})
+	}
+	return samples, nil
+}
+
+// parseSampleValue parses [<unix time>, "<value>"] pair of the sample.
+func parseSampleValue(value []interface{}) (float64, error) {
+	if len(value) != 2 {
+		return 0, errors.Errorf("invalid sample %v", value)
+	}
+	str, ok := value[1].(string)
+	if !ok {
+		return 0, errors.Errorf("invalid sample value %v", value[1])
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid sample value %q", str)
+	}
+	return f, nil
+}
@@ -0,0 +1,225 @@
+package slo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+const (
+	ErrorRatioRecord           = "kuma:slo_errors_per_request:ratio_rate"
+	ErrorBudgetRemainingRecord = "kuma:slo_error_budget:remaining"
+
+	FastBurnAlert = "KumaSLOFastBurn"
+	SlowBurnAlert = "KumaSLOSlowBurn"
+)
+
+// RuleFile is a Prometheus rule file, see
+// https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/
+type RuleFile struct {
+	Groups []RuleGroup `json:"groups"`
+}
+
+type RuleGroup struct {
+	Name  string `json:"name"`
+	Rules []Rule `json:"rules"`
+}
+
+type Rule struct {
+	Record      string            `json:"record,omitempty"`
+	Alert       string            `json:"alert,omitempty"`
+	Expr        string            `json:"expr"`
+	For         string            `json:"for,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// burnRateWindows is a multi-window, multi-burn-rate alert as described in
+// https://sre.google/workbook/alerting-on-slos/
+// It fires when budgetConsumed of the error budget is spent within the long
+// window, the short window makes the alert reset quickly once it's fixed.
+type burnRateWindows struct {
+	budgetConsumed float64
+	long           time.Duration
+	short          time.Duration
+}
+
+func (w burnRateWindows) burnRate(window time.Duration) float64 {
+	return w.budgetConsumed * float64(window) / float64(w.long)
+}
+
+var fastBurnWindows = []burnRateWindows{
+	{budgetConsumed: 0.02, long: time.Hour, short: 5 * time.Minute},
+	{budgetConsumed: 0.05, long: 6 * time.Hour, short: 30 * time.Minute},
+}
+
+var slowBurnWindows = []burnRateWindows{
+	{budgetConsumed: 0.10, long: 24 * time.Hour, short: 2 * time.Hour},
+	{budgetConsumed: 0.10, long: 72 * time.Hour, short: 6 * time.Hour},
+}
+
+// GenerateRules generates a group of recording and alerting rules for every SLO.
+// Recording rules compute the error ratio over the windows used by alerts
+// and the remaining error budget.
+func GenerateRules(slos []*core_mesh.SLOResource) *RuleFile {
+	file := &RuleFile{
+		Groups: []RuleGroup{},
+	}
+	for _, slo := range slos {
+		file.Groups = append(file.Groups, RuleGroup{
+			Name:  fmt.Sprintf("kuma-slo-%s-%s", slo.GetMeta().GetMesh(), slo.GetMeta().GetName()),
+			Rules: rules(slo),
+		})
+	}
+	return file
+}
+
+func rules(slo *core_mesh.SLOResource) []Rule {
+	window := slo.Spec.GetConf().GetWindow().AsDuration()
+	fastBurn := alertWindows(fastBurnWindows, window)
+	slowBurn := alertWindows(slowBurnWindows, window)
+
+	var rules []Rule
+	seen := map[time.Duration]bool{}
+	for _, w := range append(append([]burnRateWindows{}, fastBurn...), slowBurn...) {
+		for _, d := range []time.Duration{w.short, w.long} {
+			if seen[d] {
+				continue
+			}
+			seen[d] = true
+			rules = append(rules, errorRatioRule(slo, d))
+		}
+	}
+	if !seen[window] {
+		rules = append(rules, errorRatioRule(slo, window))
+	}
+
+	rules = append(rules, Rule{
+		Record: ErrorBudgetRemainingRecord,
+		Expr:   fmt.Sprintf("1 - %s%s / %s", ErrorRatioRecord+promDuration(window), recordSelector(slo), formatFloat(slo.ErrorBudget())),
+		Labels: labels(slo),
+	})
+
+	if len(fastBurn) > 0 {
+		rules = append(rules, burnRateAlert(slo, FastBurnAlert, "critical", "2m", "is burning the error budget of SLO %s too fast", fastBurn))
+	}
+	if len(slowBurn) > 0 {
+		rules = append(rules, burnRateAlert(slo, SlowBurnAlert, "warning", "15m", "is steadily burning the error budget of SLO %s", slowBurn))
+	}
+	return rules
+}
+
+// alertWindows skips alert windows which burn rate is lower than 1. Such an
+// error rate would not spend the whole error budget before the window ends.
+func alertWindows(windows []burnRateWindows, window time.Duration) []burnRateWindows {
+	var result []burnRateWindows
+	for _, w := range windows {
+		if w.burnRate(window) >= 1-1e-9 {
+			result = append(result, w)
+		}
+	}
+	return result
+}
+
+func errorRatioRule(slo *core_mesh.SLOResource, window time.Duration) Rule {
+	return Rule{
+		Record: ErrorRatioRecord + promDuration(window),
+		Expr:   ErrorRatioQuery(slo, window),
+		Labels: labels(slo),
+	}
+}
+
+func burnRateAlert(slo *core_mesh.SLOResource, name, severity, forDuration, summary string, windows []burnRateWindows) Rule {
+	window := slo.Spec.GetConf().GetWindow().AsDuration()
+	selector := recordSelector(slo)
+	expr := ""
+	for i, w := range windows {
+		if i > 0 {
+			expr += " or "
+		}
+		threshold := fmt.Sprintf("(%s * %s)", formatFloat(w.burnRate(window)), formatFloat(slo.ErrorBudget()))
+		expr += fmt.Sprintf("(%s%s%s > %s and %s%s%s > %s)",
+			ErrorRatioRecord, promDuration(w.long), selector, threshold,
+			ErrorRatioRecord, promDuration(w.short), selector, threshold,
+		)
+	}
+	alertLabels := labels(slo)
+	alertLabels["severity"] = severity
+	return Rule{
+		Alert:  name,
+		Expr:   expr,
+		For:    forDuration,
+		Labels: alertLabels,
+		Annotations: map[string]string{
+			"summary": fmt.Sprintf("Service %s in mesh %s ", slo.Spec.GetService(), slo.GetMeta().GetMesh()) +
+				fmt.Sprintf(summary, slo.GetMeta().GetName()),
+		},
+	}
+}
+
+// ErrorBudgetRemainingQuery returns a PromQL query of the remaining error budget
+// of the SLO recorded by the rules.
+func ErrorBudgetRemainingQuery(slo *core_mesh.SLOResource) string {
+	return ErrorBudgetRemainingRecord + recordSelector(slo)
+}
+
+// ErrorRatioQuery returns a PromQL query of the ratio of bad requests of
+// the SLO in the window. It is built from the metrics of inbound clusters
+// of the service's data plane proxies.
+func ErrorRatioQuery(slo *core_mesh.SLOResource, window time.Duration) string {
+	selector := inboundSelector(slo)
+	rangeSelector := "[" + promDuration(window) + "]"
+	if threshold := slo.Spec.GetConf().GetLatency().GetThreshold(); threshold != nil {
+		le := formatFloat(float64(threshold.AsDuration()) / float64(time.Millisecond))
+		return fmt.Sprintf("1 - (sum(rate(envoy_cluster_upstream_rq_time_bucket{%s,le=%q}%s)) / sum(rate(envoy_cluster_upstream_rq_time_count{%s}%s)))",
+			selector, le, rangeSelector, selector, rangeSelector)
+	}
+	return fmt.Sprintf(`sum(rate(envoy_cluster_upstream_rq_xx{%s,envoy_response_code_class="5"}%s)) / sum(rate(envoy_cluster_upstream_rq_total{%s}%s))`,
+		selector, rangeSelector, selector, rangeSelector)
+}
+
+func inboundSelector(slo *core_mesh.SLOResource) string {
+	// kuma_io_services is a multi-value label, e.g. ",backend,backend-admin,"
+	services := ".*," + regexp.QuoteMeta(slo.Spec.GetService()) + ",.*"
+	return fmt.Sprintf(`mesh=%s,kuma_io_services=~%s,envoy_cluster_name=~"localhost_.*"`,
+		strconv.Quote(slo.GetMeta().GetMesh()), strconv.Quote(services))
+}
+
+func recordSelector(slo *core_mesh.SLOResource) string {
+	return fmt.Sprintf("{mesh=%s,slo=%s}", strconv.Quote(slo.GetMeta().GetMesh()), strconv.Quote(slo.GetMeta().GetName()))
+}
+
+func labels(slo *core_mesh.SLOResource) map[string]string {
+	return map[string]string{
+		"mesh":            slo.GetMeta().GetMesh(),
+		"slo":             slo.GetMeta().GetName(),
+		"kuma_io_service": slo.Spec.GetService(),
+	}
+}
+
+// promDuration formats the duration in the largest Prometheus unit that
+// represents it exactly, e.g. 30d, 6h or 5m.
+func promDuration(d time.Duration) string {
+	units := []struct {
+		suffix string
+		unit   time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	}
+	for _, u := range units {
+		if d%u.unit == 0 {
+			return fmt.Sprintf("%d%s", d/u.unit, u.suffix)
+		}
+	}
+	return fmt.Sprintf("%dms", d/time.Millisecond)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', 10, 64)
+}
@@ -0,0 +1,74 @@
+package slo_test
+
+import (
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/slo"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+func sloResource(mesh, name, spec string) *core_mesh.SLOResource {
+	res := core_mesh.NewSLOResource()
+	res.SetMeta(&test_model.ResourceMeta{Mesh: mesh, Name: name})
+	Expect(util_proto.FromYAML([]byte(spec), res.Spec)).To(Succeed())
+	return res
+}
+
+var _ = Describe("GenerateRules()", func() {
+
+	type testCase struct {
+		slos   []*core_mesh.SLOResource
+		golden string
+	}
+
+	DescribeTable("should generate Prometheus rules",
+		func(given testCase) {
+			// when
+			rules := slo.GenerateRules(given.slos)
+
+			// then
+			actual, err := yaml.Marshal(rules)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(matchers.MatchGoldenYAML(filepath.Join("testdata", given.golden)))
+		},
+		Entry("availability and latency SLOs", testCase{
+			slos: []*core_mesh.SLOResource{
+				sloResource("default", "backend-availability", `
+                service: backend
+                conf:
+                  target: 99.9
+                  window: 720h
+                  availability: {}`),
+				sloResource("default", "web-latency", `
+                service: web.kuma-demo
+                conf:
+                  target: 99
+                  window: 168h
+                  latency:
+                    threshold: 2.5s`),
+			},
+			golden: "rules.golden.yaml",
+		}),
+		Entry("SLO with window shorter than alert windows", testCase{
+			slos: []*core_mesh.SLOResource{
+				sloResource("demo", "backend-daily", `
+                service: backend
+                conf:
+                  target: 95
+                  window: 12h
+                  availability: {}`),
+			},
+			golden: "rules.short-window.golden.yaml",
+		}),
+		Entry("no SLOs", testCase{
+			golden: "rules.empty.golden.yaml",
+		}),
+	)
+})
@@ -0,0 +1,11 @@
+package slo_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestSLO(t *testing.T) {
+	test.RunSpecs(t, "SLO Suite")
+}
@@ -0,0 +1,29 @@
+package slo
+
+import (
+	"context"
+	"math"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// Status queries the remaining error budget of the SLO recorded by the rules
+// generated by GenerateRules and computes the error ratio in its window from it.
+// It returns ErrNoData when the rules were not evaluated yet or there are
+// no metrics of the service.
+func Status(ctx context.Context, querier Querier, slo *core_mesh.SLOResource) (*mesh_proto.ServiceInsight_Service_SLOStatus, error) {
+	remaining, err := querier.Query(ctx, ErrorBudgetRemainingQuery(slo))
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(remaining) {
+		// there were no requests in the window so no budget was spent
+		remaining = 1
+	}
+	return &mesh_proto.ServiceInsight_Service_SLOStatus{
+		Target:               slo.Spec.GetConf().GetTarget(),
+		ErrorRatio:           (1 - remaining) * slo.ErrorBudget(),
+		ErrorBudgetRemaining: remaining,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package slo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/slo"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("Status()", func() {
+
+	var queries chan string
+	var response string
+	var querier slo.Querier
+
+	BeforeEach(func() {
+		queries = make(chan string, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/prometheus/api/v1/query"))
+			queries <- req.URL.Query().Get("query")
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		querier, err = slo.NewPrometheusQuerier(server.URL+"/prometheus", time.Second)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should compute remaining error budget", func() {
+		// given
+		response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1435781451.781,"0.6"]}]}}`
+		backend := sloResource("default", "backend-availability", `
+                service: backend
+                conf:
+                  target: 99.9
+                  window: 720h
+                  availability: {}`)
+
+		// when
+		status, err := slo.Status(context.Background(), querier, backend)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(<-queries).To(Equal(`kuma:slo_error_budget:remaining{mesh="default",slo="backend-availability"}`))
+		Expect(status.Target).To(Equal(99.9))
+		Expect(status.ErrorRatio).To(BeNumerically("~", 0.0004, 1e-9))
+		Expect(status.ErrorBudgetRemaining).To(Equal(0.6))
+	})
+
+	It("should treat no requests in the window as untouched budget", func() {
+		// given
+		response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1435781451.781,"NaN"]}]}}`
+		backend := sloResource("default", "backend-latency", `
+                service: backend
+                conf:
+                  target: 99
+                  window: 24h
+                  latency:
+                    threshold: 100ms`)
+
+		// when
+		status, err := slo.Status(context.Background(), querier, backend)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(<-queries).To(Equal(`kuma:slo_error_budget:remaining{mesh="default",slo="backend-latency"}`))
+		Expect(status).To(MatchProto(&mesh_proto.ServiceInsight_Service_SLOStatus{
+			Target:               99,
+			ErrorRatio:           0,
+			ErrorBudgetRemaining: 1,
+		}))
+	})
+
+	It("should return ErrNoData when the remaining error budget is not recorded", func() {
+		// given
+		response = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+
+		// when
+		_, err := slo.Status(context.Background(), querier, sloResource("default", "backend", `
+                service: backend
+                conf:
+                  target: 99.9
+                  window: 720h
+                  availability: {}`))
+
+		// then
+		Expect(err).To(Equal(slo.ErrNoData))
+	})
+
+	It("should return an error of the query endpoint", func() {
+		// given
+		response = `{"status":"error","errorType":"bad_data","error":"invalid parameter \"query\""}`
+
+		// when
+		_, err := querier.Query(context.Background(), "up{")
+
+		// then
+		Expect(err).To(MatchError(`query failed (200): invalid parameter "query"`))
+	})
+
+	It("should return all series of a vector query", func() {
+		// given
+		response = `{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{"envoy_cluster_name":"backend"},"value":[1435781451.781,"1.5"]},` +
+			`{"metric":{"envoy_cluster_name":"db"},"value":[1435781451.781,"2"]}]}}`
+
+		// when
+		samples, err := querier.QueryVector(context.Background(), "sum by (envoy_cluster_name) (envoy_cluster_upstream_rq_total)")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(<-queries).To(Equal("sum by (envoy_cluster_name) (envoy_cluster_upstream_rq_total)"))
+		Expect(samples).To(Equal([]slo.Sample{
+			{Labels: map[string]string{"envoy_cluster_name": "backend"}, Value: 1.5},
+			{Labels: map[string]string{"envoy_cluster_name": "db"}, Value: 2},
+		}))
+	})
+})
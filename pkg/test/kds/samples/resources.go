@@ -367,4 +367,14 @@ var (
 			},
 		},
 	}
+	SLO = &mesh_proto.SLO{
+		Service: "backend",
+		Conf: &mesh_proto.SLO_Conf{
+			Target: 99.9,
+			Window: util_proto.Duration(720 * time.Hour),
+			Objective: &mesh_proto.SLO_Conf_Availability_{
+				Availability: &mesh_proto.SLO_Conf_Availability{},
+			},
+		},
+	}
 )
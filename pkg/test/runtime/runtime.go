@@ -8,6 +8,7 @@ import (
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/dataplane"
@@ -18,9 +19,9 @@ import (
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
-	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/dns/resolver"
@@ -96,6 +97,8 @@ func BuilderFor(appCtx context.Context, cfg kuma_cp.Config) (*core_runtime.Build
 	builder.WithKDSContext(kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name))
 	builder.WithCAProvider(secrets.NewCaProvider(builder.CaManagers()))
 	builder.WithAPIServerAuthenticator(certs.ClientCertAuthenticator)
+	builder.WithAuditLogger(audit.NopLogger())
+	builder.WithTransactions(core_store.NoTransactions{})
 	builder.WithAccess(core_runtime.Access{
 		ResourceAccess:       resources_access.NewAdminResourceAccess(builder.Config().Access.Static.AdminResources),
 		DataplaneTokenAccess: tokens_access.NewStaticGenerateDataplaneTokenAccess(builder.Config().Access.Static.GenerateDPToken),
@@ -120,10 +123,10 @@ func newResourceManager(builder *core_runtime.Builder) core_manager.Customizable
 	defaultManager := core_manager.NewResourceManager(builder.ResourceStore())
 	customManagers := map[core_model.ResourceType]core_manager.ResourceManager{}
 	customizableManager := core_manager.NewCustomizableResourceManager(defaultManager, customManagers)
-	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.ResourceValidators().Mesh)
+	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.ResourceValidators().Mesh, builder.Config().Defaults.SigningKeyAlgorithm)
 	customManagers[core_mesh.MeshType] = meshManager
 
-	secretManager := secret_manager.NewSecretManager(builder.SecretStore(), secret_cipher.None(), nil)
+	secretManager := secret_manager.NewSecretManager(builder.SecretStore(), nil)
 	customManagers[system.SecretType] = secretManager
 	return customizableManager
 }
@@ -147,3 +150,35 @@ func (d *DummyEnvoyAdminClient) PostQuit(dataplane *core_mesh.DataplaneResource)
 func (d *DummyEnvoyAdminClient) ConfigDump(proxy admin.ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
 	return []byte(fmt.Sprintf(`{"envoyAdminAddress": "%s"}`, proxy.AdminAddress(defaultAdminPort))), nil
 }
+
+func (d *DummyEnvoyAdminClient) Stats(proxy admin.ResourceWithAddress, defaultAdminPort uint32, filter string) ([]byte, error) {
+	return []byte(fmt.Sprintf("envoyAdminAddress: %s\nfilter: %s\n", proxy.AdminAddress(defaultAdminPort), filter)), nil
+}
+
+func (d *DummyEnvoyAdminClient) Clusters(proxy admin.ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
+	return []byte(fmt.Sprintf(`{"envoyAdminAddress": "%s", "clusterStatuses": []}`, proxy.AdminAddress(defaultAdminPort))), nil
+}
+
+func (d *DummyEnvoyAdminClient) Listeners(proxy admin.ResourceWithAddress, defaultAdminPort uint32) ([]byte, error) {
+	return []byte(fmt.Sprintf(`{"envoyAdminAddress": "%s", "listenerStatuses": []}`, proxy.AdminAddress(defaultAdminPort))), nil
+}
+
+func (d *DummyEnvoyAdminClient) LogLevels(admin.ResourceWithAddress, uint32) (map[string]string, error) {
+	return map[string]string{
+		"admin": "info",
+		"http":  "info",
+	}, nil
+}
+
+func (d *DummyEnvoyAdminClient) SetLogLevel(_ admin.ResourceWithAddress, _ uint32, logger string, level string) (map[string]string, error) {
+	levels := map[string]string{
+		"admin": "info",
+		"http":  "info",
+	}
+	for l := range levels {
+		if logger == "" || logger == l {
+			levels[l] = level
+		}
+	}
+	return levels, nil
+}
@@ -3,6 +3,7 @@ package tls
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
@@ -33,12 +34,57 @@ var ECDSAKeyType KeyType = func() (crypto.Signer, error) {
 	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 }
 
+var ECDSAP384KeyType KeyType = func() (crypto.Signer, error) {
+	return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+}
+
+var Ed25519KeyType KeyType = func() (crypto.Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	return key, err
+}
+
 var RSAKeyType KeyType = func() (crypto.Signer, error) {
 	return util_rsa.GenerateKey(util_rsa.DefaultKeySize)
 }
 
+func RSAKeyTypeWithBits(bits int) KeyType {
+	return func() (crypto.Signer, error) {
+		return util_rsa.GenerateKey(bits)
+	}
+}
+
 var DefaultKeyType = RSAKeyType
 
+// Names of key algorithms that can be used in the configuration.
+const (
+	RSAKeyAlgorithm       = "RSA"
+	ECDSAP256KeyAlgorithm = "ECDSA-P256"
+	ECDSAP384KeyAlgorithm = "ECDSA-P384"
+	Ed25519KeyAlgorithm   = "Ed25519"
+)
+
+var KeyAlgorithms = []string{RSAKeyAlgorithm, ECDSAP256KeyAlgorithm, ECDSAP384KeyAlgorithm, Ed25519KeyAlgorithm}
+
+// NewKeyType returns KeyType of the algorithm. Empty algorithm means RSA.
+// rsaBits is only used by RSA, 0 means the default key size.
+func NewKeyType(algorithm string, rsaBits int) (KeyType, error) {
+	switch algorithm {
+	case "", RSAKeyAlgorithm:
+		if rsaBits == 0 {
+			return RSAKeyType, nil
+		}
+		return RSAKeyTypeWithBits(rsaBits), nil
+	case ECDSAP256KeyAlgorithm:
+		return ECDSAKeyType, nil
+	case ECDSAP384KeyAlgorithm:
+		return ECDSAP384KeyType, nil
+	case Ed25519KeyAlgorithm:
+		return Ed25519KeyType, nil
+	default:
+		return nil, errors.Errorf("unsupported key algorithm %q. Supported algorithms are %v", algorithm, KeyAlgorithms)
+	}
+}
+
 func NewSelfSignedCert(commonName string, certType CertType, keyType KeyType, hosts ...string) (KeyPair, error) {
 	key, err := keyType()
 	if err != nil {
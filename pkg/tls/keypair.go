@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -31,6 +32,11 @@ func ToKeyPair(key crypto.PrivateKey, cert []byte) (*KeyPair, error) {
 	}, nil
 }
 
+// PEMEncodeKey encodes RSA keys as PKCS #1, ECDSA keys as SEC 1 and Ed25519 keys as PKCS #8.
+func PEMEncodeKey(priv crypto.PrivateKey) ([]byte, error) {
+	return pemEncodeKey(priv)
+}
+
 func pemEncodeKey(priv crypto.PrivateKey) ([]byte, error) {
 	var block *pem.Block
 	switch k := priv.(type) {
@@ -42,6 +48,12 @@ func pemEncodeKey(priv crypto.PrivateKey) ([]byte, error) {
 		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: bytes}
 	case *rsa.PrivateKey:
 		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case ed25519.PrivateKey:
+		bytes, err := x509.MarshalPKCS8PrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: bytes}
 	default:
 		return nil, errors.Errorf("unsupported private key type %T", priv)
 	}
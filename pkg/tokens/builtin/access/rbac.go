@@ -0,0 +1,27 @@
+package access
+
+import (
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacGenerateDataplaneToken struct {
+	authorizer rbac.Authorizer
+}
+
+var _ DataplaneTokenAccess = &rbacGenerateDataplaneToken{}
+
+func NewRBACGenerateDataplaneTokenAccess(authorizer rbac.Authorizer) DataplaneTokenAccess {
+	return &rbacGenerateDataplaneToken{
+		authorizer: authorizer,
+	}
+}
+
+func (r *rbacGenerateDataplaneToken) ValidateGenerateDataplaneToken(_ string, mesh string, _ map[string][]string, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbCreate, rbac.DataplaneTokenType, mesh)
+}
+
+func (r *rbacGenerateDataplaneToken) ValidateGenerateZoneIngressToken(_ string, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbCreate, rbac.ZoneIngressTokenType, "")
+}
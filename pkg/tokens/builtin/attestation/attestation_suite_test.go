@@ -0,0 +1,11 @@
+package attestation_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestAttestation(t *testing.T) {
+	test.RunSpecs(t, "Dataplane Token Attestation Suite")
+}
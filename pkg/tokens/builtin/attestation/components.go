@@ -0,0 +1,30 @@
+package attestation
+
+import (
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/tokens/builtin"
+)
+
+func RegisterAttestation(rt core_runtime.Runtime) error {
+	cfg := rt.Config().DpServer.Auth.Attestation
+	if !cfg.Enabled {
+		return nil
+	}
+	providers := Providers{}
+	if len(cfg.StaticKey.PublicKeys) > 0 {
+		provider, err := LoadStaticKeyProvider(cfg.StaticKey.PublicKeys)
+		if err != nil {
+			return err
+		}
+		providers[StaticKeyProvider] = provider
+	}
+	handler := &Handler{
+		Providers:     providers,
+		Issuer:        builtin.NewDataplaneTokenIssuer(rt.ResourceManager()),
+		TokenValidity: cfg.TokenValidity,
+		AuditLogger:   rt.AuditLogger(),
+	}
+	log.Info("registering dataplane token attestation in Dataplane Server")
+	rt.DpServer().HTTPMux().HandleFunc(Path, handler.Handle)
+	return nil
+}
@@ -0,0 +1,99 @@
+package attestation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+var log = core.Log.WithName("dataplane-token-attestation")
+
+// Path of the DP Server on which kuma-dp exchanges the evidence for a dataplane token.
+const Path = "/attestation/dataplane-token"
+
+// maxRequestSize limits the size of the request, which is read before the evidence is verified.
+const maxRequestSize = 64 * 1024
+
+type Handler struct {
+	Providers     Providers
+	Issuer        issuer.DataplaneTokenIssuer
+	TokenValidity time.Duration
+	AuditLogger   audit.Logger
+}
+
+func (h *Handler) Handle(resp http.ResponseWriter, req *http.Request) {
+	bytes, err := io.ReadAll(http.MaxBytesReader(resp, req.Body, maxRequestSize))
+	if err != nil {
+		log.Info("Could not read a request", "reason", err.Error())
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	request := types.DataplaneTokenAttestationRequest{}
+	if err := json.Unmarshal(bytes, &request); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logger := log.WithValues("provider", request.Provider, "mesh", request.Mesh, "name", request.Name)
+
+	if request.Type != "" {
+		if err := mesh_proto.ProxyType(request.Type).IsValid(); err != nil {
+			writeError(resp, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+	}
+	provider, ok := h.Providers[request.Provider]
+	if !ok {
+		writeError(resp, http.StatusBadRequest, "attestation provider \""+request.Provider+"\" is not enabled in the control plane", logger)
+		return
+	}
+
+	event := audit.Event{
+		Operation: audit.GenerateToken,
+		TokenType: audit.DataplaneToken,
+		Key:       model.ResourceKey{Mesh: request.Mesh, Name: request.Name},
+		Details: map[string]string{
+			"attestationProvider": request.Provider,
+		},
+	}
+	identity, err := provider.Attest(req.Context(), request)
+	if err != nil {
+		h.AuditLogger.Log(req.Context(), audit.AccessDenied(event, err))
+		logger.Info("attestation failed", "reason", err.Error())
+		writeError(resp, http.StatusUnauthorized, "attestation failed: "+err.Error(), logger)
+		return
+	}
+
+	token, err := h.Issuer.Generate(req.Context(), identity, h.TokenValidity)
+	event.Err = err
+	h.AuditLogger.Log(req.Context(), event)
+	if err != nil {
+		logger.Error(err, "Could not issue a token")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	logger.V(1).Info("issued dataplane token", "validFor", h.TokenValidity)
+
+	resp.Header().Set("content-type", "text/plain")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := resp.Write([]byte(token)); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
+
+func writeError(resp http.ResponseWriter, status int, msg string, logger logr.Logger) {
+	resp.WriteHeader(status)
+	if _, err := resp.Write([]byte(msg)); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
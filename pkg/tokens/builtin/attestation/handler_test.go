@@ -0,0 +1,274 @@
+package attestation_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/attestation"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+type recordingIssuer struct {
+	identity issuer.DataplaneIdentity
+	validFor time.Duration
+}
+
+var _ issuer.DataplaneTokenIssuer = &recordingIssuer{}
+
+func (r *recordingIssuer) Generate(_ context.Context, identity issuer.DataplaneIdentity, validFor time.Duration) (core_tokens.Token, error) {
+	r.identity = identity
+	r.validFor = validFor
+	return "token-for-" + identity.Name, nil
+}
+
+var _ = Describe("Dataplane token attestation", func() {
+
+	var server *httptest.Server
+	var tokenIssuer *recordingIssuer
+	var trustedKey []byte
+	var auditLog *bytes.Buffer
+
+	newKey := func() []byte {
+		key, err := core_tokens.NewSigningKeyWithAlgorithm(util_tls.Ed25519KeyAlgorithm)
+		Expect(err).ToNot(HaveOccurred())
+		return key
+	}
+
+	attest := func(request types.DataplaneTokenAttestationRequest) (int, string) {
+		body, err := json.Marshal(request)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := http.Post(server.URL+attestation.Path, "application/json", bytes.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, string(respBody)
+	}
+
+	evidence := func(key []byte, name string, mesh string, now time.Time) string {
+		evidence, err := attestation.NewStaticKeyEvidence(key, name, mesh, "", now)
+		Expect(err).ToNot(HaveOccurred())
+		return evidence
+	}
+
+	BeforeEach(func() {
+		trustedKey = newKey()
+		publicKey, err := core_tokens.PublicKeyFromSigningKey(trustedKey)
+		Expect(err).ToNot(HaveOccurred())
+		publicKeyFile := filepath.Join(GinkgoT().TempDir(), "key.pem")
+		Expect(os.WriteFile(publicKeyFile, publicKey, 0600)).To(Succeed())
+
+		provider, err := attestation.LoadStaticKeyProvider(map[string]string{publicKeyFile: "demo/backend-*"})
+		Expect(err).ToNot(HaveOccurred())
+
+		tokenIssuer = &recordingIssuer{}
+		auditLog = &bytes.Buffer{}
+		handler := &attestation.Handler{
+			Providers: attestation.Providers{
+				attestation.StaticKeyProvider: provider,
+			},
+			Issuer:        tokenIssuer,
+			TokenValidity: time.Hour,
+			AuditLogger:   audit.NewLogger(audit.LevelMetadata, auditLog),
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc(attestation.Path, handler.Handle)
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should issue a short-lived token for attested dataplane", func() {
+		// when
+		status, body := attest(types.DataplaneTokenAttestationRequest{
+			Provider: attestation.StaticKeyProvider,
+			Mesh:     "demo",
+			Name:     "backend-01",
+			Evidence: evidence(trustedKey, "backend-01", "demo", time.Now()),
+		})
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("token-for-backend-01"))
+		Expect(tokenIssuer.identity).To(Equal(issuer.DataplaneIdentity{
+			Name: "backend-01",
+			Mesh: "demo",
+			Type: mesh_proto.DataplaneProxyType,
+		}))
+		Expect(tokenIssuer.validFor).To(Equal(time.Hour))
+
+		// and
+		entry := audit.Entry{}
+		Expect(json.Unmarshal(auditLog.Bytes(), &entry)).To(Succeed())
+		Expect(entry.Operation).To(Equal(audit.GenerateToken))
+		Expect(entry.TokenType).To(Equal(audit.DataplaneToken))
+		Expect(entry.Mesh).To(Equal("demo"))
+		Expect(entry.Name).To(Equal("backend-01"))
+		Expect(entry.Outcome).To(Equal(audit.Success))
+		Expect(entry.Details).To(Equal(map[string]string{"attestationProvider": attestation.StaticKeyProvider}))
+	})
+
+	It("should take the type of the proxy from the evidence", func() {
+		// given
+		ingressEvidence, err := attestation.NewStaticKeyEvidence(trustedKey, "backend-01", "demo", string(mesh_proto.IngressProxyType), time.Now())
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		status, _ := attest(types.DataplaneTokenAttestationRequest{
+			Provider: attestation.StaticKeyProvider,
+			Mesh:     "demo",
+			Name:     "backend-01",
+			Evidence: ingressEvidence,
+		})
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(tokenIssuer.identity.Type).To(Equal(mesh_proto.IngressProxyType))
+
+		// when the type in the request does not match the evidence
+		status, body := attest(types.DataplaneTokenAttestationRequest{
+			Provider: attestation.StaticKeyProvider,
+			Mesh:     "demo",
+			Name:     "backend-01",
+			Type:     string(mesh_proto.DataplaneProxyType),
+			Evidence: ingressEvidence,
+		})
+
+		// then
+		Expect(status).To(Equal(http.StatusUnauthorized))
+		Expect(body).To(ContainSubstring(`evidence was issued for proxy of type "ingress"`))
+	})
+
+	It("should audit denied attestation", func() {
+		// when
+		status, _ := attest(types.DataplaneTokenAttestationRequest{
+			Provider: attestation.StaticKeyProvider,
+			Mesh:     "demo",
+			Name:     "backend-01",
+			Evidence: evidence(newKey(), "backend-01", "demo", time.Now()),
+		})
+
+		// then
+		Expect(status).To(Equal(http.StatusUnauthorized))
+		entry := audit.Entry{}
+		Expect(json.Unmarshal(auditLog.Bytes(), &entry)).To(Succeed())
+		Expect(entry.Outcome).To(Equal(audit.Denied))
+		Expect(entry.Error).To(ContainSubstring("could not verify the evidence"))
+	})
+
+	It("should reject too large request", func() {
+		// when
+		resp, err := http.Post(server.URL+attestation.Path, "application/json", bytes.NewReader(make([]byte, 128*1024)))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	type testCase struct {
+		request        func() types.DataplaneTokenAttestationRequest
+		expectedStatus int
+		expectedBody   string
+	}
+
+	DescribeTable("should reject",
+		func(given testCase) {
+			// when
+			status, body := attest(given.request())
+
+			// then
+			Expect(status).To(Equal(given.expectedStatus))
+			Expect(body).To(ContainSubstring(given.expectedBody))
+		},
+		Entry("evidence signed with untrusted key", testCase{
+			request: func() types.DataplaneTokenAttestationRequest {
+				return types.DataplaneTokenAttestationRequest{
+					Provider: attestation.StaticKeyProvider,
+					Mesh:     "demo",
+					Name:     "backend-01",
+					Evidence: evidence(newKey(), "backend-01", "demo", time.Now()),
+				}
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "could not verify the evidence",
+		}),
+		Entry("expired evidence", testCase{
+			request: func() types.DataplaneTokenAttestationRequest {
+				return types.DataplaneTokenAttestationRequest{
+					Provider: attestation.StaticKeyProvider,
+					Mesh:     "demo",
+					Name:     "backend-01",
+					Evidence: evidence(trustedKey, "backend-01", "demo", time.Now().Add(-time.Hour)),
+				}
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "token is expired",
+		}),
+		Entry("evidence issued for other dataplane", testCase{
+			request: func() types.DataplaneTokenAttestationRequest {
+				return types.DataplaneTokenAttestationRequest{
+					Provider: attestation.StaticKeyProvider,
+					Mesh:     "demo",
+					Name:     "backend-02",
+					Evidence: evidence(trustedKey, "backend-01", "demo", time.Now()),
+				}
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `evidence was issued for dataplane "backend-01" in mesh "demo"`,
+		}),
+		Entry("evidence signed with key that is not trusted for the dataplane", testCase{
+			request: func() types.DataplaneTokenAttestationRequest {
+				return types.DataplaneTokenAttestationRequest{
+					Provider: attestation.StaticKeyProvider,
+					Mesh:     "demo",
+					Name:     "web-01",
+					Evidence: evidence(trustedKey, "web-01", "demo", time.Now()),
+				}
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `the key is not trusted for dataplane "web-01" in mesh "demo"`,
+		}),
+		Entry("evidence signed with key that is not trusted for the mesh", testCase{
+			request: func() types.DataplaneTokenAttestationRequest {
+				return types.DataplaneTokenAttestationRequest{
+					Provider: attestation.StaticKeyProvider,
+					Mesh:     "prod",
+					Name:     "backend-01",
+					Evidence: evidence(trustedKey, "backend-01", "prod", time.Now()),
+				}
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `the key is not trusted for dataplane "backend-01" in mesh "prod"`,
+		}),
+		Entry("provider that is not enabled", testCase{
+			request: func() types.DataplaneTokenAttestationRequest {
+				return types.DataplaneTokenAttestationRequest{
+					Provider: "aws",
+					Mesh:     "demo",
+					Name:     "backend-01",
+					Evidence: "doc",
+				}
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `attestation provider "aws" is not enabled in the control plane`,
+		}),
+	)
+})
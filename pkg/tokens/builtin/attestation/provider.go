@@ -0,0 +1,17 @@
+package attestation
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+// Provider verifies evidence presented by a data plane proxy, for example a signed instance identity document
+// or a token of a node agent, and returns the identity that a short-lived dataplane token can be issued for.
+// Providers are registered in the Handler by name, which kuma-dp passes in the request.
+type Provider interface {
+	Attest(ctx context.Context, request types.DataplaneTokenAttestationRequest) (issuer.DataplaneIdentity, error)
+}
+
+type Providers map[string]Provider
@@ -0,0 +1,169 @@
+package attestation
+
+import (
+	"context"
+	"crypto"
+	"os"
+	"path"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	dp_server "github.com/kumahq/kuma/pkg/config/dp-server"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+// StaticKeyProvider is a reference implementation of the Provider.
+// The evidence is a short-lived JWT signed with a private key provisioned on the machine of the data plane proxy.
+// Every public key is trusted only for the data plane proxies that it is configured for,
+// therefore every machine (or group of machines) should have its own key, so it can be distrusted independently.
+const StaticKeyProvider = "staticKey"
+
+const (
+	staticKeyAudience = "kuma-dp-attestation"
+	// MaxStaticKeyEvidenceValidity limits the time in which the evidence can be replayed.
+	MaxStaticKeyEvidenceValidity = 5 * time.Minute
+	// evidenceClockSkew is subtracted from the issue time, so the evidence is accepted when the clock of the control plane is behind.
+	evidenceClockSkew = time.Minute
+)
+
+type StaticKeyClaims struct {
+	Name string
+	Mesh string
+	Type string
+	jwt.RegisteredClaims
+}
+
+// NewStaticKeyEvidence returns the evidence for the StaticKeyProvider signed with the PEM encoded private key.
+func NewStaticKeyEvidence(keyBytes []byte, name string, mesh string, proxyType string, now time.Time) (string, error) {
+	key, method, err := core_tokens.ParseSigningKey(keyBytes)
+	if err != nil {
+		return "", errors.Wrap(err, "could not parse the attestation key")
+	}
+	claims := StaticKeyClaims{
+		Name: name,
+		Mesh: mesh,
+		Type: proxyType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{staticKeyAudience},
+			IssuedAt:  jwt.NewNumericDate(now.Add(-evidenceClockSkew)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(-evidenceClockSkew).Add(MaxStaticKeyEvidenceValidity)),
+		},
+	}
+	return jwt.NewWithClaims(method, claims).SignedString(key)
+}
+
+// StaticKey is a trusted public key of the StaticKeyProvider.
+// Mesh and Name are patterns (see path.Match) of data plane proxies that can be attested with the key.
+type StaticKey struct {
+	PublicKey crypto.PublicKey
+	Mesh      string
+	Name      string
+}
+
+func (s StaticKey) trusts(mesh string, name string) bool {
+	meshMatch, _ := path.Match(s.Mesh, mesh)
+	nameMatch, _ := path.Match(s.Name, name)
+	return meshMatch && nameMatch
+}
+
+// LoadStaticKeyProvider loads public keys from files and trusts them for data plane proxies in the "mesh/name" format.
+func LoadStaticKeyProvider(publicKeys map[string]string) (Provider, error) {
+	var keys []StaticKey
+	for file, dataplanes := range publicKeys {
+		keyBytes, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read public key file %s", file)
+		}
+		key, err := core_tokens.ParsePublicKey(keyBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse public key file %s", file)
+		}
+		mesh, name, err := dp_server.ParseStaticKeyDataplanes(dataplanes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid data plane proxies of public key file %s", file)
+		}
+		keys = append(keys, StaticKey{
+			PublicKey: key,
+			Mesh:      mesh,
+			Name:      name,
+		})
+	}
+	return NewStaticKeyProvider(keys), nil
+}
+
+func NewStaticKeyProvider(keys []StaticKey) Provider {
+	return &staticKeyProvider{
+		keys: keys,
+	}
+}
+
+type staticKeyProvider struct {
+	keys []StaticKey
+}
+
+var _ Provider = &staticKeyProvider{}
+
+func (s *staticKeyProvider) Attest(_ context.Context, request types.DataplaneTokenAttestationRequest) (issuer.DataplaneIdentity, error) {
+	claims, err := s.verify(request.Evidence)
+	if err != nil {
+		return issuer.DataplaneIdentity{}, err
+	}
+	if claims.IssuedAt == nil || claims.ExpiresAt == nil {
+		return issuer.DataplaneIdentity{}, errors.New("evidence has to have iat and exp claims")
+	}
+	if claims.ExpiresAt.Sub(claims.IssuedAt.Time) > MaxStaticKeyEvidenceValidity {
+		return issuer.DataplaneIdentity{}, errors.Errorf("evidence cannot be valid for longer than %s", MaxStaticKeyEvidenceValidity)
+	}
+	if claims.Name == "" || claims.Mesh == "" {
+		return issuer.DataplaneIdentity{}, errors.New("evidence has to have name and mesh")
+	}
+	if claims.Name != request.Name || claims.Mesh != request.Mesh {
+		return issuer.DataplaneIdentity{}, errors.Errorf("evidence was issued for dataplane %q in mesh %q", claims.Name, claims.Mesh)
+	}
+	// the type is taken from the evidence, because the rest of the request is not signed
+	proxyType := claims.Type
+	if proxyType == "" {
+		proxyType = string(mesh_proto.DataplaneProxyType)
+	}
+	if err := mesh_proto.ProxyType(proxyType).IsValid(); err != nil {
+		return issuer.DataplaneIdentity{}, errors.Wrap(err, "evidence has invalid type")
+	}
+	if request.Type != "" && request.Type != proxyType {
+		return issuer.DataplaneIdentity{}, errors.Errorf("evidence was issued for proxy of type %q", proxyType)
+	}
+	return issuer.DataplaneIdentity{
+		Name: claims.Name,
+		Mesh: claims.Mesh,
+		Type: mesh_proto.ProxyType(proxyType),
+	}, nil
+}
+
+// verify returns claims of the evidence if it is signed with a key that is trusted for the data plane proxy from the claims.
+func (s *staticKeyProvider) verify(evidence string) (*StaticKeyClaims, error) {
+	var lastErr error = errors.New("there are no trusted public keys")
+	for _, key := range s.keys {
+		claims := &StaticKeyClaims{}
+		_, err := jwt.ParseWithClaims(evidence, claims, func(*jwt.Token) (interface{}, error) {
+			return key.PublicKey, nil
+		})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if !claims.VerifyAudience(staticKeyAudience, true) {
+			return nil, errors.New("evidence has invalid audience")
+		}
+		if !key.trusts(claims.Mesh, claims.Name) {
+			// the same key can be configured multiple times for different data plane proxies
+			lastErr = errors.Errorf("the key is not trusted for dataplane %q in mesh %q", claims.Name, claims.Mesh)
+			continue
+		}
+		return claims, nil
+	}
+	return nil, errors.Wrap(lastErr, "could not verify the evidence")
+}
@@ -1,8 +1,14 @@
 package builtin
 
 import (
-	"github.com/kumahq/kuma/pkg/config/core"
+	"context"
+	"sync"
+	"time"
+
+	config_core "github.com/kumahq/kuma/pkg/config/core"
 	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/tokens"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
@@ -10,6 +16,8 @@ import (
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
+var log = core.Log.WithName("dataplane-token-validators")
+
 func NewDataplaneTokenIssuer(resManager manager.ResourceManager) issuer.DataplaneTokenIssuer {
 	return issuer.NewDataplaneTokenIssuer(func(meshName string) tokens.Issuer {
 		return tokens.NewTokenIssuer(
@@ -35,13 +43,66 @@ func NewZoneTokenIssuer(resManager manager.ResourceManager) zone.TokenIssuer {
 }
 
 func NewDataplaneTokenValidator(resManager manager.ResourceManager, storeType store_config.StoreType) issuer.Validator {
-	return issuer.NewValidator(func(meshName string) tokens.Validator {
-		return tokens.NewValidator(
-			tokens.NewMeshedSigningKeyAccessor(resManager, issuer.DataplaneTokenSigningKeyPrefix(meshName), meshName),
-			tokens.NewRevocations(resManager, issuer.DataplaneTokenRevocationsSecretKey(meshName)),
-			storeType,
-		)
-	})
+	validators := &dataplaneTokenValidators{
+		resManager: resManager,
+		storeType:  storeType,
+		validators: map[string]tokens.Validator{},
+	}
+	return issuer.NewValidator(validators.get)
+}
+
+// validatorsPruneInterval is how often validators of deleted meshes are dropped.
+const validatorsPruneInterval = time.Minute
+
+// dataplaneTokenValidators reuses validators per mesh, so revocation lists that they cache are not parsed on every validation.
+type dataplaneTokenValidators struct {
+	resManager manager.ResourceManager
+	storeType  store_config.StoreType
+
+	sync.Mutex
+	validators map[string]tokens.Validator
+	prunedAt   time.Time
+}
+
+func (d *dataplaneTokenValidators) get(meshName string) tokens.Validator {
+	d.Lock()
+	defer d.Unlock()
+	if time.Since(d.prunedAt) > validatorsPruneInterval {
+		// prune is claimed before it starts, so a failing List is not retried on every validation
+		d.prunedAt = time.Now()
+		go d.prune()
+	}
+	if validator, ok := d.validators[meshName]; ok {
+		return validator
+	}
+	validator := tokens.NewValidator(
+		tokens.NewMeshedSigningKeyAccessor(d.resManager, issuer.DataplaneTokenSigningKeyPrefix(meshName), meshName),
+		tokens.NewRevocations(d.resManager, issuer.DataplaneTokenRevocationsSecretKey(meshName)),
+		d.storeType,
+	)
+	d.validators[meshName] = validator
+	return validator
+}
+
+// prune drops validators of meshes that no longer exist.
+// Meshes are listed outside of the lock, so validations are not blocked by the store.
+func (d *dataplaneTokenValidators) prune() {
+	meshes := core_mesh.MeshResourceList{}
+	if err := d.resManager.List(context.Background(), &meshes); err != nil {
+		log.Error(err, "could not list meshes to prune dataplane token validators")
+		return
+	}
+	existing := map[string]struct{}{}
+	for _, mesh := range meshes.Items {
+		existing[mesh.GetMeta().GetName()] = struct{}{}
+	}
+	d.Lock()
+	defer d.Unlock()
+	for meshName := range d.validators {
+		if _, ok := existing[meshName]; !ok {
+			delete(d.validators, meshName)
+		}
+	}
 }
 
 func NewZoneIngressTokenValidator(resManager manager.ResourceManager, storeType store_config.StoreType) zoneingress.Validator {
@@ -54,10 +115,10 @@ func NewZoneIngressTokenValidator(resManager manager.ResourceManager, storeType
 	)
 }
 
-func NewZoneTokenValidator(resManager manager.ResourceManager, mode core.CpMode, storeType store_config.StoreType) zone.Validator {
+func NewZoneTokenValidator(resManager manager.ResourceManager, mode config_core.CpMode, storeType store_config.StoreType) zone.Validator {
 	var signingKeyAccessor tokens.SigningKeyAccessor
 
-	if mode == core.Zone {
+	if mode == config_core.Zone {
 		signingKeyAccessor = tokens.NewSigningKeyFromPublicKeyAccessor(resManager, zone.SigningPublicKeyPrefix)
 	} else {
 		signingKeyAccessor = tokens.NewSigningKeyAccessor(resManager, zone.SigningKeyPrefix)
@@ -0,0 +1,12 @@
+package types
+
+// DataplaneTokenAttestationRequest is sent by kuma-dp to the DP Server to exchange a proof of its identity for a dataplane token.
+type DataplaneTokenAttestationRequest struct {
+	// Provider is a name of the attestation provider that verifies the evidence.
+	Provider string `json:"provider"`
+	Mesh     string `json:"mesh"`
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	// Evidence is a proof of the identity in the format of the provider.
+	Evidence string `json:"evidence"`
+}
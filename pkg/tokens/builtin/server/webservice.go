@@ -8,6 +8,8 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/rest/errors"
 	"github.com/kumahq/kuma/pkg/core/user"
 	"github.com/kumahq/kuma/pkg/core/validators"
@@ -27,6 +29,7 @@ type tokenWebService struct {
 	zoneIssuer        zone.TokenIssuer
 	dpAccess          access.DataplaneTokenAccess
 	zoneAccess        zone_access.ZoneTokenAccess
+	auditLogger       audit.Logger
 }
 
 func NewWebservice(
@@ -35,6 +38,7 @@ func NewWebservice(
 	zoneIssuer zone.TokenIssuer,
 	dpAccess access.DataplaneTokenAccess,
 	zoneAccess zone_access.ZoneTokenAccess,
+	auditLogger audit.Logger,
 ) *restful.WebService {
 	ws := tokenWebService{
 		issuer:            issuer,
@@ -42,6 +46,7 @@ func NewWebservice(
 		zoneIssuer:        zoneIssuer,
 		dpAccess:          dpAccess,
 		zoneAccess:        zoneAccess,
+		auditLogger:       auditLogger,
 	}
 	return ws.createWs()
 }
@@ -86,12 +91,18 @@ func (d *tokenWebService) handleIdentityRequest(request *restful.Request, respon
 		return
 	}
 
+	event := audit.Event{
+		Operation: audit.GenerateToken,
+		TokenType: audit.DataplaneToken,
+		Key:       model.ResourceKey{Mesh: idReq.Mesh, Name: idReq.Name},
+	}
 	if err := d.dpAccess.ValidateGenerateDataplaneToken(
 		idReq.Name,
 		idReq.Mesh,
 		idReq.Tags,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
+		d.auditLogger.Log(request.Request.Context(), audit.AccessDenied(event, err))
 		errors.HandleError(response, err, "Could not issue a token")
 		return
 	}
@@ -102,6 +113,8 @@ func (d *tokenWebService) handleIdentityRequest(request *restful.Request, respon
 		Type: mesh_proto.ProxyType(idReq.Type),
 		Tags: mesh_proto.MultiValueTagSetFrom(idReq.Tags),
 	}, validFor)
+	event.Err = err
+	d.auditLogger.Log(request.Request.Context(), event)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
@@ -135,7 +148,13 @@ func (d *tokenWebService) handleZoneIngressIdentityRequest(request *restful.Requ
 		return
 	}
 
+	event := audit.Event{
+		Operation: audit.GenerateToken,
+		TokenType: audit.ZoneIngressToken,
+		Key:       model.ResourceKey{Name: idReq.Zone},
+	}
 	if err := d.dpAccess.ValidateGenerateZoneIngressToken(idReq.Zone, user.FromCtx(request.Request.Context())); err != nil {
+		d.auditLogger.Log(request.Request.Context(), audit.AccessDenied(event, err))
 		errors.HandleError(response, err, "Could not issue a token")
 		return
 	}
@@ -156,6 +175,8 @@ func (d *tokenWebService) handleZoneIngressIdentityRequest(request *restful.Requ
 	token, err := d.zoneIngressIssuer.Generate(request.Request.Context(), zoneingress.Identity{
 		Zone: idReq.Zone,
 	}, validFor)
+	event.Err = err
+	d.auditLogger.Log(request.Request.Context(), event)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
@@ -177,7 +198,13 @@ func (d *tokenWebService) handleZoneIdentityRequest(request *restful.Request, re
 
 	ctx := request.Request.Context()
 
+	event := audit.Event{
+		Operation: audit.GenerateToken,
+		TokenType: audit.ZoneToken,
+		Key:       model.ResourceKey{Name: idReq.Zone},
+	}
 	if err := d.zoneAccess.ValidateGenerateZoneToken(idReq.Zone, user.FromCtx(ctx)); err != nil {
+		d.auditLogger.Log(ctx, audit.AccessDenied(event, err))
 		errors.HandleError(response, err, "Could not issue a token")
 		return
 	}
@@ -200,6 +227,8 @@ func (d *tokenWebService) handleZoneIdentityRequest(request *restful.Request, re
 		Zone:  idReq.Zone,
 		Scope: idReq.Scope,
 	}, validFor)
+	event.Err = err
+	d.auditLogger.Log(ctx, event)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
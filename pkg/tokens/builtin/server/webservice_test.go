@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/core/tokens"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/access"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
@@ -57,14 +58,17 @@ var _ = Describe("Dataplane Token Webservice", func() {
 
 	const credentials = "test"
 	var url string
+	var auditLog *bytes.Buffer
 
 	BeforeEach(func() {
+		auditLog = &bytes.Buffer{}
 		ws := server.NewWebservice(
 			&staticTokenIssuer{credentials},
 			&zoneIngressStaticTokenIssuer{},
 			&zoneStaticTokenIssuer{},
 			&access.NoopDpTokenAccess{},
 			&zone_access.NoopZoneTokenAccess{},
+			audit.NewLogger(audit.LevelMetadata, auditLog),
 		)
 
 		container := restful.NewContainer()
@@ -104,6 +108,15 @@ var _ = Describe("Dataplane Token Webservice", func() {
 		// then
 		Expect(err).ToNot(HaveOccurred())
 		Expect(string(respBody)).To(Equal(credentials))
+
+		// and
+		entry := audit.Entry{}
+		Expect(json.Unmarshal(auditLog.Bytes(), &entry)).To(Succeed())
+		Expect(entry.Operation).To(Equal(audit.GenerateToken))
+		Expect(entry.TokenType).To(Equal(audit.DataplaneToken))
+		Expect(entry.Mesh).To(Equal("default"))
+		Expect(entry.Name).To(Equal("dp-1"))
+		Expect(entry.Outcome).To(Equal(audit.Success))
 	})
 
 	DescribeTable("should return bad request on invalid json",
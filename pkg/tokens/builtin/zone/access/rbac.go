@@ -0,0 +1,23 @@
+package access
+
+import (
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacZoneTokenAccess struct {
+	authorizer rbac.Authorizer
+}
+
+var _ ZoneTokenAccess = &rbacZoneTokenAccess{}
+
+func NewRBACZoneTokenAccess(authorizer rbac.Authorizer) ZoneTokenAccess {
+	return &rbacZoneTokenAccess{
+		authorizer: authorizer,
+	}
+}
+
+func (r *rbacZoneTokenAccess) ValidateGenerateZoneToken(_ string, user user.User) error {
+	return r.authorizer.Authorize(user, system.VerbCreate, rbac.ZoneTokenType, "")
+}
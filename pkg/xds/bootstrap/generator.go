@@ -8,6 +8,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -82,6 +83,7 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 		XdsConnectTimeout:     b.config.Params.XdsConnectTimeout,
 		AccessLogPipe:         envoy_common.AccessLogSocketName(request.Name, request.Mesh),
 		DataplaneToken:        request.DataplaneToken,
+		DataplaneTokenPath:    request.DataplaneTokenPath,
 		DataplaneResource:     request.DataplaneResource,
 		KumaDpVersion:         request.Version.KumaDp.Version,
 		KumaDpGitTag:          request.Version.KumaDp.GitTag,
@@ -141,6 +143,17 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 
 		params.Service = dataplane.Spec.GetIdentifyingService()
 		setAdminPort(dataplane.Spec.GetNetworking().GetAdmin().GetPort())
+
+		mesh, err := b.meshFor(ctx, dataplane)
+		if err != nil {
+			return nil, err
+		}
+		if params.MetricsApplications, err = metricsApplications(dataplane, mesh); err != nil {
+			return nil, err
+		}
+		if params.MetricsOpenTelemetry, err = metricsOpenTelemetry(dataplane, mesh); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errors.Errorf("unknown proxy type %v", params.ProxyType)
 	}
@@ -285,6 +298,95 @@ func (b *bootstrapGenerator) validateMeshExist(ctx context.Context, mesh string)
 	return nil
 }
 
+// meshFor returns the mesh of the dataplane or nil if the mesh does not exist.
+func (b *bootstrapGenerator) meshFor(ctx context.Context, dataplane *core_mesh.DataplaneResource) (*core_mesh.MeshResource, error) {
+	mesh := core_mesh.NewMeshResource()
+	if err := b.resManager.Get(ctx, mesh, core_store.GetByKey(dataplane.Meta.GetMesh(), core_model.NoMesh)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mesh, nil
+}
+
+// metricsApplications returns enabled application metrics endpoints of the dataplane,
+// which are merged with Envoy metrics by kuma-dp.
+func metricsApplications(dataplane *core_mesh.DataplaneResource, mesh *core_mesh.MeshResource) ([]types.MetricsApplication, error) {
+	endpoint, err := dataplane.GetPrometheusEndpoint(mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get prometheus endpoint")
+	}
+
+	var applications []types.MetricsApplication
+	for _, app := range endpoint.GetAggregate() {
+		if app.GetEnabled() != nil && !app.GetEnabled().GetValue() {
+			continue
+		}
+		application := types.MetricsApplication{
+			Name:    app.Name,
+			Address: app.Address,
+			Port:    app.Port,
+			Path:    app.Path,
+		}
+		if application.Address == "" {
+			application.Address = "127.0.0.1"
+		}
+		if application.Path == "" {
+			application.Path = "/metrics"
+		}
+		applications = append(applications, application)
+	}
+	return applications, nil
+}
+
+const (
+	defaultMetricsOpenTelemetryInterval = 60 * time.Second
+	meshResourceAttribute               = "kuma.io/mesh"
+	dataplaneResourceAttribute          = "kuma.io/dataplane"
+)
+
+// metricsOpenTelemetry returns the configuration of pushing metrics of the dataplane
+// to an OpenTelemetry collector by kuma-dp.
+func metricsOpenTelemetry(dataplane *core_mesh.DataplaneResource, mesh *core_mesh.MeshResource) (*types.MetricsOpenTelemetry, error) {
+	cfg, err := dataplane.GetOpenTelemetryMetrics(mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get opentelemetry metrics config")
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+
+	interval := defaultMetricsOpenTelemetryInterval
+	if cfg.Interval != nil {
+		interval = cfg.Interval.AsDuration()
+	}
+	attributes := map[string]string{
+		meshResourceAttribute:      dataplane.Meta.GetMesh(),
+		dataplaneResourceAttribute: dataplane.Meta.GetName(),
+	}
+	tags := dataplane.Spec.TagSet()
+	for _, key := range tags.Keys() {
+		attributes[key] = strings.Join(tags.Values(key), ",")
+	}
+	var tls *types.MetricsOpenTelemetryTLS
+	if cfg.Tls != nil {
+		tls = &types.MetricsOpenTelemetryTLS{
+			CaCert:     cfg.Tls.CaCert,
+			ServerName: cfg.Tls.ServerName,
+			SkipVerify: cfg.Tls.SkipVerify,
+		}
+	}
+	return &types.MetricsOpenTelemetry{
+		Endpoint:           cfg.Endpoint,
+		Interval:           interval.String(),
+		Filter:             cfg.Filter,
+		UsedOnly:           cfg.GetUsedOnly().GetValue(),
+		ResourceAttributes: attributes,
+		TLS:                tls,
+	}, nil
+}
+
 // caCert gets CA cert that was used to signed cert that DP server is protected with.
 // Technically result of this function does not have to be a valid CA.
 // When user provides custom cert + key and does not provide --ca-cert-file to kuma-dp run, this can return just a regular cert
@@ -81,6 +81,7 @@ var _ = Describe("bootstrapGenerator", func() {
 
 	type testCase struct {
 		config             func() *bootstrap_config.BootstrapServerConfig
+		mesh               func() *mesh_proto.Mesh
 		dataplane          func() *core_mesh.DataplaneResource
 		dpAuthEnabled      bool
 		request            types.BootstrapRequest
@@ -90,6 +91,12 @@ var _ = Describe("bootstrapGenerator", func() {
 	DescribeTable("should generate bootstrap configuration",
 		func(given testCase) {
 			// setup
+			if given.mesh != nil {
+				mesh := core_mesh.NewMeshResource()
+				Expect(resManager.Get(context.Background(), mesh, store.GetByKey("mesh", model.NoMesh))).To(Succeed())
+				mesh.Spec = given.mesh()
+				Expect(resManager.Update(context.Background(), mesh)).To(Succeed())
+			}
 			err := resManager.Create(context.Background(), given.dataplane(), store.CreateByKey("name.namespace", "mesh"))
 			Expect(err).ToNot(HaveOccurred())
 
@@ -269,6 +276,136 @@ var _ = Describe("bootstrapGenerator", func() {
 			expectedConfigFile: "generator.default-config.kubernetes.ipv6.golden.yaml",
 			hdsEnabled:         false,
 		}),
+		Entry("default config with renewable token", testCase{
+			dpAuthEnabled: true,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			dataplane: func() *core_mesh.DataplaneResource {
+				dp := defaultDataplane()
+				dp.Spec.Networking.Admin.Port = 1234
+				return dp
+			},
+			request: types.BootstrapRequest{
+				Mesh:               "mesh",
+				Name:               "name.namespace",
+				DataplaneToken:     "token",
+				DataplaneTokenPath: "/tmp/kuma-dp/name.namespace",
+				Version:            defaultVersion,
+			},
+			expectedConfigFile: "generator.default-config.renewable-token.golden.yaml",
+			hdsEnabled:         true,
+		}),
+		Entry("default config with application metrics", testCase{
+			dpAuthEnabled: false,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			mesh: func() *mesh_proto.Mesh {
+				return &mesh_proto.Mesh{
+					Metrics: &mesh_proto.Metrics{
+						EnabledBackend: "prometheus-1",
+						Backends: []*mesh_proto.MetricsBackend{{
+							Name: "prometheus-1",
+							Type: mesh_proto.MetricsPrometheusType,
+							Conf: util_proto.MustToStruct(&mesh_proto.PrometheusMetricsBackendConfig{
+								Port: 5670,
+								Path: "/metrics",
+								Aggregate: []*mesh_proto.PrometheusAggregateMetricsConfig{
+									{
+										Name: "app",
+										Port: 8080,
+									},
+									{
+										Name: "sidecar",
+										Port: 8081,
+									},
+								},
+							}),
+						}},
+					},
+				}
+			},
+			dataplane: func() *core_mesh.DataplaneResource {
+				dp := defaultDataplane()
+				dp.Spec.Metrics = &mesh_proto.MetricsBackend{
+					Type: mesh_proto.MetricsPrometheusType,
+					Conf: util_proto.MustToStruct(&mesh_proto.PrometheusMetricsBackendConfig{
+						Aggregate: []*mesh_proto.PrometheusAggregateMetricsConfig{
+							{
+								Name:    "jmx",
+								Address: "8.8.8.8",
+								Port:    9404,
+								Path:    "/jmx",
+							},
+							{
+								Name:    "sidecar",
+								Enabled: util_proto.Bool(false),
+							},
+						},
+					}),
+				}
+				return dp
+			},
+			request: types.BootstrapRequest{
+				Mesh:    "mesh",
+				Name:    "name.namespace",
+				Version: defaultVersion,
+			},
+			expectedConfigFile: "generator.default-config.application-metrics.golden.yaml",
+			hdsEnabled:         true,
+		}),
+		Entry("default config with opentelemetry metrics", testCase{
+			dpAuthEnabled: false,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			mesh: func() *mesh_proto.Mesh {
+				return &mesh_proto.Mesh{
+					Metrics: &mesh_proto.Metrics{
+						EnabledBackend: "otel-1",
+						Backends: []*mesh_proto.MetricsBackend{{
+							Name: "otel-1",
+							Type: mesh_proto.MetricsOpenTelemetryType,
+							Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryMetricsBackendConfig{
+								Endpoint: "otel-collector:4317",
+								Filter:   "^cluster",
+								Tls: &mesh_proto.OpenTelemetryMetricsTls{
+									ServerName: "otel-collector.observability",
+								},
+							}),
+						}},
+					},
+				}
+			},
+			dataplane: func() *core_mesh.DataplaneResource {
+				dp := defaultDataplane()
+				dp.Spec.Metrics = &mesh_proto.MetricsBackend{
+					Type: mesh_proto.MetricsOpenTelemetryType,
+					Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryMetricsBackendConfig{
+						Interval: util_proto.Duration(15 * time.Second),
+						UsedOnly: util_proto.Bool(true),
+					}),
+				}
+				return dp
+			},
+			request: types.BootstrapRequest{
+				Mesh:    "mesh",
+				Name:    "name.namespace",
+				Version: defaultVersion,
+			},
+			expectedConfigFile: "generator.default-config.opentelemetry-metrics.golden.yaml",
+			hdsEnabled:         true,
+		}),
 		Entry("backwards compatibility, adminPort in bootstrapRequest", testCase{ // https://github.com/kumahq/kuma/issues/4002
 			dpAuthEnabled: true,
 			config: func() *bootstrap_config.BootstrapServerConfig {
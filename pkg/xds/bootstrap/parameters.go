@@ -1,6 +1,10 @@
 package bootstrap
 
-import "time"
+import (
+	"time"
+
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
 
 type configParameters struct {
 	Id                    string
@@ -13,6 +17,7 @@ type configParameters struct {
 	XdsConnectTimeout     time.Duration
 	AccessLogPipe         string
 	DataplaneToken        string
+	DataplaneTokenPath    string
 	DataplaneResource     string
 	CertBytes             []byte
 	KumaDpVersion         string
@@ -27,4 +32,6 @@ type configParameters struct {
 	DNSPort               uint32
 	EmptyDNSPort          uint32
 	ProxyType             string
+	MetricsApplications   []types.MetricsApplication
+	MetricsOpenTelemetry  *types.MetricsOpenTelemetry
 }
@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"net"
 	"strconv"
 
 	"github.com/asaskevich/govalidator"
@@ -8,11 +9,13 @@ import (
 	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_grpc_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
 	envoy_metrics_v3 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v3"
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
 	"github.com/kumahq/kuma/pkg/xds/envoy/tls"
 )
 
@@ -78,6 +81,16 @@ func genConfig(parameters configParameters) (*envoy_bootstrap_v3.Bootstrap, erro
 					TagName:  "listener",
 					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "((.+?)\\.)rbac\\."},
 				},
+				{
+					// virtual clusters generated for TrafficRoute rules and MeshGatewayRoutes
+					TagName:  "kuma_route",
+					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^vhost\\..+\\.vcluster\\.(((?:trafficroute|meshgatewayroute)-[^.]+)\\.)"},
+				},
+				{
+					// split clusters with the stat name "<service>.split.<tags>"
+					TagName:  "kuma_split",
+					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^cluster\\.[^.]+(\\.split\\.([^.]+))\\."},
+				},
 			},
 		},
 		DynamicResources: &envoy_bootstrap_v3.Bootstrap_DynamicResources{
@@ -242,7 +255,13 @@ func genConfig(parameters configParameters) (*envoy_bootstrap_v3.Bootstrap, erro
 		}
 	}
 
-	if parameters.DataplaneToken != "" {
+	if parameters.DataplaneTokenPath != "" {
+		// the token is renewed by kuma-dp, so instead of sending the initial token Envoy reads the file on every connection
+		if res.HdsConfig != nil {
+			res.HdsConfig.GrpcServices = []*envoy_core_v3.GrpcService{tokenFileGrpcService(parameters, "hds")}
+		}
+		res.DynamicResources.AdsConfig.GrpcServices = []*envoy_core_v3.GrpcService{tokenFileGrpcService(parameters, "ads")}
+	} else if parameters.DataplaneToken != "" {
 		if res.HdsConfig != nil {
 			for _, n := range res.HdsConfig.GrpcServices {
 				n.InitialMetadata = []*envoy_core_v3.HeaderValue{
@@ -285,6 +304,39 @@ func genConfig(parameters configParameters) (*envoy_bootstrap_v3.Bootstrap, erro
 	if parameters.ProxyType != "" {
 		res.Node.Metadata.Fields["dataplane.proxyType"] = util_proto.MustNewValueForStruct(parameters.ProxyType)
 	}
+	if len(parameters.MetricsApplications) > 0 {
+		applications := make([]interface{}, 0, len(parameters.MetricsApplications))
+		for _, app := range parameters.MetricsApplications {
+			applications = append(applications, map[string]interface{}{
+				"name":    app.Name,
+				"address": app.Address,
+				"port":    app.Port,
+				"path":    app.Path,
+			})
+		}
+		res.Node.Metadata.Fields[types.MetricsApplicationsMetadataField] = util_proto.MustNewValueForStruct(applications)
+	}
+	if otel := parameters.MetricsOpenTelemetry; otel != nil {
+		attributes := make(map[string]interface{}, len(otel.ResourceAttributes))
+		for k, v := range otel.ResourceAttributes {
+			attributes[k] = v
+		}
+		fields := map[string]interface{}{
+			"endpoint":           otel.Endpoint,
+			"interval":           otel.Interval,
+			"filter":             otel.Filter,
+			"usedOnly":           otel.UsedOnly,
+			"resourceAttributes": attributes,
+		}
+		if otel.TLS != nil {
+			fields["tls"] = map[string]interface{}{
+				"caCert":     otel.TLS.CaCert,
+				"serverName": otel.TLS.ServerName,
+				"skipVerify": otel.TLS.SkipVerify,
+			}
+		}
+		res.Node.Metadata.Fields[types.MetricsOpenTelemetryMetadataField] = util_proto.MustNewValueForStruct(fields)
+	}
 	if len(parameters.DynamicMetadata) > 0 {
 		md := make(map[string]interface{}, len(parameters.DynamicMetadata))
 		for k, v := range parameters.DynamicMetadata {
@@ -295,6 +347,52 @@ func genConfig(parameters configParameters) (*envoy_bootstrap_v3.Bootstrap, erro
 	return res, nil
 }
 
+// tokenFileGrpcService returns a Google gRPC service, because only those support call credentials.
+// The file based metadata plugin reads the token from the file for every call.
+func tokenFileGrpcService(parameters configParameters, statPrefix string) *envoy_core_v3.GrpcService {
+	return &envoy_core_v3.GrpcService{
+		TargetSpecifier: &envoy_core_v3.GrpcService_GoogleGrpc_{
+			GoogleGrpc: &envoy_core_v3.GrpcService_GoogleGrpc{
+				TargetUri:  net.JoinHostPort(parameters.XdsHost, strconv.Itoa(int(parameters.XdsPort))),
+				StatPrefix: statPrefix,
+				ChannelCredentials: &envoy_core_v3.GrpcService_GoogleGrpc_ChannelCredentials{
+					CredentialSpecifier: &envoy_core_v3.GrpcService_GoogleGrpc_ChannelCredentials_SslCredentials{
+						SslCredentials: &envoy_core_v3.GrpcService_GoogleGrpc_SslCredentials{
+							RootCerts: &envoy_core_v3.DataSource{
+								Specifier: &envoy_core_v3.DataSource_InlineBytes{
+									InlineBytes: parameters.CertBytes,
+								},
+							},
+						},
+					},
+				},
+				CallCredentials: []*envoy_core_v3.GrpcService_GoogleGrpc_CallCredentials{
+					{
+						CredentialSpecifier: &envoy_core_v3.GrpcService_GoogleGrpc_CallCredentials_FromPlugin{
+							FromPlugin: &envoy_core_v3.GrpcService_GoogleGrpc_CallCredentials_MetadataCredentialsFromPlugin{
+								Name: fileBasedMetadataCredentials,
+								ConfigType: &envoy_core_v3.GrpcService_GoogleGrpc_CallCredentials_MetadataCredentialsFromPlugin_TypedConfig{
+									TypedConfig: util_proto.MustMarshalAny(&envoy_grpc_credential_v3.FileBasedMetadataConfig{
+										SecretData: &envoy_core_v3.DataSource{
+											Specifier: &envoy_core_v3.DataSource_Filename{
+												Filename: parameters.DataplaneTokenPath,
+											},
+										},
+										HeaderKey: "authorization",
+									}),
+								},
+							},
+						},
+					},
+				},
+				CredentialsFactoryName: fileBasedMetadataCredentials,
+			},
+		},
+	}
+}
+
+const fileBasedMetadataCredentials = "envoy.grpc_credentials.file_based_metadata"
+
 func clusterTypeFromHost(host string) envoy_cluster_v3.Cluster_DiscoveryType {
 	if govalidator.IsIP(host) {
 		return envoy_cluster_v3.Cluster_STATIC
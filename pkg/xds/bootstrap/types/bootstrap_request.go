@@ -12,14 +12,17 @@ const (
 const BootstrapVersionHeader = "kuma-bootstrap-version"
 
 type BootstrapRequest struct {
-	Mesh              string  `json:"mesh"`
-	Name              string  `json:"name"`
-	ProxyType         string  `json:"proxyType"`
-	AdminPort         uint32  `json:"adminPort,omitempty"`
-	DataplaneToken    string  `json:"dataplaneToken,omitempty"`
-	DataplaneResource string  `json:"dataplaneResource,omitempty"`
-	Host              string  `json:"-"`
-	Version           Version `json:"version"`
+	Mesh           string `json:"mesh"`
+	Name           string `json:"name"`
+	ProxyType      string `json:"proxyType"`
+	AdminPort      uint32 `json:"adminPort,omitempty"`
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+	// DataplaneTokenPath is set when kuma-dp renews the token in the file (see attestation).
+	// Envoy then reads the token from the file whenever it connects to the control plane.
+	DataplaneTokenPath string  `json:"dataplaneTokenPath,omitempty"`
+	DataplaneResource  string  `json:"dataplaneResource,omitempty"`
+	Host               string  `json:"-"`
+	Version            Version `json:"version"`
 	// CaCert is a PEM-encoded CA cert that DP uses to verify CP
 	CaCert          string            `json:"caCert"`
 	DynamicMetadata map[string]string `json:"dynamicMetadata"`
@@ -0,0 +1,14 @@
+package types
+
+// MetricsApplicationsMetadataField is a field of Envoy node metadata in which
+// Control Plane passes application metrics endpoints to kuma-dp.
+const MetricsApplicationsMetadataField = "dataplane.metrics.applications"
+
+// MetricsApplication is a metrics endpoint of an application next to a data plane proxy.
+// kuma-dp scrapes it and exposes its metrics together with Envoy metrics.
+type MetricsApplication struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    uint32 `json:"port"`
+	Path    string `json:"path"`
+}
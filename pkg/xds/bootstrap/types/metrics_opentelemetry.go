@@ -0,0 +1,26 @@
+package types
+
+// MetricsOpenTelemetryMetadataField is a field of Envoy node metadata in which
+// Control Plane passes the OpenTelemetry metrics backend to kuma-dp.
+const MetricsOpenTelemetryMetadataField = "dataplane.metrics.opentelemetry"
+
+// MetricsOpenTelemetry is a configuration of pushing Envoy metrics over OTLP.
+// kuma-dp periodically reads Envoy stats and exports them to a collector.
+type MetricsOpenTelemetry struct {
+	Endpoint string `json:"endpoint"`
+	// Interval is a Go duration string, e.g. "60s".
+	Interval string `json:"interval"`
+	Filter   string `json:"filter,omitempty"`
+	UsedOnly bool   `json:"usedOnly,omitempty"`
+	// ResourceAttributes are attached to the OTLP resource, e.g. dataplane tags.
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+	// TLS is nil when the connection to the collector is not encrypted.
+	TLS *MetricsOpenTelemetryTLS `json:"tls,omitempty"`
+}
+
+type MetricsOpenTelemetryTLS struct {
+	// CaCert is a PEM-encoded CA cert that kuma-dp uses to verify the collector.
+	CaCert     string `json:"caCert,omitempty"`
+	ServerName string `json:"serverName,omitempty"`
+	SkipVerify bool   `json:"skipVerify,omitempty"`
+}
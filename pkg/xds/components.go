@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/attestation"
 	"github.com/kumahq/kuma/pkg/xds/bootstrap"
 	"github.com/kumahq/kuma/pkg/xds/server"
 )
@@ -15,5 +16,8 @@ func Setup(rt core_runtime.Runtime) error {
 	if err := bootstrap.RegisterBootstrap(rt); err != nil {
 		return errors.Wrap(err, "could not register Bootstrap")
 	}
+	if err := attestation.RegisterAttestation(rt); err != nil {
+		return errors.Wrap(err, "could not register dataplane token attestation")
+	}
 	return nil
 }
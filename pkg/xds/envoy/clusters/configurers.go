@@ -60,6 +60,16 @@ func EdsCluster(name string) ClusterBuilderOpt {
 	})
 }
 
+// AltStatName sets the name used in stats of the cluster instead of its name.
+// It has to be configured after the configurers that set the name of the cluster.
+func AltStatName(name string) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.AltStatNameConfigurer{
+			Name: name,
+		})
+	})
+}
+
 // ProvidedEndpointCluster sets the cluster with the defined endpoints, this is useful when endpoints are not discovered using EDS, so we don't use EdsCluster
 func ProvidedEndpointCluster(name string, hasIPv6 bool, endpoints ...core_xds.Endpoint) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
@@ -7,11 +7,17 @@ import (
 )
 
 type AltStatNameConfigurer struct {
+	// Name is used in stats if set, otherwise the sanitized name of the cluster is used.
+	Name string
 }
 
 var _ ClusterConfigurer = &AltStatNameConfigurer{}
 
 func (e *AltStatNameConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if e.Name != "" {
+		cluster.AltStatName = e.Name
+		return nil
+	}
 	sanitizedName := util_xds.SanitizeMetric(cluster.Name)
 	if sanitizedName != cluster.Name {
 		cluster.AltStatName = sanitizedName
@@ -26,10 +26,12 @@ func Kafka(statsName string) FilterChainBuilderOpt {
 	})
 }
 
-func Tracing(backend *mesh_proto.TracingBackend, service string) FilterChainBuilderOpt {
+func Tracing(backend *mesh_proto.TracingBackend, service string, tags mesh_proto.MultiValueTagSet, envoyVersion *mesh_proto.EnvoyVersion) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.TracingConfigurer{
-		Backend: backend,
-		Service: service,
+		Backend:      backend,
+		Service:      service,
+		Tags:         tags,
+		EnvoyVersion: envoyVersion,
 	})
 }
 
@@ -104,6 +106,7 @@ func NetworkAccessLog(
 	sourceService string,
 	destinationService string,
 	backend *mesh_proto.LoggingBackend,
+	filter *mesh_proto.TrafficLog_Conf_Filter,
 	proxy *core_xds.Proxy,
 ) FilterChainBuilderOpt {
 	if backend == nil {
@@ -117,6 +120,7 @@ func NetworkAccessLog(
 			SourceService:      sourceService,
 			DestinationService: destinationService,
 			Backend:            backend,
+			Filter:             filter,
 			Proxy:              proxy,
 		},
 	})
@@ -127,6 +131,7 @@ func HttpAccessLog(
 	trafficDirection envoy_common.TrafficDirection,
 	sourceService string, destinationService string,
 	backend *mesh_proto.LoggingBackend,
+	filter *mesh_proto.TrafficLog_Conf_Filter,
 	proxy *core_xds.Proxy,
 ) FilterChainBuilderOpt {
 	if backend == nil {
@@ -140,6 +145,7 @@ func HttpAccessLog(
 			SourceService:      sourceService,
 			DestinationService: destinationService,
 			Backend:            backend,
+			Filter:             filter,
 			Proxy:              proxy,
 		},
 	})
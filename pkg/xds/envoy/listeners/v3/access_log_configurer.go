@@ -3,19 +3,26 @@ package v3
 import (
 	"fmt"
 	"net"
+	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	access_loggers_file "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	access_loggers_grpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	access_loggers_otel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	"github.com/pkg/errors"
+	otlp "go.opentelemetry.io/proto/otlp/common/v1"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 	"github.com/kumahq/kuma/pkg/util/proto"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
 
 const accessLogSink = "access_log_sink"
@@ -26,13 +33,36 @@ type AccessLogConfigurer struct {
 	SourceService      string
 	DestinationService string
 	Backend            *mesh_proto.LoggingBackend
+	Filter             *mesh_proto.TrafficLog_Conf_Filter
 	Proxy              *core_xds.Proxy
 }
 
+func (c *AccessLogConfigurer) accessLog(defaultFormat string) (*envoy_accesslog.AccessLog, error) {
+	accessLog, err := convertLoggingBackend(c.Mesh, c.TrafficDirection, c.SourceService, c.DestinationService, c.Backend, c.Proxy, defaultFormat)
+	if err != nil || accessLog == nil {
+		return accessLog, err
+	}
+	accessLog.Filter = convertLoggingFilter(c.Filter)
+	return accessLog, nil
+}
+
 func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection, sourceService string, destinationService string, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy, defaultFormat string) (*envoy_accesslog.AccessLog, error) {
 	if backend == nil {
 		return nil, nil
 	}
+	variables := accesslog.InterpolationVariables{
+		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(proxy.Dataplane.GetIP(), "0"), // deprecated variable
+		accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: proxy.Dataplane.GetIP(),                        // replacement variable
+		accesslog.CMD_KUMA_SOURCE_SERVICE:              sourceService,
+		accesslog.CMD_KUMA_DESTINATION_SERVICE:         destinationService,
+		accesslog.CMD_KUMA_MESH:                        mesh,
+		accesslog.CMD_KUMA_TRAFFIC_DIRECTION:           string(trafficDirection),
+	}
+
+	if len(backend.JsonFormat) > 0 {
+		return convertJsonLoggingBackend(variables, backend, proxy)
+	}
+
 	formatString := defaultFormat
 	if backend.Format != "" {
 		formatString = backend.Format
@@ -43,15 +73,6 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 		return nil, errors.Wrapf(err, "invalid access log format string: %s", formatString)
 	}
 
-	variables := accesslog.InterpolationVariables{
-		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(proxy.Dataplane.GetIP(), "0"), // deprecated variable
-		accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: proxy.Dataplane.GetIP(),                        // replacement variable
-		accesslog.CMD_KUMA_SOURCE_SERVICE:              sourceService,
-		accesslog.CMD_KUMA_DESTINATION_SERVICE:         destinationService,
-		accesslog.CMD_KUMA_MESH:                        mesh,
-		accesslog.CMD_KUMA_TRAFFIC_DIRECTION:           string(trafficDirection),
-	}
-
 	format, err = format.Interpolate(variables)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", formatString)
@@ -62,20 +83,83 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 		return fileAccessLog(format, backend.Conf)
 	case mesh_proto.LoggingTcpType:
 		return tcpAccessLog(format, backend.Conf)
+	case mesh_proto.LoggingOtelType:
+		return otelAccessLog(format, backend.Name, kumaAttributes(variables, proxy))
+	default: // should be caught by validator
+		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
+	}
+}
+
+func convertJsonLoggingBackend(variables accesslog.InterpolationVariables, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy) (*envoy_accesslog.AccessLog, error) {
+	format, err := accesslog.ParseJsonFormat(backend.JsonFormat)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid access log json format")
+	}
+
+	format, err = format.Interpolate(variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to interpolate access log json format with Kuma-specific variables")
+	}
+
+	switch backend.GetType() {
+	case mesh_proto.LoggingFileType:
+		return fileJsonAccessLog(format, backend.Conf)
+	case mesh_proto.LoggingTcpType:
+		if supportsJsonFormatLogName(proxy.Metadata.GetVersion().GetKumaDp()) {
+			return tcpAccessLog(format, backend.Conf)
+		}
+		// older kuma-dp would fail to parse the log name of a structured format
+		textFormat, err := format.TextFormat()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert access log json format to a text format")
+		}
+		return tcpAccessLog(textFormat, backend.Conf)
+	case mesh_proto.LoggingOtelType:
+		return otelJsonAccessLog(format, backend.Name, kumaAttributes(variables, proxy))
 	default: // should be caught by validator
 		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
 	}
 }
 
-func tcpAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
+// minJsonFormatLogNameKumaDpVersion is the first version of kuma-dp that understands
+// log names with a structured access log format.
+var minJsonFormatLogNameKumaDpVersion = semver.MustParse("1.6.0")
+
+// supportsJsonFormatLogName returns true if kuma-dp can parse a log name with a structured access log format.
+// kuma-dp of the same build as the control plane is considered as supporting it, which covers development builds.
+func supportsJsonFormatLogName(kumaDpVersion *mesh_proto.KumaDpVersion) bool {
+	if kumaDpVersion.GetVersion() == kuma_version.Build.Version {
+		return true
+	}
+	version := strings.SplitN(kumaDpVersion.GetVersion(), "-", 2)[0]
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return !v.LessThan(minJsonFormatLogNameKumaDpVersion)
+}
+
+// tcpLogFormat is either a text or a structured access log format.
+type tcpLogFormat interface {
+	accesslog.HttpLogConfigurer
+	String() string
+}
+
+func tcpAccessLog(format tcpLogFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
 	cfg := mesh_proto.TcpLoggingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
 		return nil, errors.Wrap(err, "could not parse backend config")
 	}
 
+	// kuma-dp learns the address of the TCP service and the format from the log name
+	logName := fmt.Sprintf("%s;%s", cfg.Address, format.String())
+	if _, ok := format.(*accesslog.JsonFormat); ok {
+		logName = fmt.Sprintf("%s;%s", accesslog.JsonFormatLogNamePrefix, logName)
+	}
+
 	httpGrpcAccessLog := &access_loggers_grpc.HttpGrpcAccessLogConfig{
 		CommonConfig: &access_loggers_grpc.CommonGrpcAccessLogConfig{
-			LogName:             fmt.Sprintf("%s;%s", cfg.Address, format.String()),
+			LogName:             logName,
 			TransportApiVersion: envoy_core.ApiVersion_V3,
 			GrpcService: &envoy_core.GrpcService{
 				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
@@ -101,6 +185,98 @@ func tcpAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*
 	}, nil
 }
 
+// kumaAttributes returns OTLP attributes describing the Kuma context of the log
+// record. Unlike the format string, they are always present.
+func kumaAttributes(variables accesslog.InterpolationVariables, proxy *core_xds.Proxy) []*otlp.KeyValue {
+	attributes := []*otlp.KeyValue{
+		otlpStringAttribute("kuma.mesh", variables.Get(accesslog.CMD_KUMA_MESH)),
+		otlpStringAttribute("kuma.source_service", variables.Get(accesslog.CMD_KUMA_SOURCE_SERVICE)),
+		otlpStringAttribute("kuma.destination_service", variables.Get(accesslog.CMD_KUMA_DESTINATION_SERVICE)),
+		otlpStringAttribute("kuma.traffic_direction", variables.Get(accesslog.CMD_KUMA_TRAFFIC_DIRECTION)),
+	}
+	if zones := proxy.Dataplane.Spec.TagSet().Values(mesh_proto.ZoneTag); len(zones) > 0 {
+		attributes = append(attributes, otlpStringAttribute("kuma.zone", strings.Join(zones, ",")))
+	}
+	return attributes
+}
+
+func otlpStringAttribute(key string, value string) *otlp.KeyValue {
+	return &otlp.KeyValue{
+		Key: key,
+		Value: &otlp.AnyValue{
+			Value: &otlp.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+// otelAccessLog configures Envoy to send access logs as OTLP log records. The
+// body of the record is the whole log line, and each command operator of the
+// format string is also sent as a separate attribute, e.g. "response_code" for
+// %RESPONSE_CODE%, so collectors don't have to parse the line.
+func otelAccessLog(format *accesslog.AccessLogFormat, backendName string, attributes []*otlp.KeyValue) (*envoy_accesslog.AccessLog, error) {
+	seen := map[string]bool{}
+	for _, attribute := range attributes {
+		seen[attribute.Key] = true
+	}
+	for _, fragment := range format.Fragments {
+		if _, ok := fragment.(accesslog.TextSpan); ok {
+			continue
+		}
+		key := strings.ToLower(strings.Trim(fragment.String(), "%"))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		attributes = append(attributes, otlpStringAttribute(key, fragment.String()))
+	}
+
+	body := &otlp.AnyValue{
+		Value: &otlp.AnyValue_StringValue{
+			StringValue: strings.TrimSuffix(format.String(), "\n"),
+		},
+	}
+	return otelAccessLogConfig(backendName, body, attributes)
+}
+
+// otelJsonAccessLog configures Envoy to send access logs as OTLP log records
+// with every field of the structured format sent as a separate attribute.
+func otelJsonAccessLog(format *accesslog.JsonFormat, backendName string, attributes []*otlp.KeyValue) (*envoy_accesslog.AccessLog, error) {
+	for _, field := range format.Fields {
+		attributes = append(attributes, otlpStringAttribute(field.Name, field.Format.String()))
+	}
+	return otelAccessLogConfig(backendName, nil, attributes)
+}
+
+func otelAccessLogConfig(backendName string, body *otlp.AnyValue, attributes []*otlp.KeyValue) (*envoy_accesslog.AccessLog, error) {
+	otelAccessLog := &access_loggers_otel.OpenTelemetryAccessLogConfig{
+		CommonConfig: &access_loggers_grpc.CommonGrpcAccessLogConfig{
+			LogName:             backendName,
+			TransportApiVersion: envoy_core.ApiVersion_V3,
+			GrpcService: &envoy_core.GrpcService{
+				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+						ClusterName: names.GetLoggingClusterName(backendName),
+					},
+				},
+			},
+		},
+		Body: body,
+		Attributes: &otlp.KeyValueList{
+			Values: attributes,
+		},
+	}
+	marshaled, err := proto.MarshalAnyDeterministic(otelAccessLog)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshall %T", otelAccessLog)
+	}
+	return &envoy_accesslog.AccessLog{
+		Name: "envoy.access_loggers.open_telemetry",
+		ConfigType: &envoy_accesslog.AccessLog_TypedConfig{
+			TypedConfig: marshaled,
+		},
+	}, nil
+}
+
 func fileAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
 	cfg := mesh_proto.FileLoggingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
@@ -132,3 +308,145 @@ func fileAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (
 		},
 	}, nil
 }
+
+func fileJsonAccessLog(format *accesslog.JsonFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
+	cfg := mesh_proto.FileLoggingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		return nil, errors.Wrap(err, "could not parse backend config")
+	}
+
+	fields := map[string]interface{}{}
+	for name, value := range format.Map() {
+		fields[name] = value
+	}
+	jsonFormat, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert json format")
+	}
+
+	fileAccessLog := &access_loggers_file.FileAccessLog{
+		AccessLogFormat: &access_loggers_file.FileAccessLog_LogFormat{
+			LogFormat: &envoy_core.SubstitutionFormatString{
+				Format: &envoy_core.SubstitutionFormatString_JsonFormat{
+					JsonFormat: jsonFormat,
+				},
+			},
+		},
+		Path: cfg.Path,
+	}
+	marshaled, err := proto.MarshalAnyDeterministic(fileAccessLog)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshall %T", fileAccessLog)
+	}
+	return &envoy_accesslog.AccessLog{
+		Name: "envoy.access_loggers.file",
+		ConfigType: &envoy_accesslog.AccessLog_TypedConfig{
+			TypedConfig: marshaled,
+		},
+	}, nil
+}
+
+// convertLoggingFilter converts a TrafficLog filter into an Envoy access log filter.
+// A request is logged when it matches any of the conditions, otherwise it is sampled.
+func convertLoggingFilter(filter *mesh_proto.TrafficLog_Conf_Filter) *envoy_accesslog.AccessLogFilter {
+	if filter == nil {
+		return nil
+	}
+
+	var filters []*envoy_accesslog.AccessLogFilter
+	for _, statusCodes := range filter.StatusCodes {
+		// every range has its own runtime keys, so overriding one of them in the runtime does not affect other ranges
+		runtimeKeyPrefix := fmt.Sprintf("access_log.status_code.%d_%d", statusCodes.Min, statusCodes.Max)
+		filters = append(filters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &envoy_accesslog.AndFilter{
+					Filters: []*envoy_accesslog.AccessLogFilter{
+						statusCodeFilter(envoy_accesslog.ComparisonFilter_GE, statusCodes.Min, runtimeKeyPrefix+".min"),
+						statusCodeFilter(envoy_accesslog.ComparisonFilter_LE, statusCodes.Max, runtimeKeyPrefix+".max"),
+					},
+				},
+			},
+		})
+	}
+	if filter.MinDuration != nil {
+		filters = append(filters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_DurationFilter{
+				DurationFilter: &envoy_accesslog.DurationFilter{
+					Comparison: comparisonFilter(
+						envoy_accesslog.ComparisonFilter_GE,
+						uint32(filter.MinDuration.AsDuration().Milliseconds()),
+						"access_log.min_duration",
+					),
+				},
+			},
+		})
+	}
+	if len(filter.ResponseFlags) > 0 {
+		filters = append(filters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &envoy_accesslog.ResponseFlagFilter{
+					Flags: filter.ResponseFlags,
+				},
+			},
+		})
+	}
+	for _, header := range filter.Headers {
+		filters = append(filters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_HeaderFilter{
+				HeaderFilter: &envoy_accesslog.HeaderFilter{
+					Header: &envoy_route.HeaderMatcher{
+						Name: strings.ToLower(header),
+						HeaderMatchSpecifier: &envoy_route.HeaderMatcher_PresentMatch{
+							PresentMatch: true,
+						},
+					},
+				},
+			},
+		})
+	}
+	if filter.Sampling != nil {
+		filters = append(filters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &envoy_accesslog.RuntimeFilter{
+					RuntimeKey:     "access_log.sampling",
+					PercentSampled: ConvertPercentage(filter.Sampling),
+				},
+			},
+		})
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	default:
+		return &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_OrFilter{
+				OrFilter: &envoy_accesslog.OrFilter{
+					Filters: filters,
+				},
+			},
+		}
+	}
+}
+
+func statusCodeFilter(op envoy_accesslog.ComparisonFilter_Op, value uint32, runtimeKey string) *envoy_accesslog.AccessLogFilter {
+	return &envoy_accesslog.AccessLogFilter{
+		FilterSpecifier: &envoy_accesslog.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &envoy_accesslog.StatusCodeFilter{
+				Comparison: comparisonFilter(op, value, runtimeKey),
+			},
+		},
+	}
+}
+
+func comparisonFilter(op envoy_accesslog.ComparisonFilter_Op, value uint32, runtimeKey string) *envoy_accesslog.ComparisonFilter {
+	return &envoy_accesslog.ComparisonFilter{
+		Op: op,
+		Value: &envoy_core.RuntimeUInt32{
+			DefaultValue: value,
+			RuntimeKey:   runtimeKey,
+		},
+	}
+}
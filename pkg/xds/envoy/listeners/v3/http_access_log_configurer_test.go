@@ -1,6 +1,8 @@
 package v3_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -22,6 +24,8 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 		statsName        string
 		routeName        string
 		backend          *mesh_proto.LoggingBackend
+		filter           *mesh_proto.TrafficLog_Conf_Filter
+		kumaDpVersion    string
 		expected         string
 	}
 
@@ -42,6 +46,7 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 								ServicePort: 8080,
 								Tags: map[string]string{
 									"kuma.io/service": "web",
+									"kuma.io/zone":    "zone-1",
 								},
 							}},
 							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{{
@@ -51,6 +56,13 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 						},
 					},
 				},
+				Metadata: &core_xds.DataplaneMetadata{
+					Version: &mesh_proto.Version{
+						KumaDp: &mesh_proto.KumaDpVersion{
+							Version: given.kumaDpVersion,
+						},
+					},
+				},
 			}
 
 			// when
@@ -58,7 +70,7 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 				Configure(OutboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy.APIV3).
 					Configure(HttpConnectionManager(given.statsName, false)).
-					Configure(HttpAccessLog(mesh, envoy.TrafficDirectionOutbound, sourceService, destinationService, given.backend, proxy)))).
+					Configure(HttpAccessLog(mesh, envoy.TrafficDirectionOutbound, sourceService, destinationService, given.backend, given.filter, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -129,6 +141,226 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                   - name: envoy.filters.http.router
                   statPrefix: backend
             name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with file access log and filter", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "file",
+				Type:   mesh_proto.LoggingFileType,
+				Format: "%RESPONSE_CODE%",
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			filter: &mesh_proto.TrafficLog_Conf_Filter{
+				StatusCodes: []*mesh_proto.TrafficLog_Conf_Filter_StatusCodeRange{
+					{
+						Min: 500,
+						Max: 599,
+					},
+					{
+						Min: 429,
+						Max: 429,
+					},
+				},
+				MinDuration:   util_proto.Duration(2 * time.Second),
+				ResponseFlags: []string{"UH"},
+				Headers:       []string{"X-Debug"},
+				Sampling:      util_proto.Double(1.5),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    filter:
+                      orFilter:
+                        filters:
+                        - andFilter:
+                            filters:
+                            - statusCodeFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 500
+                                    runtimeKey: access_log.status_code.500_599.min
+                            - statusCodeFilter:
+                                comparison:
+                                  op: LE
+                                  value:
+                                    defaultValue: 599
+                                    runtimeKey: access_log.status_code.500_599.max
+                        - andFilter:
+                            filters:
+                            - statusCodeFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 429
+                                    runtimeKey: access_log.status_code.429_429.min
+                            - statusCodeFilter:
+                                comparison:
+                                  op: LE
+                                  value:
+                                    defaultValue: 429
+                                    runtimeKey: access_log.status_code.429_429.max
+                        - durationFilter:
+                            comparison:
+                              op: GE
+                              value:
+                                defaultValue: 2000
+                                runtimeKey: access_log.min_duration
+                        - responseFlagFilter:
+                            flags:
+                            - UH
+                        - headerFilter:
+                            header:
+                              name: x-debug
+                              presentMatch: true
+                        - runtimeFilter:
+                            percentSampled:
+                              denominator: TEN_THOUSAND
+                              numerator: 15000
+                            runtimeKey: access_log.sampling
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        textFormatSource:
+                          inlineString: |+
+                            %RESPONSE_CODE%
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with file access log in json format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				Type: mesh_proto.LoggingFileType,
+				JsonFormat: map[string]string{
+					"start_time":  "%START_TIME%",
+					"route":       "%KUMA_SOURCE_SERVICE% -> %KUMA_DESTINATION_SERVICE%",
+					"mesh":        "%KUMA_MESH%",
+					"user_agent":  "%REQ(USER-AGENT)%",
+					"status_code": "%RESPONSE_CODE%",
+				},
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        jsonFormat:
+                          mesh: demo
+                          route: web -> backend
+                          start_time: '%START_TIME%'
+                          status_code: '%RESPONSE_CODE%'
+                          user_agent: '%REQ(user-agent)%'
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with otel access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "otel",
+				Format: `%KUMA_SOURCE_SERVICE% "%REQ(USER-AGENT)%" %RESPONSE_CODE% %DURATION% %RESPONSE_CODE%`,
+				Type:   mesh_proto.LoggingOtelType,
+				Conf: util_proto.MustToStruct(&mesh_proto.OtelLoggingBackendConfig{
+					Address: "otel-collector:4317",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.open_telemetry
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.open_telemetry.v3.OpenTelemetryAccessLogConfig
+                      attributes:
+                        values:
+                        - key: kuma.mesh
+                          value:
+                            stringValue: demo
+                        - key: kuma.source_service
+                          value:
+                            stringValue: web
+                        - key: kuma.destination_service
+                          value:
+                            stringValue: backend
+                        - key: kuma.traffic_direction
+                          value:
+                            stringValue: OUTBOUND
+                        - key: kuma.zone
+                          value:
+                            stringValue: zone-1
+                        - key: req(user-agent)
+                          value:
+                            stringValue: '%REQ(user-agent)%'
+                        - key: response_code
+                          value:
+                            stringValue: '%RESPONSE_CODE%'
+                        - key: duration
+                          value:
+                            stringValue: '%DURATION%'
+                      body:
+                        stringValue: web "%REQ(user-agent)%" %RESPONSE_CODE% %DURATION% %RESPONSE_CODE%
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: logging:otel
+                        logName: otel
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
             trafficDirection: OUTBOUND`,
 		}),
 		Entry("basic http_connection_manager with tcp access log", testCase{
@@ -181,6 +413,107 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                   - name: envoy.filters.http.router
                   statPrefix: backend
             name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with tcp access log in json format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "tcp",
+				Type: mesh_proto.LoggingTcpType,
+				JsonFormat: map[string]string{
+					"source":      "%KUMA_SOURCE_SERVICE%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+					"origin":      "%REQ(ORIGIN)%",
+					"server":      "%RESP(SERVER)%",
+				},
+				Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+					Address: "127.0.0.1:1234",
+				}),
+			},
+			kumaDpVersion: "1.6.0",
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      additionalResponseHeadersToLog:
+                      - server
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: 'json;127.0.0.1:1234;{"destination":"backend","origin":"%REQ(origin)%","server":"%RESP(server)%","source":"web"}'
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with tcp access log in json format and old kuma-dp", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "tcp",
+				Type: mesh_proto.LoggingTcpType,
+				JsonFormat: map[string]string{
+					"source":      "%KUMA_SOURCE_SERVICE%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+					"origin":      "%REQ(ORIGIN)%",
+					"server":      "%RESP(SERVER)%",
+				},
+				Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+					Address: "127.0.0.1:1234",
+				}),
+			},
+			kumaDpVersion: "1.5.0",
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      additionalResponseHeadersToLog:
+                      - server
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: |
+                          127.0.0.1:1234;{"destination":"backend","origin":"%REQ(origin)%","server":"%RESP(server)%","source":"web"}
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
             trafficDirection: OUTBOUND`,
 		}),
 	)
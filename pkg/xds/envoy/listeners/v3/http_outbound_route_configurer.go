@@ -24,7 +24,8 @@ func (c *HttpOutboundRouteConfigurer) Configure(filterChain *envoy_listener.Filt
 			Configure(envoy_routes.TagsHeader(c.DpTags)).
 			Configure(envoy_routes.VirtualHost(envoy_routes.NewVirtualHostBuilder(envoy_common.APIV3).
 				Configure(envoy_routes.CommonVirtualHost(c.Service)).
-				Configure(envoy_routes.Routes(c.Routes)))),
+				Configure(envoy_routes.Routes(c.Routes)).
+				Configure(envoy_routes.VirtualClusters()))),
 	}
 
 	return static.Configure(filterChain)
@@ -12,7 +12,7 @@ type NetworkAccessLogConfigurer struct {
 }
 
 func (c *NetworkAccessLogConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	accessLog, err := convertLoggingBackend(c.AccessLogConfigurer.Mesh, c.AccessLogConfigurer.TrafficDirection, c.AccessLogConfigurer.SourceService, c.AccessLogConfigurer.DestinationService, c.AccessLogConfigurer.Backend, c.AccessLogConfigurer.Proxy, defaultNetworkAccessLogFormat)
+	accessLog, err := c.AccessLogConfigurer.accessLog(defaultNetworkAccessLogFormat)
 	if err != nil {
 		return err
 	}
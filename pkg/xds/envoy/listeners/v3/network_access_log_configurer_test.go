@@ -22,6 +22,7 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
 		statsName        string
 		clusters         []envoy_common.Cluster
 		backend          *mesh_proto.LoggingBackend
+		filter           *mesh_proto.TrafficLog_Conf_Filter
 		expected         string
 	}
 
@@ -58,7 +59,7 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
 				Configure(OutboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
 					Configure(TcpProxy(given.statsName, given.clusters...)).
-					Configure(NetworkAccessLog(meshName, envoy_common.TrafficDirectionUnspecified, sourceService, destinationService, given.backend, proxy)))).
+					Configure(NetworkAccessLog(meshName, envoy_common.TrafficDirectionUnspecified, sourceService, destinationService, given.backend, given.filter, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -133,6 +134,54 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                   cluster: db
                   statPrefix: db
             name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic tcp_proxy with file access log and filter", testCase{
+			listenerName:    "outbound:127.0.0.1:5432",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    5432,
+			statsName:       "db",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("db"),
+				envoy_common.WithWeight(200),
+			)},
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				Type: mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			filter: &mesh_proto.TrafficLog_Conf_Filter{
+				ResponseFlags: []string{"UF", "UO"},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    filter:
+                      responseFlagFilter:
+                        flags:
+                        - UF
+                        - UO
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        textFormatSource:
+                          inlineString: |+
+                            [%START_TIME%] %RESPONSE_FLAGS% demo 192.168.0.1(backend)->%UPSTREAM_HOST%(db) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes
+                      path: /tmp/log
+                  cluster: db
+                  statPrefix: db
+            name: outbound:127.0.0.1:5432
             trafficDirection: OUTBOUND`,
 		}),
 		Entry("basic tcp_proxy with tcp access log", testCase{
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	xds_type "github.com/cncf/xds/go/xds/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const openTelemetryConfigTypeURL = "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig"
+
+// minOpenTelemetryTracerEnvoyVersion is the first version of Envoy with the OpenTelemetry tracer.
+var minOpenTelemetryTracerEnvoyVersion = semver.MustParse("1.23.0")
+
+// supportsOpenTelemetryTracer returns true if the Envoy of the dataplane has the OpenTelemetry tracer.
+// Dataplanes that don't report the version are considered as old ones.
+func supportsOpenTelemetryTracer(envoyVersion *mesh_proto.EnvoyVersion) bool {
+	version := strings.SplitN(envoyVersion.GetVersion(), "-", 2)[0]
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return !v.LessThan(minOpenTelemetryTracerEnvoyVersion)
+}
+
+// openTelemetryConfigAny returns OpenTelemetryConfig of Envoy
+// (https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/trace/v3/opentelemetry.proto).
+// go-control-plane that we depend on predates the tracer, so the config is passed as TypedStruct,
+// which Envoy converts to the message of the type URL.
+func openTelemetryConfigAny(clusterName string, serviceName string) (*anypb.Any, error) {
+	value, err := structpb.NewStruct(map[string]interface{}{
+		"grpc_service": map[string]interface{}{
+			"envoy_grpc": map[string]interface{}{
+				"cluster_name": clusterName,
+			},
+		},
+		"service_name": serviceName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util_proto.MarshalAnyDeterministic(&xds_type.TypedStruct{
+		TypeUrl: openTelemetryConfigTypeURL,
+		Value:   value,
+	})
+}
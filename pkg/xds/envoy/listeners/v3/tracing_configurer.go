@@ -2,10 +2,12 @@ package v3
 
 import (
 	net_url "net/url"
+	"strings"
 
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_trace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -19,8 +21,15 @@ type TracingConfigurer struct {
 	Backend *mesh_proto.TracingBackend
 
 	// Opaque string which envoy will assign to tracer collector cluster, on those
-	// which support association of named "service" tags on traces. Consumed by datadog.
+	// which support association of named "service" tags on traces. Consumed by datadog
+	// and opentelemetry.
 	Service string
+
+	// Tags of the dataplane. Consumed by opentelemetry, which attaches them to spans.
+	Tags mesh_proto.MultiValueTagSet
+
+	// EnvoyVersion of the dataplane. The opentelemetry tracer is configured only for Envoy that has it.
+	EnvoyVersion *mesh_proto.EnvoyVersion
 }
 
 var _ FilterChainConfigurer = &TracingConfigurer{}
@@ -29,6 +38,9 @@ func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 	if c.Backend == nil {
 		return nil
 	}
+	if c.Backend.Type == mesh_proto.TracingOpenTelemetryType && !supportsOpenTelemetryTracer(c.EnvoyVersion) {
+		return nil
+	}
 
 	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
 		hcm.Tracing = &envoy_hcm.HttpConnectionManager_Tracing{}
@@ -50,6 +62,13 @@ func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 				return err
 			}
 			hcm.Tracing.Provider = tracing
+		case mesh_proto.TracingOpenTelemetryType:
+			tracing, err := openTelemetryConfig(c.Backend.Name, c.Service)
+			if err != nil {
+				return err
+			}
+			hcm.Tracing.Provider = tracing
+			hcm.Tracing.CustomTags = tagsToCustomTags(c.Tags)
 		}
 		return nil
 	})
@@ -78,6 +97,37 @@ func datadogConfig(cfgStr *structpb.Struct, backendName string, serviceName stri
 	return tracingConfig, nil
 }
 
+func openTelemetryConfig(backendName string, serviceName string) (*envoy_trace.Tracing_Http, error) {
+	otelConfigAny, err := openTelemetryConfigAny(names.GetTracingClusterName(backendName), serviceName)
+	if err != nil {
+		return nil, err
+	}
+	tracingConfig := &envoy_trace.Tracing_Http{
+		Name: "envoy.tracers.opentelemetry",
+		ConfigType: &envoy_trace.Tracing_Http_TypedConfig{
+			TypedConfig: otelConfigAny,
+		},
+	}
+	return tracingConfig, nil
+}
+
+// tagsToCustomTags turns tags of the dataplane into literal span attributes.
+// Tags with multiple values are joined with a comma.
+func tagsToCustomTags(tags mesh_proto.MultiValueTagSet) []*envoy_tracing.CustomTag {
+	var customTags []*envoy_tracing.CustomTag
+	for _, key := range tags.Keys() {
+		customTags = append(customTags, &envoy_tracing.CustomTag{
+			Tag: key,
+			Type: &envoy_tracing.CustomTag_Literal_{
+				Literal: &envoy_tracing.CustomTag_Literal{
+					Value: strings.Join(tags.Values(key), ","),
+				},
+			},
+		})
+	}
+	return customTags
+}
+
 func zipkinConfig(cfgStr *structpb.Struct, backendName string) (*envoy_trace.Tracing_Http, error) {
 	cfg := mesh_proto.ZipkinTracingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
@@ -14,8 +14,10 @@ import (
 var _ = Describe("TracingConfigurer", func() {
 
 	type testCase struct {
-		backend  *mesh_proto.TracingBackend
-		expected string
+		backend      *mesh_proto.TracingBackend
+		tags         mesh_proto.MultiValueTagSet
+		envoyVersion string
+		expected     string
 	}
 
 	DescribeTable("should generate proper Envoy config",
@@ -25,7 +27,7 @@ var _ = Describe("TracingConfigurer", func() {
 				Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080, xds.SocketAddressProtocolTCP)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy.APIV3).
 					Configure(HttpConnectionManager("localhost:8080", false)).
-					Configure(Tracing(given.backend, "service")))).
+					Configure(Tracing(given.backend, "service", given.tags, &mesh_proto.EnvoyVersion{Version: given.envoyVersion})))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -137,6 +139,80 @@ var _ = Describe("TracingConfigurer", func() {
         name: inbound:192.168.0.1:8080
         trafficDirection: INBOUND`,
 		}),
+		Entry("opentelemetry backend specified", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "otel",
+				Type: mesh_proto.TracingOpenTelemetryType,
+				Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryTracingBackendConfig{
+					Service: "otel-collector",
+				}),
+			},
+			tags: mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"service"},
+				"version":         {"v1", "v2"},
+			}),
+			envoyVersion: "1.23.0",
+			expected: `
+        address:
+          socketAddress:
+            address: 192.168.0.1
+            portValue: 8080
+        filterChains:
+        - filters:
+          - name: envoy.filters.network.http_connection_manager
+            typedConfig:
+              '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+              httpFilters:
+              - name: envoy.filters.http.router
+              statPrefix: localhost_8080
+              tracing:
+                customTags:
+                - literal:
+                    value: service
+                  tag: kuma.io/service
+                - literal:
+                    value: v1,v2
+                  tag: version
+                provider:
+                  name: envoy.tracers.opentelemetry
+                  typedConfig:
+                    '@type': type.googleapis.com/xds.type.v3.TypedStruct
+                    typeUrl: type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig
+                    value:
+                      grpc_service:
+                        envoy_grpc:
+                          cluster_name: tracing:otel
+                      service_name: service
+        name: inbound:192.168.0.1:8080
+        trafficDirection: INBOUND`,
+		}),
+
+		Entry("opentelemetry backend specified for Envoy without the tracer", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "otel",
+				Type: mesh_proto.TracingOpenTelemetryType,
+				Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryTracingBackendConfig{
+					Service: "otel-collector",
+				}),
+			},
+			envoyVersion: "1.21.1",
+			expected: `
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  statPrefix: localhost_8080
+                  httpFilters:
+                  - name: envoy.filters.http.router
+`,
+		}),
 
 		Entry("no backend specified", testCase{
 			backend: nil,
@@ -3,10 +3,14 @@ package names
 import (
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
 )
 
 // Separator is the separator used in resource names.
@@ -29,6 +33,43 @@ func GetSplitClusterName(service string, idx int) string {
 	return fmt.Sprintf("%s-_%d_", service, idx)
 }
 
+// GetSplitClusterStatName returns a name that is used in stats of a split cluster instead
+// of its name. Tags of the split (except for the service) end up in the "kuma_split" stats tag.
+func GetSplitClusterStatName(service string, tags map[string]string) string {
+	var keys []string
+	for key := range tags {
+		if key != mesh_proto.ServiceTag {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, util_xds.SanitizeMetric(key)+"-"+util_xds.SanitizeMetric(tags[key]))
+	}
+	return fmt.Sprintf("%s.split.%s", util_xds.SanitizeMetric(service), strings.Join(pairs, "_"))
+}
+
+// GetTrafficRouteRuleName returns a name of the route generated for the given rule of TrafficRoute.
+// The name ends up in the "kuma_route" stats tag.
+func GetTrafficRouteRuleName(trafficRoute string, idx int) string {
+	return fmt.Sprintf("trafficroute-%s-rule-%d", util_xds.SanitizeMetric(trafficRoute), idx)
+}
+
+// GetTrafficRouteDefaultName returns a name of the route generated for the default destination of TrafficRoute.
+// The name ends up in the "kuma_route" stats tag.
+func GetTrafficRouteDefaultName(trafficRoute string) string {
+	return fmt.Sprintf("trafficroute-%s-default", util_xds.SanitizeMetric(trafficRoute))
+}
+
+// GetMeshGatewayRouteName returns a name of the idx-th route of a virtual host generated for MeshGatewayRoute.
+// A single MeshGatewayRoute generates many routes (for every rule and match), so they are numbered.
+// The name ends up in the "kuma_route" stats tag.
+func GetMeshGatewayRouteName(route string, idx int) string {
+	return fmt.Sprintf("meshgatewayroute-%s-%d", util_xds.SanitizeMetric(route), idx)
+}
+
 func GetPortForLocalClusterName(cluster string) (uint32, error) {
 	parts := strings.Split(cluster, Separator)
 	if len(parts) != 2 {
@@ -79,6 +120,10 @@ func GetTracingClusterName(backendName string) string {
 	return Join("tracing", backendName)
 }
 
+func GetLoggingClusterName(backendName string) string {
+	return Join("logging", backendName)
+}
+
 func GetDNSListenerName() string {
 	return Join("kuma", "dns")
 }
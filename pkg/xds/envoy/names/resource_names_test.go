@@ -53,4 +53,26 @@ var _ = Describe("ListenerFilterChainConfigurer", func() {
 		}),
 	)
 
+	DescribeTable("GetSplitClusterStatName",
+		func(tags map[string]string, expected string) {
+			// expect
+			Expect(names.GetSplitClusterStatName("backend_kuma-demo_svc_8080", tags)).To(Equal(expected))
+		},
+		Entry("single tag", map[string]string{
+			"kuma.io/service": "backend_kuma-demo_svc_8080",
+			"version":         "v2",
+		}, "backend_kuma-demo_svc_8080.split.version-v2"),
+		Entry("multiple tags", map[string]string{
+			"kuma.io/service": "backend_kuma-demo_svc_8080",
+			"version":         "1.0",
+			"kuma.io/zone":    "east",
+		}, "backend_kuma-demo_svc_8080.split.kuma_io_zone-east_version-1_0"),
+	)
+
+	It("should generate route names that are valid stat names", func() {
+		// expect
+		Expect(names.GetTrafficRouteRuleName("route-all.default", 1)).To(Equal("trafficroute-route-all_default-rule-1"))
+		Expect(names.GetTrafficRouteDefaultName("route-all")).To(Equal("trafficroute-route-all-default"))
+		Expect(names.GetMeshGatewayRouteName("echo.example", 1)).To(Equal("meshgatewayroute-echo_example-1"))
+	})
 })
@@ -5,6 +5,9 @@ import (
 )
 
 type Route struct {
+	// Name identifies the route in Envoy stats. If not empty, a virtual
+	// cluster of the same name is generated for the route.
+	Name      string
 	Match     *mesh_proto.TrafficRoute_Http_Match
 	Modify    *mesh_proto.TrafficRoute_Http_Modify
 	RateLimit *mesh_proto.RateLimit
@@ -38,6 +38,12 @@ func Routes(routes envoy_common.Routes) VirtualHostBuilderOpt {
 		})
 }
 
+// VirtualClusters generates virtual clusters for named routes of the virtual host.
+// It has to be configured after the routes.
+func VirtualClusters() VirtualHostBuilderOpt {
+	return AddVirtualHostConfigurer(&v3.VirtualClustersConfigurer{})
+}
+
 // Redirect for paths that match to matchPath returns 301 status code with new port and path
 func Redirect(matchPath, newPath string, allowGetOnly bool, port uint32) VirtualHostBuilderOpt {
 	return AddVirtualHostConfigurer(&v3.RedirectConfigurer{
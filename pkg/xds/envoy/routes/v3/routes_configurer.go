@@ -21,6 +21,7 @@ type RoutesConfigurer struct {
 func (c RoutesConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
 	for _, route := range c.Routes {
 		envoyRoute := &envoy_route.Route{
+			Name:  route.Name,
 			Match: c.routeMatch(route.Match),
 			Action: &envoy_route.Route_Route{
 				Route: c.routeAction(route.Clusters, route.Modify),
@@ -0,0 +1,75 @@
+package v3
+
+import (
+	"regexp"
+
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/pkg/errors"
+)
+
+// queryString matches an optional query string, because the ":path" header
+// contains it while route path matchers ignore it.
+const queryString = `(\?.*)?`
+
+// VirtualClustersConfigurer generates a virtual cluster for every named route
+// of the virtual host, so Envoy emits request stats per route. Virtual clusters
+// are matched in the same order as routes, but only by the path and headers.
+type VirtualClustersConfigurer struct {
+}
+
+func (c VirtualClustersConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	names := map[string]bool{}
+	for _, route := range virtualHost.Routes {
+		if route.Name == "" {
+			continue
+		}
+		// stats of virtual clusters with the same name would be mixed up
+		if names[route.Name] {
+			return errors.Errorf("route name %q is not unique in the virtual host %q", route.Name, virtualHost.Name)
+		}
+		names[route.Name] = true
+		var headers []*envoy_route.HeaderMatcher
+		if path := c.pathMatcher(route.GetMatch()); path != nil {
+			headers = append(headers, path)
+		}
+		headers = append(headers, route.GetMatch().GetHeaders()...)
+		virtualHost.VirtualClusters = append(virtualHost.VirtualClusters, &envoy_route.VirtualCluster{
+			Name:    route.Name,
+			Headers: headers,
+		})
+	}
+	return nil
+}
+
+func (c VirtualClustersConfigurer) pathMatcher(match *envoy_route.RouteMatch) *envoy_route.HeaderMatcher {
+	switch match.GetPathSpecifier().(type) {
+	case *envoy_route.RouteMatch_Prefix:
+		return &envoy_route.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoy_route.HeaderMatcher_PrefixMatch{
+				PrefixMatch: match.GetPrefix(),
+			},
+		}
+	case *envoy_route.RouteMatch_Path:
+		return c.regexPathMatcher(regexp.QuoteMeta(match.GetPath()) + queryString)
+	case *envoy_route.RouteMatch_SafeRegex:
+		return c.regexPathMatcher("(" + match.GetSafeRegex().GetRegex() + ")" + queryString)
+	default:
+		return nil
+	}
+}
+
+func (c VirtualClustersConfigurer) regexPathMatcher(regex string) *envoy_route.HeaderMatcher {
+	return &envoy_route.HeaderMatcher{
+		Name: ":path",
+		HeaderMatchSpecifier: &envoy_route.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: &envoy_type_matcher.RegexMatcher{
+				EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+					GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+				},
+				Regex: regex,
+			},
+		},
+	}
+}
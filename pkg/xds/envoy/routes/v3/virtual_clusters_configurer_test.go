@@ -0,0 +1,127 @@
+package v3_test
+
+import (
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+var _ = Describe("VirtualClustersConfigurer", func() {
+
+	type testCase struct {
+		routes   envoy_common.Routes
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			virtualHost := &envoy_config_route_v3.VirtualHost{}
+			err := envoy_routes.RoutesConfigurer{Routes: given.routes}.
+				Configure(virtualHost)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = envoy_routes.VirtualClustersConfigurer{}.Configure(virtualHost)
+			Expect(err).ToNot(HaveOccurred())
+			// and only virtual clusters are compared
+			virtualHost.Routes = nil
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("unnamed routes", testCase{
+			routes: []envoy_common.Route{
+				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(envoy_common.WithName("backend"))),
+			},
+			expected: `{}`,
+		}),
+		Entry("named routes", testCase{
+			routes: []envoy_common.Route{
+				{
+					Name: "trafficroute-web-rule-0",
+					Match: &mesh_proto.TrafficRoute_Http_Match{
+						Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact{
+								Exact: "/api.v1",
+							},
+						},
+						Method: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact{
+								Exact: "GET",
+							},
+						},
+					},
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+				},
+				{
+					Name: "trafficroute-web-rule-1",
+					Match: &mesh_proto.TrafficRoute_Http_Match{
+						Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex{
+								Regex: "/users/[0-9]+",
+							},
+						},
+					},
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+				},
+				{
+					Name:     "trafficroute-web-default",
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+				},
+			},
+			expected: `
+virtualClusters:
+- name: trafficroute-web-rule-0
+  headers:
+  - name: :path
+    safeRegexMatch:
+      googleRe2: {}
+      regex: /api\.v1(\?.*)?
+  - name: :method
+    exactMatch: GET
+- name: trafficroute-web-rule-1
+  headers:
+  - name: :path
+    safeRegexMatch:
+      googleRe2: {}
+      regex: (/users/[0-9]+)(\?.*)?
+- name: trafficroute-web-default
+  headers:
+  - name: :path
+    prefixMatch: /
+`,
+		}),
+	)
+
+	It("should reject routes with the same name", func() {
+		// given
+		virtualHost := &envoy_config_route_v3.VirtualHost{Name: "backend"}
+		err := envoy_routes.RoutesConfigurer{Routes: []envoy_common.Route{
+			{
+				Name:     "trafficroute-web-default",
+				Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+			},
+			{
+				Name:     "trafficroute-web-default",
+				Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("httpbin"))},
+			},
+		}}.Configure(virtualHost)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = envoy_routes.VirtualClustersConfigurer{}.Configure(virtualHost)
+
+		// then
+		Expect(err).To(MatchError(`route name "trafficroute-web-default" is not unique in the virtual host "backend"`))
+	})
+})
@@ -5,23 +5,46 @@ import (
 
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/xds/envoy/names"
 	"github.com/kumahq/kuma/pkg/xds/envoy/tls"
 )
 
+// onlyVerifyLeafCertCrlField is the number of "only_verify_leaf_cert_crl" field of CertificateValidationContext.
+// The field is supported since Envoy 1.19, but it is missing in the version of go-control-plane that we use.
+const onlyVerifyLeafCertCrlField = 14
+
+// setOnlyVerifyLeafCertCrl makes Envoy check only the list of the CA that issued the certificate of the peer.
+// Otherwise, Envoy requires a list of every CA in the chain, which can't be signed when the CA of the Mesh
+// is an intermediate CA of an external root.
+func setOnlyVerifyLeafCertCrl(validationContext *envoy_auth.CertificateValidationContext) {
+	field := protowire.AppendTag(nil, onlyVerifyLeafCertCrlField, protowire.VarintType)
+	field = protowire.AppendVarint(field, protowire.EncodeBool(true))
+	validationContext.ProtoReflect().SetUnknown(field)
+}
+
 func CreateCaSecret(secret *core_xds.CaSecret, mesh string) *envoy_auth.Secret {
+	validationContext := &envoy_auth.CertificateValidationContext{
+		TrustedCa: &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{
+				InlineBytes: bytes.Join(secret.PemCerts, []byte("\n")),
+			},
+		},
+	}
+	if len(secret.PemCrls) > 0 {
+		validationContext.Crl = &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{
+				InlineBytes: bytes.Join(secret.PemCrls, []byte("\n")),
+			},
+		}
+		setOnlyVerifyLeafCertCrl(validationContext)
+	}
 	return &envoy_auth.Secret{
 		Name: names.GetSecretName(tls.MeshCaResource, "secret", mesh),
 		Type: &envoy_auth.Secret_ValidationContext{
-			ValidationContext: &envoy_auth.CertificateValidationContext{
-				TrustedCa: &envoy_core.DataSource{
-					Specifier: &envoy_core.DataSource_InlineBytes{
-						InlineBytes: bytes.Join(secret.PemCerts, []byte("\n")),
-					},
-				},
-			},
+			ValidationContext: validationContext,
 		},
 	}
 }
@@ -0,0 +1,58 @@
+package v3_test
+
+import (
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	envoy_secrets "github.com/kumahq/kuma/pkg/xds/envoy/secrets/v3"
+)
+
+var _ = Describe("CreateCaSecret", func() {
+
+	// onlyVerifyLeafCertCrl returns the value of "only_verify_leaf_cert_crl" field of the marshaled validation context
+	onlyVerifyLeafCertCrl := func(secret *envoy_auth.Secret) bool {
+		bytes, err := proto.Marshal(secret.GetValidationContext())
+		Expect(err).ToNot(HaveOccurred())
+		for len(bytes) > 0 {
+			num, typ, n := protowire.ConsumeTag(bytes)
+			Expect(n).To(BeNumerically(">", 0))
+			bytes = bytes[n:]
+			if num == 14 && typ == protowire.VarintType {
+				value, n := protowire.ConsumeVarint(bytes)
+				Expect(n).To(BeNumerically(">", 0))
+				return protowire.DecodeBool(value)
+			}
+			n = protowire.ConsumeFieldValue(num, typ, bytes)
+			Expect(n).To(BeNumerically(">", 0))
+			bytes = bytes[n:]
+		}
+		return false
+	}
+
+	It("should check only the list of the CA that issued the peer certificate", func() {
+		// when
+		secret := envoy_secrets.CreateCaSecret(&core_xds.CaSecret{
+			PemCerts: [][]byte{[]byte("CERT")},
+			PemCrls:  [][]byte{[]byte("CRL-1"), []byte("CRL-2")},
+		}, "default")
+
+		// then
+		Expect(secret.GetValidationContext().GetCrl().GetInlineBytes()).To(Equal([]byte("CRL-1\nCRL-2")))
+		Expect(onlyVerifyLeafCertCrl(secret)).To(BeTrue())
+	})
+
+	It("should not set revocation options without revocation lists", func() {
+		// when
+		secret := envoy_secrets.CreateCaSecret(&core_xds.CaSecret{
+			PemCerts: [][]byte{[]byte("CERT")},
+		}, "default")
+
+		// then
+		Expect(secret.GetValidationContext().GetCrl()).To(BeNil())
+		Expect(onlyVerifyLeafCertCrl(secret)).To(BeFalse())
+	})
+})
@@ -0,0 +1,11 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestSecrets(t *testing.T) {
+	test.RunSpecs(t, "Envoy Secrets V3 Suite")
+}
@@ -56,6 +56,7 @@ func (_ DirectAccessProxyGenerator) Generate(ctx xds_context.Context, proxy *cor
 					sourceService,
 					name,
 					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[core_mesh.PassThroughService]),
+					proxy.Policies.TrafficLogs[core_mesh.PassThroughService].GetFilter(),
 					proxy,
 				)))).
 			Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+// OriginLogging is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginLogging = "logging"
+
+// LoggingProxyGenerator generates clusters for logging backends that Envoy
+// sends logs to directly, i.e. OpenTelemetry collectors. Other backends
+// either do not need a cluster (file) or use the kuma-dp access log sink (tcp).
+type LoggingProxyGenerator struct {
+}
+
+var _ ResourceGenerator = LoggingProxyGenerator{}
+
+func (g LoggingProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	backends := map[string]*mesh_proto.LoggingBackend{}
+	for _, trafficLog := range proxy.Policies.TrafficLogs {
+		backend := ctx.Mesh.GetLoggingBackend(trafficLog)
+		if backend == nil || backend.Type != mesh_proto.LoggingOtelType {
+			continue
+		}
+		backends[backend.Name] = backend
+	}
+	if len(backends) == 0 {
+		return nil, nil
+	}
+
+	var backendNames []string
+	for name := range backends {
+		backendNames = append(backendNames, name)
+	}
+	sort.Strings(backendNames)
+
+	resources := core_xds.NewResourceSet()
+	for _, name := range backendNames {
+		cfg := mesh_proto.OtelLoggingBackendConfig{}
+		if err := proto.ToTyped(backends[name].Conf, &cfg); err != nil {
+			return nil, errors.Wrap(err, "could not convert backend to otel")
+		}
+		endpoint, err := otlpCollectorEndpoint(cfg.Address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate cluster for logging backend %s", name)
+		}
+
+		clusterName := names.GetLoggingClusterName(name)
+		res, err := clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+			Configure(clusters.Http2()).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginLogging, Resource: res})
+	}
+	return resources, nil
+}
@@ -0,0 +1,112 @@
+package generator_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+)
+
+var _ = Describe("LoggingProxyGenerator", func() {
+
+	trafficLog := func(backend string) *core_mesh.TrafficLogResource {
+		return &core_mesh.TrafficLogResource{
+			Spec: &mesh_proto.TrafficLog{
+				Conf: &mesh_proto.TrafficLog_Conf{
+					Backend: backend,
+				},
+			},
+		}
+	}
+
+	meshCtx := xds_context.Context{Mesh: xds_context.MeshContext{
+		Resource: &core_mesh.MeshResource{Spec: &mesh_proto.Mesh{
+			Logging: &mesh_proto.Logging{
+				Backends: []*mesh_proto.LoggingBackend{
+					{
+						Name: "file",
+						Type: mesh_proto.LoggingFileType,
+						Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+							Path: "/tmp/access.log",
+						}),
+					},
+					{
+						Name: "otel",
+						Type: mesh_proto.LoggingOtelType,
+						Conf: util_proto.MustToStruct(&mesh_proto.OtelLoggingBackendConfig{
+							Address: "otel-collector.observability:4317",
+						}),
+					},
+				},
+			},
+		}},
+	}}
+
+	proxy := func(logs core_xds.TrafficLogMap) *core_xds.Proxy {
+		return &core_xds.Proxy{
+			Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "backend-01",
+					Mesh: "demo",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+			APIVersion: envoy_common.APIV3,
+			Policies: core_xds.MatchedPolicies{
+				TrafficLogs: logs,
+			},
+		}
+	}
+
+	It("should not generate resources without otel backend", func() {
+		// given
+		gen := generator.LoggingProxyGenerator{}
+
+		// when
+		rs, err := gen.Generate(meshCtx, proxy(core_xds.TrafficLogMap{
+			"web": trafficLog("file"),
+		}))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs).To(BeNil())
+	})
+
+	It("should generate a single cluster per otel backend", func() {
+		// given
+		gen := generator.LoggingProxyGenerator{}
+
+		// when
+		rs, err := gen.Generate(meshCtx, proxy(core_xds.TrafficLogMap{
+			"web":     trafficLog("otel"),
+			"backend": trafficLog("otel"),
+			"redis":   trafficLog("file"),
+		}))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := util_proto.ToYAML(resp)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and output matches golden files
+		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "logging", "otel.envoy-config.golden.yaml")))
+	})
+})
@@ -112,9 +112,9 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 		case core_mesh.ProtocolGRPC:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
-				Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), sourceService)).
+				Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), sourceService, proxy.Dataplane.Spec.TagSet(), proxy.Metadata.GetVersion().GetEnvoy())).
 				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_common.TrafficDirectionOutbound, sourceService, serviceName,
-					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[serviceName]), proxy)).
+					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[serviceName]), proxy.Policies.TrafficLogs[serviceName].GetFilter(), proxy)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
 				// backwards compatibility to support RateLimit for ExternalServices without ZoneEgress
 				ConfigureIf(!ctx.Mesh.Resource.ZoneEgressEnabled(), envoy_listeners.RateLimit(rateLimits)).
@@ -123,7 +123,7 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 		case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
-				Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), sourceService)).
+				Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), sourceService, proxy.Dataplane.Spec.TagSet(), proxy.Metadata.GetVersion().GetEnvoy())).
 				// backwards compatibility to support RateLimit for ExternalServices without ZoneEgress
 				ConfigureIf(!ctx.Mesh.Resource.ZoneEgressEnabled(), envoy_listeners.RateLimit(rateLimits)).
 				Configure(envoy_listeners.HttpAccessLog(
@@ -132,6 +132,7 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 					sourceService,
 					serviceName,
 					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[serviceName]),
+					proxy.Policies.TrafficLogs[serviceName].GetFilter(),
 					proxy,
 				)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
@@ -146,6 +147,7 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 					sourceService,
 					serviceName,
 					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[serviceName]),
+					proxy.Policies.TrafficLogs[serviceName].GetFilter(),
 					proxy,
 				)).
 				Configure(envoy_listeners.MaxConnectAttempts(retryPolicy))
@@ -162,6 +164,7 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 					sourceService,
 					serviceName,
 					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[serviceName]),
+					proxy.Policies.TrafficLogs[serviceName].GetFilter(),
 					proxy,
 				)).
 				Configure(envoy_listeners.MaxConnectAttempts(retryPolicy))
@@ -237,6 +240,12 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 					Configure(envoy_clusters.Http2())
 			}
 
+			// Split clusters are named by an index, which says nothing about the destination,
+			// so their stats are named by the tags of the destination instead.
+			if len(cluster.Tags()) > 1 {
+				edsClusterBuilder.Configure(envoy_clusters.AltStatName(envoy_names.GetSplitClusterStatName(serviceName, cluster.Tags())))
+			}
+
 			edsCluster, err := edsClusterBuilder.Build()
 			if err != nil {
 				return nil, errors.Wrapf(err, "build CDS for cluster %s failed", clusterName)
@@ -360,7 +369,7 @@ func (OutboundProxyGenerator) determineRoutes(
 		return clustersInternal, clustersExternal
 	}
 
-	appendRoute := func(routes envoy_common.Routes, match *mesh_proto.TrafficRoute_Http_Match, modify *mesh_proto.TrafficRoute_Http_Modify,
+	appendRoute := func(routes envoy_common.Routes, name string, match *mesh_proto.TrafficRoute_Http_Match, modify *mesh_proto.TrafficRoute_Http_Modify,
 		clusters []envoy_common.Cluster, rateLimit *core_mesh.RateLimitResource) envoy_common.Routes {
 		if len(clusters) == 0 {
 			return routes
@@ -369,6 +378,7 @@ func (OutboundProxyGenerator) determineRoutes(
 		// backwards compatibility to support RateLimit for ExternalServices without ZoneEgress
 		if hasEgress {
 			return append(routes, envoy_common.Route{
+				Name:     name,
 				Match:    match,
 				Modify:   modify,
 				Clusters: clusters,
@@ -379,6 +389,7 @@ func (OutboundProxyGenerator) determineRoutes(
 				rlSpec = rateLimit.Spec
 			}
 			return append(routes, envoy_common.Route{
+				Name:      name,
 				Match:     match,
 				Modify:    modify,
 				RateLimit: rlSpec,
@@ -387,17 +398,40 @@ func (OutboundProxyGenerator) determineRoutes(
 		}
 	}
 
-	for _, http := range route.Spec.GetConf().GetHttp() {
+	// routes are named after the TrafficRoute so their stats can be tagged per rule
+	var routeName string
+	if route.GetMeta() != nil {
+		routeName = route.GetMeta().GetName()
+	}
+
+	for i, http := range route.Spec.GetConf().GetHttp() {
+		var name string
+		if routeName != "" {
+			name = envoy_names.GetTrafficRouteRuleName(routeName, i)
+		}
 		clustersInternal, clustersExternal := clustersFromSplit(http.GetSplitWithDestination())
-		routes = appendRoute(routes, http.Match, http.Modify, clustersInternal, nil)
-		routes = appendRoute(routes, http.Match, http.Modify, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
+		routes = appendRoute(routes, name, http.Match, http.Modify, clustersInternal, nil)
+		routes = appendRoute(routes, externalRouteName(name), http.Match, http.Modify, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
 	}
 
 	if defaultDestination := route.Spec.GetConf().GetSplitWithDestination(); len(defaultDestination) != 0 {
+		var name string
+		if routeName != "" {
+			name = envoy_names.GetTrafficRouteDefaultName(routeName)
+		}
 		clustersInternal, clustersExternal := clustersFromSplit(defaultDestination)
-		routes = appendRoute(routes, nil, nil, clustersInternal, nil)
-		routes = appendRoute(routes, nil, nil, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
+		routes = appendRoute(routes, name, nil, nil, clustersInternal, nil)
+		routes = appendRoute(routes, externalRouteName(name), nil, nil, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
 	}
 
 	return routes
 }
+
+// externalRouteName returns a name of the route to ExternalServices, which is generated
+// next to the route to internal services for the same rule, so it needs a different name.
+func externalRouteName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return name + "-external"
+}
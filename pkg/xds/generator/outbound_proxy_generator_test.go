@@ -276,6 +276,10 @@ var _ = Describe("OutboundProxyGenerator", func() {
 							DataplaneIP:   "127.0.0.1",
 							DataplanePort: 54321,
 						}: &core_mesh.TrafficRouteResource{
+							Meta: &test_model.ResourceMeta{
+								Mesh: "mesh1",
+								Name: "route-db",
+							},
 							Spec: &mesh_proto.TrafficRoute{
 								Conf: &mesh_proto.TrafficRoute_Conf{
 									Split: []*mesh_proto.TrafficRoute_Split{{
@@ -364,6 +368,26 @@ var _ = Describe("OutboundProxyGenerator", func() {
 								},
 							},
 						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "240.0.0.3",
+							DataplanePort: 80,
+						}: &core_mesh.TrafficRouteResource{
+							Meta: &test_model.ResourceMeta{
+								Mesh: "mesh1",
+								Name: "route-mixed",
+							},
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Split: []*mesh_proto.TrafficRoute_Split{{
+										Weight:      util_proto.UInt32(50),
+										Destination: mesh_proto.MatchService("api-http"),
+									}, {
+										Weight:      util_proto.UInt32(50),
+										Destination: mesh_proto.TagSelector{"kuma.io/service": "es", "kuma.io/protocol": "http"},
+									}},
+								},
+							},
+						},
 					},
 					OutboundTargets: outboundTargets,
 				},
@@ -577,6 +601,26 @@ var _ = Describe("OutboundProxyGenerator", func() {
 `,
 			expected: "08.envoy.golden.yaml",
 		}),
+		Entry("09. TrafficRoute with internal and external services", testCase{
+			ctx: mtlsCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 80
+                address: 240.0.0.3
+                tags:
+                  kuma.io/service: api-http
+              transparentProxying:
+                redirectPortOutbound: 15001
+                redirectPortInbound: 15006
+`,
+			expected: "09.envoy.golden.yaml",
+		}),
 	)
 
 	It("Add sanitized alternative cluster name for stats", func() {
@@ -87,6 +87,7 @@ func NewDefaultProxyProfile() ResourceGenerator {
 		OutboundProxyGenerator{},
 		DirectAccessProxyGenerator{},
 		TracingProxyGenerator{},
+		LoggingProxyGenerator{},
 		ProbeProxyGenerator{},
 		DNSGenerator{},
 	}
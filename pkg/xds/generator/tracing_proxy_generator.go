@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"net"
 	net_url "net/url"
 	"strconv"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/kumahq/kuma/pkg/util/proto"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	envoy_endpoints "github.com/kumahq/kuma/pkg/xds/envoy/endpoints"
 	"github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
 
@@ -28,6 +30,7 @@ func (t TracingProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 		return nil, nil
 	}
 	resources = core_xds.NewResourceSet()
+	clusterName := names.GetTracingClusterName(tracingBackend.Name)
 	var endpoint *core_xds.Endpoint
 	switch tracingBackend.Type {
 	case mesh_proto.TracingZipkinType:
@@ -48,9 +51,29 @@ func (t TracingProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 		if err != nil {
 			return nil, errors.Wrap(err, "could not generate datadog cluster")
 		}
+	case mesh_proto.TracingOpenTelemetryType:
+		cfg := mesh_proto.OpenTelemetryTracingBackendConfig{}
+		if err = proto.ToTyped(tracingBackend.Conf, &cfg); err != nil {
+			return nil, errors.Wrap(err, "could not convert backend to opentelemetry")
+		}
+		if cfg.Service != "" {
+			return t.openTelemetryServiceResources(ctx, proxy, clusterName, cfg.Service)
+		}
+		endpoint, err = otlpCollectorEndpoint(cfg.Address)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate opentelemetry cluster")
+		}
+		res, err := clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+			Configure(clusters.Http2()).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginTracing, Resource: res})
+		return resources, nil
 	}
 
-	clusterName := names.GetTracingClusterName(tracingBackend.Name)
 	res, err := clusters.NewClusterBuilder(proxy.APIVersion).
 		Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
 		Configure(clusters.ClientSideTLS([]core_xds.Endpoint{*endpoint})).
@@ -90,3 +113,56 @@ func (t TracingProxyGenerator) endpointForDatadog(cfg *mesh_proto.DatadogTracing
 		Port:   cfg.Port,
 	}, nil
 }
+
+// otlpCollectorEndpoint returns an endpoint of the OpenTelemetry collector
+// outside of the mesh, given in host:port format.
+func otlpCollectorEndpoint(address string) (*core_xds.Endpoint, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address of OpenTelemetry collector")
+	}
+	port, err := strconv.ParseUint(portStr, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid port of OpenTelemetry collector")
+	}
+	return &core_xds.Endpoint{
+		Target: host,
+		Port:   uint32(port),
+	}, nil
+}
+
+// openTelemetryServiceResources generates a cluster for the collector that is
+// a part of the mesh or an ExternalService. Spans are then sent the same way
+// as any other traffic to this service, e.g. with mTLS for a mesh service.
+func (t TracingProxyGenerator) openTelemetryServiceResources(
+	ctx xds_context.Context,
+	proxy *core_xds.Proxy,
+	clusterName string,
+	service string,
+) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	endpoints := proxy.Routing.OutboundTargets[service]
+
+	builder := clusters.NewClusterBuilder(proxy.APIVersion)
+	if len(endpoints) > 0 && endpoints[0].IsExternalService() {
+		builder.
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), endpoints...)).
+			Configure(clusters.ClientSideTLS(endpoints))
+	} else {
+		builder.
+			Configure(clusters.EdsCluster(clusterName)).
+			Configure(clusters.ClientSideMTLS(ctx.Mesh.Resource, service, ctx.Mesh.ServiceTLSReadiness[service], nil))
+
+		loadAssignment, err := envoy_endpoints.CreateClusterLoadAssignment(clusterName, endpoints, proxy.APIVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate ClusterLoadAssignment for %s", service)
+		}
+		resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginTracing, Resource: loadAssignment})
+	}
+	res, err := builder.Configure(clusters.Http2()).Build()
+	if err != nil {
+		return nil, err
+	}
+	resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginTracing, Resource: res})
+	return resources, nil
+}
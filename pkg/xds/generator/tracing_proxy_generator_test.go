@@ -197,5 +197,186 @@ var _ = Describe("TracingProxyGenerator", func() {
 			}},
 			expected: "zipkin.envoy-config-https.golden.yaml",
 		}),
+		Entry("should create cluster for OpenTelemetry collector address", testCase{
+			proxy: &core_xds.Proxy{
+				Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+				Policies: core_xds.MatchedPolicies{
+					TrafficTrace: &core_mesh.TrafficTraceResource{
+						Spec: &mesh_proto.TrafficTrace{
+							Conf: &mesh_proto.TrafficTrace_Conf{
+								Backend: "otel",
+							},
+						},
+					},
+				},
+			},
+			ctx: xds_context.Context{Mesh: xds_context.MeshContext{
+				Resource: &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "demo",
+					},
+					Spec: &mesh_proto.Mesh{
+						Tracing: &mesh_proto.Tracing{
+							Backends: []*mesh_proto.TracingBackend{
+								{
+									Name: "otel",
+									Type: mesh_proto.TracingOpenTelemetryType,
+									Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryTracingBackendConfig{
+										Address: "otel-collector.observability:4317",
+									}),
+								},
+							},
+						},
+					},
+				},
+			}},
+			expected: "opentelemetry.envoy-config-address.golden.yaml",
+		}),
+		Entry("should create cluster for OpenTelemetry collector in the mesh", testCase{
+			proxy: &core_xds.Proxy{
+				Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+				Policies: core_xds.MatchedPolicies{
+					TrafficTrace: &core_mesh.TrafficTraceResource{
+						Spec: &mesh_proto.TrafficTrace{
+							Conf: &mesh_proto.TrafficTrace_Conf{
+								Backend: "otel",
+							},
+						},
+					},
+				},
+				Routing: core_xds.Routing{
+					OutboundTargets: core_xds.EndpointMap{
+						"otel-collector": []core_xds.Endpoint{
+							{
+								Target: "192.168.0.10",
+								Port:   4317,
+								Tags: map[string]string{
+									mesh_proto.ServiceTag: "otel-collector",
+								},
+								Weight: 1,
+							},
+						},
+					},
+				},
+			},
+			ctx: xds_context.Context{Mesh: xds_context.MeshContext{
+				Resource: &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "demo",
+					},
+					Spec: &mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							EnabledBackend: "builtin",
+							Backends: []*mesh_proto.CertificateAuthorityBackend{
+								{
+									Name: "builtin",
+									Type: "builtin",
+								},
+							},
+						},
+						Tracing: &mesh_proto.Tracing{
+							Backends: []*mesh_proto.TracingBackend{
+								{
+									Name: "otel",
+									Type: mesh_proto.TracingOpenTelemetryType,
+									Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryTracingBackendConfig{
+										Service: "otel-collector",
+									}),
+								},
+							},
+						},
+					},
+				},
+			}},
+			expected: "opentelemetry.envoy-config-service.golden.yaml",
+		}),
+		Entry("should create cluster for OpenTelemetry collector as ExternalService", testCase{
+			proxy: &core_xds.Proxy{
+				Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+				Policies: core_xds.MatchedPolicies{
+					TrafficTrace: &core_mesh.TrafficTraceResource{
+						Spec: &mesh_proto.TrafficTrace{
+							Conf: &mesh_proto.TrafficTrace_Conf{
+								Backend: "otel",
+							},
+						},
+					},
+				},
+				Routing: core_xds.Routing{
+					OutboundTargets: core_xds.EndpointMap{
+						"otel-collector": []core_xds.Endpoint{
+							{
+								Target: "otel.example.com",
+								Port:   4317,
+								Tags: map[string]string{
+									mesh_proto.ServiceTag: "otel-collector",
+								},
+								Weight: 1,
+								ExternalService: &core_xds.ExternalService{
+									TLSEnabled: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			ctx: xds_context.Context{Mesh: xds_context.MeshContext{
+				Resource: &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "demo",
+					},
+					Spec: &mesh_proto.Mesh{
+						Tracing: &mesh_proto.Tracing{
+							Backends: []*mesh_proto.TracingBackend{
+								{
+									Name: "otel",
+									Type: mesh_proto.TracingOpenTelemetryType,
+									Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryTracingBackendConfig{
+										Service: "otel-collector",
+									}),
+								},
+							},
+						},
+					},
+				},
+			}},
+			expected: "opentelemetry.envoy-config-external-service.golden.yaml",
+		}),
 	)
 })
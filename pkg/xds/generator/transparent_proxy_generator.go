@@ -84,6 +84,7 @@ func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *mode
 				sourceService,
 				"external",
 				ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[core_mesh.PassThroughService]),
+				proxy.Policies.TrafficLogs[core_mesh.PassThroughService].GetFilter(),
 				proxy,
 			)))).
 		Configure(envoy_listeners.OriginalDstForwarder()).
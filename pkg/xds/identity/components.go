@@ -0,0 +1,23 @@
+package identity
+
+import (
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	auth_components "github.com/kumahq/kuma/pkg/xds/auth/components"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
+)
+
+// RegisterWorkloadIdentity exposes certificates of dataplanes, so kuma-dp can serve them to applications over SPIFFE Workload API.
+// It has to share Secrets with xDS, otherwise the dataplane would get different certificate than Envoy.
+func RegisterWorkloadIdentity(rt core_runtime.Runtime, secrets secrets.Secrets) error {
+	authenticator, err := auth_components.DefaultAuthenticator(rt)
+	if err != nil {
+		return err
+	}
+	handler := &Handler{
+		ResManager:    rt.ReadOnlyResourceManager(),
+		Authenticator: authenticator,
+		Secrets:       secrets,
+	}
+	rt.DpServer().HTTPMux().HandleFunc(Path, handler.Handle)
+	return nil
+}
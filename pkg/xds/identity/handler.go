@@ -0,0 +1,132 @@
+package identity
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
+)
+
+var log = core.Log.WithName("workload-identity")
+
+// Path of the DP Server on which kuma-dp fetches the identity of the dataplane for the SPIFFE Workload API.
+const Path = "/workload-identity"
+
+// maxRequestSize limits the size of the request, which is read before the dataplane is authenticated.
+const maxRequestSize = 64 * 1024
+
+// authorization header carries the same credential as the metadata of ADS stream.
+const authorization = "authorization"
+
+type WorkloadIdentityRequest struct {
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+}
+
+// WorkloadIdentityResponse contains the certificate of the dataplane taken from the cache of this instance of the control plane.
+// It's not necessarily the certificate delivered to Envoy over SDS (Envoy may be connected to another instance),
+// but it has the same identity and is signed by the same CA.
+type WorkloadIdentityResponse struct {
+	// CertChain is PEM encoded certificate chain, the dataplane certificate comes first.
+	CertChain []byte `json:"certChain"`
+	// PrivateKey is PEM encoded private key of the dataplane certificate.
+	PrivateKey []byte `json:"privateKey"`
+	// TrustBundle is PEM encoded certificates of all CAs trusted in the Mesh.
+	TrustBundle []byte `json:"trustBundle"`
+	// Crls are PEM encoded revocation lists of the CAs.
+	Crls []byte `json:"crls,omitempty"`
+}
+
+type Handler struct {
+	ResManager    manager.ReadOnlyResourceManager
+	Authenticator auth.Authenticator
+	Secrets       secrets.Secrets
+}
+
+func (h *Handler) Handle(resp http.ResponseWriter, req *http.Request) {
+	bytes, err := io.ReadAll(http.MaxBytesReader(resp, req.Body, maxRequestSize))
+	if err != nil {
+		log.Info("Could not read a request", "reason", err.Error())
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	request := WorkloadIdentityRequest{}
+	if err := json.Unmarshal(bytes, &request); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logger := log.WithValues("mesh", request.Mesh, "name", request.Name)
+
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := h.ResManager.Get(req.Context(), dataplane, core_store.GetByKey(request.Name, request.Mesh)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			writeError(resp, http.StatusNotFound, "dataplane not found", logger)
+			return
+		}
+		logger.Error(err, "Could not get a dataplane")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err := h.Authenticator.Authenticate(req.Context(), dataplane, req.Header.Get(authorization)); err != nil {
+		logger.Info("authentication failed", "reason", err.Error())
+		writeError(resp, http.StatusUnauthorized, "authentication failed", logger)
+		return
+	}
+
+	mesh := core_mesh.NewMeshResource()
+	if err := h.ResManager.Get(req.Context(), mesh, core_store.GetByKey(request.Mesh, core_model.NoMesh)); err != nil {
+		logger.Error(err, "Could not get a mesh")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if !mesh.MTLSEnabled() {
+		writeError(resp, http.StatusConflict, "mTLS is not enabled in the mesh", logger)
+		return
+	}
+
+	identity, ca, err := h.Secrets.GetForDataPlane(dataplane, mesh)
+	if err != nil {
+		logger.Error(err, "Could not get certificates of a dataplane")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	response := WorkloadIdentityResponse{
+		CertChain:   concat(identity.PemCerts),
+		PrivateKey:  identity.PemKey,
+		TrustBundle: concat(ca.PemCerts),
+		Crls:        concat(ca.PemCrls),
+	}
+	respBytes, err := json.Marshal(response)
+	if err != nil {
+		logger.Error(err, "Could not marshal a response")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := resp.Write(respBytes); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
+
+func concat(pems [][]byte) []byte {
+	return bytes.Join(pems, nil)
+}
+
+func writeError(resp http.ResponseWriter, status int, msg string, logger logr.Logger) {
+	resp.WriteHeader(status)
+	if _, err := resp.Write([]byte(msg)); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
@@ -0,0 +1,167 @@
+package identity_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/identity"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
+)
+
+type staticSecrets struct {
+	secrets.Secrets
+	identity *core_xds.IdentitySecret
+	ca       *core_xds.CaSecret
+}
+
+func (s *staticSecrets) GetForDataPlane(*core_mesh.DataplaneResource, *core_mesh.MeshResource) (*core_xds.IdentitySecret, *core_xds.CaSecret, error) {
+	return s.identity, s.ca, nil
+}
+
+type tokenAuthenticator string
+
+func (t tokenAuthenticator) Authenticate(_ context.Context, _ model.Resource, credential auth.Credential) error {
+	if credential != string(t) {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+var _ = Describe("Workload identity handler", func() {
+
+	var resManager manager.ResourceManager
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		handler := &identity.Handler{
+			ResManager:    resManager,
+			Authenticator: tokenAuthenticator("valid-token"),
+			Secrets: &staticSecrets{
+				identity: &core_xds.IdentitySecret{
+					PemCerts: [][]byte{[]byte("cert\n")},
+					PemKey:   []byte("key\n"),
+				},
+				ca: &core_xds.CaSecret{
+					PemCerts: [][]byte{[]byte("ca-1\n"), []byte("ca-2\n")},
+				},
+			},
+		}
+		server = httptest.NewServer(http.HandlerFunc(handler.Handle))
+
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{Name: "ca-1", Type: "builtin"},
+			},
+		}
+		Expect(resManager.Create(context.Background(), mesh, store.CreateByKey("default", model.NoMesh))).To(Succeed())
+		Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("no-mtls", model.NoMesh))).To(Succeed())
+
+		dataplane := core_mesh.NewDataplaneResource()
+		dataplane.Spec.Networking = &mesh_proto.Dataplane_Networking{
+			Address: "192.168.0.1",
+			Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+				{Port: 8080, Tags: map[string]string{mesh_proto.ServiceTag: "backend"}},
+			},
+		}
+		Expect(resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))).To(Succeed())
+		dataplane = core_mesh.NewDataplaneResource()
+		dataplane.Spec.Networking = &mesh_proto.Dataplane_Networking{
+			Address: "192.168.0.2",
+			Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+				{Port: 8080, Tags: map[string]string{mesh_proto.ServiceTag: "backend"}},
+			},
+		}
+		Expect(resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "no-mtls"))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	request := func(mesh, name, token string) (int, []byte) {
+		body, err := json.Marshal(identity.WorkloadIdentityRequest{Mesh: mesh, Name: name})
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest(http.MethodPost, server.URL+identity.Path, bytes.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("authorization", token)
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, respBody
+	}
+
+	It("should return certificates of the dataplane", func() {
+		// when
+		status, body := request("default", "dp-1", "valid-token")
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		response := identity.WorkloadIdentityResponse{}
+		Expect(json.Unmarshal(body, &response)).To(Succeed())
+		Expect(response).To(Equal(identity.WorkloadIdentityResponse{
+			CertChain:   []byte("cert\n"),
+			PrivateKey:  []byte("key\n"),
+			TrustBundle: []byte("ca-1\nca-2\n"),
+		}))
+	})
+
+	It("should reject invalid credential", func() {
+		// when
+		status, body := request("default", "dp-1", "other-token")
+
+		// then
+		Expect(status).To(Equal(http.StatusUnauthorized))
+		Expect(string(body)).To(Equal("authentication failed"))
+	})
+
+	It("should return not found for unknown dataplane", func() {
+		// when
+		status, _ := request("default", "dp-2", "valid-token")
+
+		// then
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+
+	It("should return conflict when mTLS is disabled", func() {
+		// when
+		status, body := request("no-mtls", "dp-1", "valid-token")
+
+		// then
+		Expect(status).To(Equal(http.StatusConflict))
+		Expect(string(body)).To(Equal("mTLS is not enabled in the mesh"))
+	})
+
+	It("should reject too large request", func() {
+		// given
+		body := bytes.Repeat([]byte(" "), 64*1024+1)
+
+		// when
+		resp, err := http.Post(server.URL+identity.Path, "application/json", bytes.NewReader(body))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
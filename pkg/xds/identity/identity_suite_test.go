@@ -0,0 +1,11 @@
+package identity_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestIdentity(t *testing.T) {
+	test.RunSpecs(t, "Workload Identity Suite")
+}
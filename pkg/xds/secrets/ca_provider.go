@@ -11,8 +11,9 @@ import (
 )
 
 type CaProvider interface {
-	// Get returns all PEM encoded CAs, a list of CAs that were used to generate a secret and an error.
-	Get(context.Context, *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error)
+	// Get returns all PEM encoded CAs of the given backends with revocation lists of the revoked certificates,
+	// a list of CAs that were used to generate a secret and an error.
+	Get(ctx context.Context, mesh *core_mesh.MeshResource, backends []string, revoked core_ca.RevokedCerts) (*core_xds.CaSecret, []string, error)
 }
 
 func NewCaProvider(caManagers core_ca.Managers) CaProvider {
@@ -25,23 +26,54 @@ type meshCaProvider struct {
 	caManagers core_ca.Managers
 }
 
-func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error) {
-	backend := mesh.GetEnabledCertificateAuthorityBackend()
-	if backend == nil {
+func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource, backends []string, revoked core_ca.RevokedCerts) (*core_xds.CaSecret, []string, error) {
+	if len(backends) == 0 {
 		return nil, nil, errors.New("CA backend is nil")
 	}
 
-	caManager, exist := s.caManagers[backend.Type]
-	if !exist {
-		return nil, nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
-	}
+	var certs [][]byte
+	var crls [][]byte
+	crlsSupported := true
+	for _, backendName := range backends {
+		backend := mesh.GetCertificateAuthorityBackend(backendName)
+		if backend == nil {
+			return nil, nil, errors.Errorf("CA backend %q is not defined in mesh %q", backendName, mesh.GetMeta().GetName())
+		}
+
+		caManager, exist := s.caManagers[backend.Type]
+		if !exist {
+			return nil, nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+		}
 
-	certs, err := caManager.GetRootCert(ctx, mesh.GetMeta().GetName(), backend)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "could not get root certs")
+		backendCerts, err := caManager.GetRootCert(ctx, mesh.GetMeta().GetName(), backend)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get root certs of backend %q", backendName)
+		}
+		certs = append(certs, backendCerts...)
+
+		if len(revoked) == 0 || !crlsSupported {
+			continue
+		}
+		issuer, ok := caManager.(core_ca.RevocationListIssuer)
+		if !ok {
+			log.Error(errors.Errorf("CA manager of type %s does not support revocation lists", backend.Type), "revoked certificates are not going to be rejected", "mesh", mesh.GetMeta().GetName(), "backend", backendName)
+			crlsSupported = false
+			continue
+		}
+		backendCrls, err := issuer.GenerateRevocationList(ctx, mesh.GetMeta().GetName(), backend, revoked.IssuedBy(backendName))
+		if err != nil {
+			// fail instead of dropping the lists, so dataplanes keep the lists they already have
+			return nil, nil, errors.Wrapf(err, "could not generate revocation list of backend %q", backendName)
+		}
+		crls = append(crls, backendCrls...)
+	}
+	if !crlsSupported {
+		// Envoy rejects peers if a list of any CA is missing
+		crls = nil
 	}
 
 	return &core_xds.CaSecret{
 		PemCerts: certs,
-	}, []string{backend.Name}, nil
+		PemCrls:  crls,
+	}, backends, nil
 }
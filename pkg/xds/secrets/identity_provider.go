@@ -18,8 +18,8 @@ type Identity struct {
 }
 
 type IdentityProvider interface {
-	// Get returns PEM encoded cert + key, backend that was used to generate this pair and an error.
-	Get(context.Context, Identity, *core_mesh.MeshResource) (*core_xds.IdentitySecret, string, error)
+	// Get returns PEM encoded cert + key issued by the given backend, backend that was used to generate this pair and an error.
+	Get(ctx context.Context, requestor Identity, mesh *core_mesh.MeshResource, backendName string) (*core_xds.IdentitySecret, string, error)
 }
 
 func NewIdentityProvider(caManagers core_ca.Managers) IdentityProvider {
@@ -32,10 +32,10 @@ type identityCertProvider struct {
 	caManagers core_ca.Managers
 }
 
-func (s *identityCertProvider) Get(ctx context.Context, requestor Identity, mesh *core_mesh.MeshResource) (*core_xds.IdentitySecret, string, error) {
-	backend := mesh.GetEnabledCertificateAuthorityBackend()
+func (s *identityCertProvider) Get(ctx context.Context, requestor Identity, mesh *core_mesh.MeshResource, backendName string) (*core_xds.IdentitySecret, string, error) {
+	backend := mesh.GetCertificateAuthorityBackend(backendName)
 	if backend == nil {
-		return nil, "", errors.Errorf("CA default backend in mesh %q has to be defined", mesh.GetMeta().GetName())
+		return nil, "", errors.Errorf("CA backend %q in mesh %q has to be defined", backendName, mesh.GetMeta().GetName())
 	}
 
 	caManager, exist := s.caManagers[backend.Type]
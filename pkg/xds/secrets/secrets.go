@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -14,8 +15,11 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/metrics"
 )
@@ -38,6 +42,11 @@ type Info struct {
 
 	IssuedBackend     string
 	SupportedBackends []string
+
+	// Serial is the serial number of the certificate in hexadecimal format.
+	Serial string
+	// RevokedSerials are serial numbers of revoked certificates that are included in the revocation lists of CAs.
+	RevokedSerials []string
 }
 
 func (c *Info) CertLifetime() time.Duration {
@@ -48,7 +57,7 @@ func (c *Info) ExpiringSoon() bool {
 	return core.Now().After(c.Generation.Add(c.CertLifetime() / 5 * 4))
 }
 
-func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider, metrics metrics.Metrics) (Secrets, error) {
+func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider, resManager manager.ReadOnlyResourceManager, metrics metrics.Metrics) (Secrets, error) {
 	certGenerationsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Help: "Number of generated certificates",
 		Name: "cert_generation",
@@ -60,6 +69,7 @@ func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider, metric
 	return &secrets{
 		caProvider:            caProvider,
 		identityProvider:      identityProvider,
+		resManager:            resManager,
 		cachedCerts:           map[model.ResourceKey]*certs{},
 		certGenerationsMetric: certGenerationsMetric,
 	}, nil
@@ -68,6 +78,7 @@ func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider, metric
 type secrets struct {
 	caProvider       CaProvider
 	identityProvider IdentityProvider
+	resManager       manager.ReadOnlyResourceManager
 
 	sync.RWMutex
 	cachedCerts           map[model.ResourceKey]*certs
@@ -139,17 +150,29 @@ func (s *secrets) get(
 	resourceKey.Mesh = meshName
 	certs := s.certs(resourceKey)
 
+	rotation, err := s.rotation(mesh)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get state of the CA rotation")
+	}
+
+	revoked, err := core_ca.LoadRevokedCerts(context.Background(), s.resManager, meshName, core.Now())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get revoked certificates")
+	}
+
 	if shouldGenerate, reason := s.shouldGenerateCerts(
 		certs.Info(),
 		mesh.Spec.Mtls,
 		tags,
+		rotation,
+		revoked,
 	); shouldGenerate {
 		log.Info(
 			"generating certificate",
 			string(resource.Descriptor().Name), resourceKey, "reason", reason,
 		)
 
-		certs, err := s.generateCerts(meshName, tags, mesh)
+		certs, err := s.generateCerts(meshName, tags, mesh, rotation, revoked)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not generate certificates")
 		}
@@ -165,6 +188,29 @@ func (s *secrets) get(
 		return nil, nil, errors.New("certificates were not generated")
 	}
 
+	if !reflect.DeepEqual(certs.info.RevokedSerials, revoked.Serials()) {
+		// the certificate of the DP is still valid, only revocation lists have to be updated
+		log.Info(
+			"updating revocation lists",
+			string(resource.Descriptor().Name), resourceKey,
+		)
+		ca, _, err := s.caProvider.Get(context.Background(), mesh, rotation.TrustedBackends(), revoked)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get mesh CA cert")
+		}
+		info := *certs.info
+		info.RevokedSerials = revoked.Serials()
+		updated := *certs
+		updated.ca = ca
+		updated.info = &info
+
+		s.Lock()
+		s.cachedCerts[resourceKey] = &updated
+		s.Unlock()
+
+		return updated.identity, updated.ca, nil
+	}
+
 	return certs.identity, certs.ca, nil
 }
 
@@ -174,7 +220,20 @@ func (s *secrets) Cleanup(dpKey model.ResourceKey) {
 	s.Unlock()
 }
 
-func (s *secrets) shouldGenerateCerts(info *Info, mtls *mesh_proto.Mesh_Mtls, tags mesh_proto.MultiValueTagSet) (bool, string) {
+// rotation returns the state of the CA rotation of the mesh. MeshInsight is computed periodically,
+// so the state might lag behind, which only delays the next phase of the rotation.
+func (s *secrets) rotation(mesh *core_mesh.MeshResource) (core_ca.Rotation, error) {
+	insight := core_mesh.NewMeshInsightResource()
+	if err := s.resManager.Get(context.Background(), insight, store.GetByKey(mesh.GetMeta().GetName(), model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return core_ca.NewRotation(mesh.Spec.GetMtls(), nil), nil
+		}
+		return core_ca.Rotation{}, err
+	}
+	return core_ca.NewRotation(mesh.Spec.GetMtls(), insight.Spec), nil
+}
+
+func (s *secrets) shouldGenerateCerts(info *Info, mtls *mesh_proto.Mesh_Mtls, tags mesh_proto.MultiValueTagSet, rotation core_ca.Rotation, revoked core_ca.RevokedCerts) (bool, string) {
 	if info == nil {
 		return true, "mTLS is enabled and DP hasn't received a certificate yet"
 	}
@@ -187,6 +246,14 @@ func (s *secrets) shouldGenerateCerts(info *Info, mtls *mesh_proto.Mesh_Mtls, ta
 		return true, "DP tags have changed"
 	}
 
+	if info.IssuedBackend != rotation.IssuingBackend() || !reflect.DeepEqual(info.SupportedBackends, rotation.TrustedBackends()) {
+		return true, fmt.Sprintf("the CA rotation has progressed. Certificate issued by %q, trusted backends %q", rotation.IssuingBackend(), rotation.TrustedBackends())
+	}
+
+	if revoked.IsRevoked(info.Serial) {
+		return true, fmt.Sprintf("the certificate with serial %q was revoked", info.Serial)
+	}
+
 	if info.ExpiringSoon() {
 		return true, fmt.Sprintf("the certificate expiring soon. Generated at %q, expiring at %q", info.Generation, info.Expiration)
 	}
@@ -198,25 +265,27 @@ func (s *secrets) generateCerts(
 	resourceMesh string,
 	tags mesh_proto.MultiValueTagSet,
 	mesh *core_mesh.MeshResource,
+	rotation core_ca.Rotation,
+	revoked core_ca.RevokedCerts,
 ) (*certs, error) {
 	requester := Identity{
 		Services: tags,
 		Mesh:     resourceMesh,
 	}
 
-	identity, issuedBackend, err := s.identityProvider.Get(context.Background(), requester, mesh)
+	identity, issuedBackend, err := s.identityProvider.Get(context.Background(), requester, mesh, rotation.IssuingBackend())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get Dataplane cert pair")
 	}
 
 	s.certGenerationsMetric.WithLabelValues(requester.Mesh).Inc()
 
-	ca, supportedBackends, err := s.caProvider.Get(context.Background(), mesh)
+	ca, supportedBackends, err := s.caProvider.Get(context.Background(), mesh, rotation.TrustedBackends(), revoked)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get mesh CA cert")
 	}
 
-	info, err := newCertInfo(identity, mesh.Spec.Mtls, tags, issuedBackend, supportedBackends)
+	info, err := newCertInfo(identity, mesh.Spec.Mtls, tags, issuedBackend, supportedBackends, revoked.Serials())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not extract info about certificate")
 	}
@@ -228,7 +297,7 @@ func (s *secrets) generateCerts(
 	}, nil
 }
 
-func newCertInfo(identityCert *core_xds.IdentitySecret, mtls *mesh_proto.Mesh_Mtls, tags mesh_proto.MultiValueTagSet, issuedBackend string, supportedBackends []string) (*Info, error) {
+func newCertInfo(identityCert *core_xds.IdentitySecret, mtls *mesh_proto.Mesh_Mtls, tags mesh_proto.MultiValueTagSet, issuedBackend string, supportedBackends []string, revokedSerials []string) (*Info, error) {
 	block, _ := pem.Decode(identityCert.PemCerts[0])
 	cert, err := x509.ParseCertificate(block.Bytes)
 	if err != nil {
@@ -241,6 +310,8 @@ func newCertInfo(identityCert *core_xds.IdentitySecret, mtls *mesh_proto.Mesh_Mt
 		Generation:        core.Now(),
 		IssuedBackend:     issuedBackend,
 		SupportedBackends: supportedBackends,
+		Serial:            cert.SerialNumber.Text(16),
+		RevokedSerials:    revokedSerials,
 	}
 	return certInfo, nil
 }
@@ -2,17 +2,21 @@ package secrets_test
 
 import (
 	"context"
+	"crypto/x509"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
-	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	secrets_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secrets_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
@@ -20,6 +24,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
 	"github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	. "github.com/kumahq/kuma/pkg/xds/secrets"
 )
 
@@ -27,6 +32,7 @@ var _ = Describe("Secrets", func() {
 
 	var secrets Secrets
 	var metrics core_metrics.Metrics
+	var resManager core_manager.ResourceManager
 	var now time.Time
 
 	newMesh := func() *core_mesh.MeshResource {
@@ -102,7 +108,8 @@ var _ = Describe("Secrets", func() {
 
 	BeforeEach(func() {
 		resStore := memory.NewStore()
-		secretManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), cipher.None(), nil)
+		resManager = core_manager.NewResourceManager(resStore)
+		secretManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), nil)
 		builtinCaManager := ca_builtin.NewBuiltinCaManager(secretManager)
 		caManagers := core_ca.Managers{
 			"builtin": builtinCaManager,
@@ -117,7 +124,7 @@ var _ = Describe("Secrets", func() {
 		Expect(err).ToNot(HaveOccurred())
 		metrics = m
 
-		secrets, err = NewSecrets(caProvider, identityProvider, metrics)
+		secrets, err = NewSecrets(caProvider, identityProvider, resManager, metrics)
 		Expect(err).ToNot(HaveOccurred())
 
 		now = time.Now()
@@ -237,6 +244,166 @@ var _ = Describe("Secrets", func() {
 		})
 	})
 
+	Context("CA rotation", func() {
+		setMeshInsight := func(issued map[string]uint32, supported map[string]uint32) {
+			stats := func(counts map[string]uint32) map[string]*mesh_proto.MeshInsight_DataplaneStat {
+				result := map[string]*mesh_proto.MeshInsight_DataplaneStat{}
+				for backend, online := range counts {
+					result[backend] = &mesh_proto.MeshInsight_DataplaneStat{Total: online, Online: online}
+				}
+				return result
+			}
+			err := core_manager.Upsert(resManager, core_model.ResourceKey{Name: "default"}, core_mesh.NewMeshInsightResource(), func(resource core_model.Resource) error {
+				resource.(*core_mesh.MeshInsightResource).Spec.MTLS = &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends:    stats(issued),
+					SupportedBackends: stats(supported),
+				}
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		rotatedMesh := func() *core_mesh.MeshResource {
+			mesh := newMesh()
+			mesh.Spec.Mtls.EnabledBackend = "ca-2"
+			return mesh
+		}
+
+		BeforeEach(func() {
+			err := resManager.Create(context.Background(), newMesh(), core_store.CreateByKey("default", core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = secrets.GetForDataPlane(newDataplane(), newMesh())
+			Expect(err).ToNot(HaveOccurred())
+			setMeshInsight(map[string]uint32{"ca-1": 2}, map[string]uint32{"ca-1": 2})
+		})
+
+		It("should distribute both roots while certificates are issued by the old backend", func() {
+			// when
+			_, ca, err := secrets.GetForDataPlane(newDataplane(), rotatedMesh())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ca.PemCerts).To(HaveLen(2))
+			info := secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta))
+			Expect(info.IssuedBackend).To(Equal("ca-1"))
+			Expect(info.SupportedBackends).To(Equal([]string{"ca-2", "ca-1"}))
+		})
+
+		It("should not reissue certificates from the new backend until every dataplane trusts it", func() {
+			// given
+			_, _, err := secrets.GetForDataPlane(newDataplane(), rotatedMesh())
+			Expect(err).ToNot(HaveOccurred())
+			setMeshInsight(map[string]uint32{"ca-1": 2}, map[string]uint32{"ca-1": 2, "ca-2": 1})
+
+			// when
+			_, _, err = secrets.GetForDataPlane(newDataplane(), rotatedMesh())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta)).IssuedBackend).To(Equal("ca-1"))
+			Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(2.0))
+		})
+
+		It("should reissue certificates from the new backend and then drop the old root", func() {
+			// given every dataplane trusts the new backend
+			_, _, err := secrets.GetForDataPlane(newDataplane(), rotatedMesh())
+			Expect(err).ToNot(HaveOccurred())
+			setMeshInsight(map[string]uint32{"ca-1": 2}, map[string]uint32{"ca-1": 2, "ca-2": 2})
+
+			// when
+			_, ca, err := secrets.GetForDataPlane(newDataplane(), rotatedMesh())
+
+			// then certificate is issued by the new backend
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ca.PemCerts).To(HaveLen(2))
+			info := secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta))
+			Expect(info.IssuedBackend).To(Equal("ca-2"))
+			Expect(info.SupportedBackends).To(Equal([]string{"ca-2", "ca-1"}))
+
+			// when every dataplane reports certificate issued by the new backend
+			setMeshInsight(map[string]uint32{"ca-2": 2}, map[string]uint32{"ca-1": 2, "ca-2": 2})
+			_, ca, err = secrets.GetForDataPlane(newDataplane(), rotatedMesh())
+
+			// then the old root is dropped
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ca.PemCerts).To(HaveLen(1))
+			info = secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta))
+			Expect(info.IssuedBackend).To(Equal("ca-2"))
+			Expect(info.SupportedBackends).To(Equal([]string{"ca-2"}))
+			Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(4.0))
+		})
+	})
+
+	Context("certificate revocation", func() {
+		revoke := func(serial string) {
+			data, err := core_ca.RevokedCerts{
+				{
+					Serial:    serial,
+					Dataplane: "dp1",
+					Backend:   "ca-1",
+					RevokedAt: now,
+					ExpiresAt: now.Add(time.Hour),
+				},
+			}.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			err = core_manager.Upsert(resManager, core_ca.RevocationsSecretKey("default"), system.NewSecretResource(), func(resource core_model.Resource) error {
+				resource.(*system.SecretResource).Spec = &system_proto.Secret{
+					Data: util_proto.Bytes(data),
+				}
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			err := resManager.Create(context.Background(), newMesh(), core_store.CreateByKey("default", core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should regenerate revoked certificate and distribute the revocation list", func() {
+			// given
+			_, ca, err := secrets.GetForDataPlane(newDataplane(), newMesh())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ca.PemCrls).To(BeEmpty())
+			serial := secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta)).Serial
+
+			// when
+			revoke(serial)
+			_, ca, err = secrets.GetForDataPlane(newDataplane(), newMesh())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ca.PemCrls).To(HaveLen(1))
+			crl, err := x509.ParseCRL(ca.PemCrls[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber.Text(16)).To(Equal(serial))
+
+			// and certificate is regenerated
+			info := secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta))
+			Expect(info.Serial).ToNot(Equal(serial))
+			Expect(info.RevokedSerials).To(Equal([]string{serial}))
+			Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(2.0))
+		})
+
+		It("should only update revocation lists when certificate of other dataplane is revoked", func() {
+			// given
+			identity, _, err := secrets.GetForDataPlane(newDataplane(), newMesh())
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			revoke("abcdef")
+			newIdentity, ca, err := secrets.GetForDataPlane(newDataplane(), newMesh())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newIdentity).To(Equal(identity))
+			Expect(ca.PemCrls).To(HaveLen(1))
+			Expect(secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta)).RevokedSerials).To(Equal([]string{"abcdef"}))
+			Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(1.0))
+		})
+	})
+
 	Context("zone egress", func() {
 		It("should generate cert and emit statistic and info", func() {
 			// when
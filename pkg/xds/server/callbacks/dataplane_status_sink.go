@@ -166,8 +166,9 @@ func (s *dataplaneInsightStore) Upsert(dataplaneType core_model.ResourceType, da
 			} else if insight.Spec.MTLS == nil ||
 				insight.Spec.MTLS.CertificateExpirationTime.AsTime() != secretsInfo.Expiration ||
 				insight.Spec.MTLS.IssuedBackend != secretsInfo.IssuedBackend ||
+				insight.Spec.MTLS.CertificateSerial != secretsInfo.Serial ||
 				!reflect.DeepEqual(insight.Spec.MTLS.SupportedBackends, secretsInfo.SupportedBackends) {
-				if err := insight.Spec.UpdateCert(secretsInfo.Generation, secretsInfo.Expiration, secretsInfo.IssuedBackend, secretsInfo.SupportedBackends); err != nil {
+				if err := insight.Spec.UpdateCert(secretsInfo.Generation, secretsInfo.Expiration, secretsInfo.IssuedBackend, secretsInfo.SupportedBackends, secretsInfo.Serial); err != nil {
 					return err
 				}
 			}
@@ -13,6 +13,7 @@ import (
 	"github.com/kumahq/kuma/pkg/xds/cache/cla"
 	"github.com/kumahq/kuma/pkg/xds/cache/mesh"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/identity"
 	xds_metrics "github.com/kumahq/kuma/pkg/xds/metrics"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
 	v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
@@ -80,12 +81,17 @@ func RegisterXDS(rt core_runtime.Runtime) error {
 	secrets, err := secrets.NewSecrets(
 		rt.CAProvider(),
 		secrets.NewIdentityProvider(rt.CaManagers()),
+		rt.ReadOnlyResourceManager(),
 		rt.Metrics(),
 	)
 	if err != nil {
 		return err
 	}
 
+	if err := identity.RegisterWorkloadIdentity(rt, secrets); err != nil {
+		return errors.Wrap(err, "could not register workload identity")
+	}
+
 	envoyCpCtx, err := xds_context.BuildControlPlaneContext(claCache, secrets)
 	if err != nil {
 		return err